@@ -0,0 +1,199 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// The allowlist is the set of device IDs allowed to announce and look up,
+// read from a file or from all files in a directory. Each line holds a
+// device ID; empty lines and lines starting with # are ignored. The
+// allowlist is reloaded when the files change.
+type allowlist struct {
+	path string
+
+	mut  sync.RWMutex
+	ids  map[protocol.DeviceID]struct{}
+	hash []byte // of the names and contents of the files
+}
+
+func newAllowlist(path string) (*allowlist, error) {
+	a := &allowlist{path: path}
+	if err := a.reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *allowlist) Serve(ctx context.Context) error {
+	t := time.NewTicker(allowlistReloadInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err := a.reload(); err != nil {
+			// Keep using what we had.
+			log.Println("Reloading allowlist:", err)
+			allowlistReloadsTotal.WithLabelValues("error").Inc()
+		}
+	}
+}
+
+func (a *allowlist) allowed(id protocol.DeviceID) bool {
+	a.mut.RLock()
+	_, ok := a.ids[id]
+	a.mut.RUnlock()
+	return ok
+}
+
+// reload reads the allowlist again if any of its files have changed, been
+// added or removed since last time. Changes are detected by content rather
+// than by modification time, which may not change or even go backwards when
+// files are edited or replaced.
+func (a *allowlist) reload() error {
+	files, err := a.listFiles()
+	if err != nil {
+		return err
+	}
+	contents := make([][]byte, len(files))
+	h := sha256.New()
+	var size [8]byte
+	for i, file := range files {
+		contents[i], err = os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		h.Write([]byte(file))
+		binary.BigEndian.PutUint64(size[:], uint64(len(contents[i])))
+		h.Write(size[:])
+		h.Write(contents[i])
+	}
+	hash := h.Sum(nil)
+
+	a.mut.RLock()
+	unchanged := a.ids != nil && bytes.Equal(hash, a.hash)
+	a.mut.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	ids := make(map[protocol.DeviceID]struct{})
+	for i, file := range files {
+		if err := parseAllowlist(file, contents[i], ids); err != nil {
+			return err
+		}
+	}
+
+	a.mut.Lock()
+	a.ids = ids
+	a.hash = hash
+	a.mut.Unlock()
+
+	log.Printf("Loaded %d device IDs from allowlist %s", len(ids), a.path)
+	allowlistReloadsTotal.WithLabelValues("success").Inc()
+	allowlistDevices.Set(float64(len(ids)))
+	return nil
+}
+
+func (a *allowlist) listFiles() ([]string, error) {
+	info, err := os.Stat(a.path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{a.path}, nil
+	}
+
+	entries, err := os.ReadDir(a.path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		files = append(files, filepath.Join(a.path, entry.Name()))
+	}
+	return files, nil
+}
+
+func parseAllowlist(file string, contents []byte, ids map[protocol.DeviceID]struct{}) error {
+	sc := bufio.NewScanner(bytes.NewReader(contents))
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		id, err := protocol.DeviceIDFromString(text)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", file, line, err)
+		}
+		ids[id] = struct{}{}
+	}
+	return sc.Err()
+}
+
+// An allowlistDatabase hides the records of devices that aren't in the
+// allowlist, such as those removed from it after announcing, and drops any
+// changes to them, such as those replicated from peers.
+type allowlistDatabase struct {
+	database
+	allow *allowlist
+}
+
+func (d allowlistDatabase) put(key string, rec DatabaseRecord) error {
+	if !d.allowedKey(key) {
+		return nil
+	}
+	return d.database.put(key, rec)
+}
+
+func (d allowlistDatabase) merge(key string, addrs []DatabaseAddress, seen int64) error {
+	if !d.allowedKey(key) {
+		return nil
+	}
+	return d.database.merge(key, addrs, seen)
+}
+
+func (d allowlistDatabase) get(key string) (DatabaseRecord, error) {
+	if !d.allowedKey(key) {
+		return DatabaseRecord{}, nil
+	}
+	return d.database.get(key)
+}
+
+func (d allowlistDatabase) iterate(prefix string, fn func(key string, rec DatabaseRecord) bool) error {
+	return d.database.iterate(prefix, func(key string, rec DatabaseRecord) bool {
+		if !d.allowedKey(key) {
+			return true
+		}
+		return fn(key, rec)
+	})
+}
+
+func (d allowlistDatabase) allowedKey(key string) bool {
+	id, err := protocol.DeviceIDFromString(key)
+	return err == nil && d.allow.allowed(id)
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	allowID1 = protocol.NewDeviceID([]byte("device one"))
+	allowID2 = protocol.NewDeviceID([]byte("device two"))
+	allowID3 = protocol.NewDeviceID([]byte("device three"))
+)
+
+func TestAllowlistFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "allowlist")
+	writeFile(t, path, "# comment\n"+allowID1.String()+"\n\n  "+allowID2.String()+"  \n")
+
+	a, err := newAllowlist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.allowed(allowID1) || !a.allowed(allowID2) {
+		t.Error("listed device should be allowed")
+	}
+	if a.allowed(allowID3) {
+		t.Error("unlisted device should not be allowed")
+	}
+
+	// Changing the file takes effect on reload, even when the change keeps
+	// the size and modification time.
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, path, "# comment\n"+allowID3.String()+"\n\n  "+allowID2.String()+"  \n")
+	touch(t, path, info.ModTime())
+	if err := a.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if a.allowed(allowID1) || !a.allowed(allowID3) {
+		t.Error("reload should replace the allowlist")
+	}
+
+	// A broken file keeps what we had.
+	writeFile(t, path, "not a device id\n")
+	if err := a.reload(); err == nil {
+		t.Error("expected error for invalid device ID")
+	}
+	if !a.allowed(allowID3) {
+		t.Error("failed reload should keep the previous allowlist")
+	}
+}
+
+func TestAllowlistDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a"), allowID1.String()+"\n")
+	writeFile(t, filepath.Join(dir, ".hidden"), allowID3.String()+"\n")
+
+	a, err := newAllowlist(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.allowed(allowID1) || a.allowed(allowID2) || a.allowed(allowID3) {
+		t.Error("unexpected allowlist contents")
+	}
+
+	// Adding a file takes effect on reload, even with an older mtime.
+	path := filepath.Join(dir, "b")
+	writeFile(t, path, allowID2.String()+"\n")
+	touch(t, path, time.Now().Add(-time.Hour))
+	if err := a.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if !a.allowed(allowID1) || !a.allowed(allowID2) {
+		t.Error("added file should be loaded")
+	}
+
+	// Replacing a file with another, older one takes effect as well.
+	os.Remove(path)
+	path = filepath.Join(dir, "c")
+	writeFile(t, path, allowID3.String()+"\n")
+	touch(t, path, time.Now().Add(-2*time.Hour))
+	if err := a.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if a.allowed(allowID2) || !a.allowed(allowID3) {
+		t.Error("replaced file should be loaded")
+	}
+}
+
+func TestAllowlistDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allowlist")
+	writeFile(t, path, allowID1.String()+"\n"+allowID2.String()+"\n")
+	a, err := newAllowlist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := newTestStore(t, backendLevelDB)
+	db := allowlistDatabase{database: store, allow: a}
+
+	addrs := []DatabaseAddress{{Address: "tcp://192.0.2.1:22000", Expires: time.Now().Add(time.Hour).UnixNano()}}
+	for _, id := range []protocol.DeviceID{allowID1, allowID2, allowID3} {
+		if err := db.merge(id.String(), addrs, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rec, _ := store.get(allowID3.String()); len(rec.Addresses) != 0 {
+		t.Error("record of a device not in the allowlist was stored")
+	}
+
+	// Device two is removed from the allowlist after announcing.
+	writeFile(t, path, allowID1.String()+"\n")
+	if err := a.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if rec, _ := db.get(allowID2.String()); len(rec.Addresses) != 0 {
+		t.Error("record of a removed device is still served")
+	}
+	var keys []string
+	db.iterate("", func(key string, _ DatabaseRecord) bool {
+		keys = append(keys, key)
+		return true
+	})
+	if len(keys) != 1 || keys[0] != allowID1.String() {
+		t.Errorf("unexpected keys %v", keys)
+	}
+}
+
+func TestAllowRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allowlist")
+	writeFile(t, path, allowID1.String()+"\n")
+	a, err := newAllowlist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &apiSrv{allow: a, limiter: newClientLimiter(0.001, 1)}
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 22000}
+
+	// Certificates not in the allowlist don't get a bucket of their own.
+	if !s.allowRequest([]byte("cert one"), addr) {
+		t.Fatal("first request should be allowed")
+	}
+	if s.allowRequest([]byte("cert two"), addr) {
+		t.Error("request with a new certificate should share the address' limit")
+	}
+	// Allowed devices do.
+	if !s.allowRequest([]byte("device one"), addr) {
+		t.Error("allowed device should have its own limit")
+	}
+}
+
+func TestClientLimiter(t *testing.T) {
+	l := newClientLimiter(0.001, 2)
+
+	for i := 0; i < 2; i++ {
+		if !l.allow("a") {
+			t.Fatal("request within burst should be allowed")
+		}
+	}
+	if l.allow("a") {
+		t.Error("request over burst should be denied")
+	}
+	if !l.allow("b") {
+		t.Error("other clients should have their own limit")
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func touch(t *testing.T, path string, when time.Time) {
+	t.Helper()
+	if err := os.Chtimes(path, when, when); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -42,6 +42,8 @@ type apiSrv struct {
 	listener net.Listener
 	repl     replicator // optional
 	useHTTP  bool
+	allow    *allowlist     // optional
+	limiter  *clientLimiter // optional
 
 	mapsMut sync.Mutex
 	misses  map[string]int32
@@ -57,13 +59,15 @@ type contextKey int
 
 const idKey contextKey = iota
 
-func newAPISrv(addr string, cert tls.Certificate, db database, repl replicator, useHTTP bool) *apiSrv {
+func newAPISrv(addr string, cert tls.Certificate, db database, repl replicator, useHTTP bool, allow *allowlist, limiter *clientLimiter) *apiSrv {
 	return &apiSrv{
 		addr:    addr,
 		cert:    cert,
 		db:      db,
 		repl:    repl,
 		useHTTP: useHTTP,
+		allow:   allow,
+		limiter: limiter,
 		misses:  make(map[string]int32),
 	}
 }
@@ -160,7 +164,7 @@ func (s *apiSrv) handler(w http.ResponseWriter, req *http.Request) {
 
 	switch req.Method {
 	case "GET":
-		s.handleGET(ctx, lw, req)
+		s.handleGET(ctx, remoteAddr, lw, req)
 	case "POST":
 		s.handlePOST(ctx, remoteAddr, lw, req)
 	default:
@@ -168,9 +172,42 @@ func (s *apiSrv) handler(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func (s *apiSrv) handleGET(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+func (s *apiSrv) handleGET(ctx context.Context, remoteAddr *net.TCPAddr, w http.ResponseWriter, req *http.Request) {
 	reqID := ctx.Value(idKey).(requestID)
 
+	rawCert, certErr := certificateBytes(req)
+	if !s.allowRequest(rawCert, remoteAddr) {
+		if debug {
+			log.Println(reqID, "rate limited")
+		}
+		lookupRequestsTotal.WithLabelValues("rate_limited").Inc()
+		w.Header().Set("Retry-After", rateLimitRetryAfterString())
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		return
+	}
+
+	if s.allow != nil {
+		// Lookups are for authenticated, allowed clients only.
+		if certErr != nil {
+			if debug {
+				log.Println(reqID, "no certificates:", certErr)
+			}
+			lookupRequestsTotal.WithLabelValues("no_certificate").Inc()
+			w.Header().Set("Retry-After", errorRetryAfterString())
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		if !s.allow.allowed(protocol.NewDeviceID(rawCert)) {
+			if debug {
+				log.Println(reqID, "lookup from device not in allowlist")
+			}
+			lookupRequestsTotal.WithLabelValues("not_allowed").Inc()
+			w.Header().Set("Retry-After", errorRetryAfterString())
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+	}
+
 	deviceID, err := protocol.DeviceIDFromString(req.URL.Query().Get("device"))
 	if err != nil {
 		if debug {
@@ -242,6 +279,16 @@ func (s *apiSrv) handlePOST(ctx context.Context, remoteAddr *net.TCPAddr, w http
 		return
 	}
 
+	if !s.allowRequest(rawCert, remoteAddr) {
+		if debug {
+			log.Println(reqID, "rate limited")
+		}
+		announceRequestsTotal.WithLabelValues("rate_limited").Inc()
+		w.Header().Set("Retry-After", rateLimitRetryAfterString())
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		return
+	}
+
 	var ann announcement
 	if err := json.NewDecoder(req.Body).Decode(&ann); err != nil {
 		if debug {
@@ -254,6 +301,15 @@ func (s *apiSrv) handlePOST(ctx context.Context, remoteAddr *net.TCPAddr, w http
 	}
 
 	deviceID := protocol.NewDeviceID(rawCert)
+	if s.allow != nil && !s.allow.allowed(deviceID) {
+		if debug {
+			log.Println(reqID, "announcement from device not in allowlist")
+		}
+		announceRequestsTotal.WithLabelValues("not_allowed").Inc()
+		w.Header().Set("Retry-After", errorRetryAfterString())
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
 
 	addresses := fixupAddresses(remoteAddr, ann.Addresses)
 	if len(addresses) == 0 {
@@ -276,6 +332,22 @@ func (s *apiSrv) handlePOST(ctx context.Context, remoteAddr *net.TCPAddr, w http
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// allowRequest returns whether the client is within its rate limit.
+// Clients are identified by address, or by device ID once that is in the
+// allowlist; anyone can make up a new certificate for each request.
+func (s *apiSrv) allowRequest(rawCert []byte, remoteAddr *net.TCPAddr) bool {
+	if s.limiter == nil {
+		return true
+	}
+	client := remoteAddr.IP.String()
+	if rawCert != nil && s.allow != nil {
+		if id := protocol.NewDeviceID(rawCert); s.allow.allowed(id) {
+			client = id.String()
+		}
+	}
+	return s.limiter.allow(client)
+}
+
 func (s *apiSrv) Stop() {
 	s.listener.Close()
 }
@@ -467,6 +539,10 @@ func errorRetryAfterString() string {
 	return strconv.Itoa(errorRetryAfterSeconds + rand.Intn(errorRetryFuzzSeconds))
 }
 
+func rateLimitRetryAfterString() string {
+	return strconv.Itoa(rateLimitRetryAfterSeconds + rand.Intn(rateLimitRetryFuzzSeconds))
+}
+
 func notFoundRetryAfterString(misses int) string {
 	retryAfterS := notFoundRetryMinSeconds + notFoundRetryIncSeconds*misses
 	if retryAfterS > notFoundRetryMaxSeconds {
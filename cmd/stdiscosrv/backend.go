@@ -0,0 +1,59 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errNotFound = errors.New("not found")
+
+// A storageBackend is the key-value store the database keeps its records
+// in. Updates are serialized by the database, but reads and iterations may
+// happen concurrently with them.
+type storageBackend interface {
+	storageReader
+	// update calls fn with a writer for a batch of changes, which are
+	// committed together when fn returns nil.
+	update(fn func(w storageWriter) error) error
+	// iterate calls fn for each key with the given prefix, in key order,
+	// until fn returns false. The key and value are only valid until fn
+	// returns.
+	iterate(prefix []byte, fn func(key, val []byte) bool) error
+	close() error
+}
+
+type storageReader interface {
+	// get returns the value for the key, or errNotFound.
+	get(key []byte) ([]byte, error)
+}
+
+// A storageWriter makes the changes of an update. Its reads see the changes
+// made earlier in the same update, and the values it returns are only valid
+// until the update returns.
+type storageWriter interface {
+	storageReader
+	put(key, val []byte) error
+	delete(key []byte) error
+}
+
+const (
+	backendLevelDB = "leveldb"
+	backendBolt    = "bolt"
+)
+
+func openBackend(kind, dir string) (storageBackend, error) {
+	switch kind {
+	case backendLevelDB:
+		return openLevelDBBackend(dir)
+	case backendBolt:
+		return openBoltBackend(dir)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q", kind)
+	}
+}
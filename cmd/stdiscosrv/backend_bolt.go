@@ -0,0 +1,138 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// The database file within the database directory.
+	boltFile = "records.bolt"
+	// The bucket holding all records.
+	boltBucket = "records"
+	// How long to wait for the lock on the database file.
+	boltOpenTimeout = 5 * time.Second
+	// The number of records read per transaction when iterating.
+	boltIterateChunk = 1000
+)
+
+type boltBackend struct {
+	db *bolt.DB
+}
+
+func openBoltBackend(dir string) (*boltBackend, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(filepath.Join(dir, boltFile), 0600, &bolt.Options{
+		Timeout: boltOpenTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(boltBucket))
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &boltBackend{db: db}, nil
+}
+
+func (b *boltBackend) get(key []byte) ([]byte, error) {
+	var val []byte
+	err := b.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket([]byte(boltBucket)).Get(key)
+		if v == nil {
+			return errNotFound
+		}
+		// The value is only valid during the transaction.
+		val = append([]byte{}, v...)
+		return nil
+	})
+	return val, err
+}
+
+// update commits the changes in one transaction, and so with one sync to
+// disk.
+func (b *boltBackend) update(fn func(w storageWriter) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltWriter{tx.Bucket([]byte(boltBucket))})
+	})
+}
+
+// iterate reads the records in chunks, each in its own read transaction. A
+// long running read transaction keeps the database file from growing, which
+// stalls the writers.
+func (b *boltBackend) iterate(prefix []byte, fn func(key, val []byte) bool) error {
+	from := prefix
+	seenFrom := false
+	for {
+		var keys, vals [][]byte
+		err := b.db.View(func(tx *bolt.Tx) error {
+			c := tx.Bucket([]byte(boltBucket)).Cursor()
+			k, v := c.Seek(from)
+			if seenFrom && bytes.Equal(k, from) {
+				k, v = c.Next()
+			}
+			for ; k != nil && bytes.HasPrefix(k, prefix) && len(keys) < boltIterateChunk; k, v = c.Next() {
+				// Keys and values are only valid during the transaction.
+				keys = append(keys, append([]byte{}, k...))
+				vals = append(vals, append([]byte{}, v...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range keys {
+			if !fn(keys[i], vals[i]) {
+				return nil
+			}
+		}
+		if len(keys) < boltIterateChunk {
+			return nil
+		}
+		from = keys[len(keys)-1]
+		seenFrom = true
+	}
+}
+
+func (b *boltBackend) close() error {
+	return b.db.Close()
+}
+
+type boltWriter struct {
+	bucket *bolt.Bucket
+}
+
+func (w boltWriter) get(key []byte) ([]byte, error) {
+	v := w.bucket.Get(key)
+	if v == nil {
+		return nil, errNotFound
+	}
+	return v, nil
+}
+
+func (w boltWriter) put(key, val []byte) error {
+	// The key and value must remain valid for the life of the transaction,
+	// while the caller may reuse its buffers.
+	return w.bucket.Put(append([]byte{}, key...), append([]byte{}, val...))
+}
+
+func (w boltWriter) delete(key []byte) error {
+	return w.bucket.Delete(key)
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type levelDBBackend struct {
+	db *leveldb.DB
+}
+
+func openLevelDBBackend(dir string) (*levelDBBackend, error) {
+	db, err := leveldb.OpenFile(dir, levelDBOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &levelDBBackend{db: db}, nil
+}
+
+func (b *levelDBBackend) get(key []byte) ([]byte, error) {
+	val, err := b.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, errNotFound
+	}
+	return val, err
+}
+
+// update applies the changes as they are made. Writes aren't synced to disk
+// by leveldb, so there's nothing to gain from committing them together.
+func (b *levelDBBackend) update(fn func(w storageWriter) error) error {
+	return fn(b)
+}
+
+func (b *levelDBBackend) put(key, val []byte) error {
+	return b.db.Put(key, val, nil)
+}
+
+func (b *levelDBBackend) delete(key []byte) error {
+	return b.db.Delete(key, nil)
+}
+
+func (b *levelDBBackend) iterate(prefix []byte, fn func(key, val []byte) bool) error {
+	iter := b.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		if !fn(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+	return iter.Error()
+}
+
+func (b *levelDBBackend) close() error {
+	return b.db.Close()
+}
@@ -14,9 +14,6 @@ import (
 	"log"
 	"sort"
 	"time"
-
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 type clock interface {
@@ -33,45 +30,47 @@ type database interface {
 	put(key string, rec DatabaseRecord) error
 	merge(key string, addrs []DatabaseAddress, seen int64) error
 	get(key string) (DatabaseRecord, error)
+	// iterate calls fn with each record whose key has the given prefix, in
+	// key order, until fn returns false. Expired addresses are removed.
+	iterate(prefix string, fn func(key string, rec DatabaseRecord) bool) error
 }
 
-type levelDBStore struct {
-	db         *leveldb.DB
-	inbox      chan func()
+// The recordStore implements the database on top of a storage backend,
+// serializing all writes.
+type recordStore struct {
+	db         storageBackend
+	inbox      chan storeWrite
 	clock      clock
 	marshalBuf []byte
 }
 
-func newLevelDBStore(dir string) (*levelDBStore, error) {
-	db, err := leveldb.OpenFile(dir, levelDBOptions)
-	if err != nil {
-		return nil, err
-	}
-	return &levelDBStore{
-		db:    db,
-		inbox: make(chan func(), 16),
+// A storeWrite is a change to be made by the serialized writer, which sends
+// its outcome on rc.
+type storeWrite struct {
+	fn func(w storageWriter) error
+	rc chan error
+}
+
+// The most writes committed together in one update.
+const maxStoreBatch = 64
+
+func newRecordStore(backend storageBackend) *recordStore {
+	return &recordStore{
+		db:    backend,
+		inbox: make(chan storeWrite, 16),
 		clock: defaultClock{},
-	}, nil
+	}
 }
 
-func (s *levelDBStore) put(key string, rec DatabaseRecord) error {
+func (s *recordStore) put(key string, rec DatabaseRecord) error {
 	t0 := time.Now()
 	defer func() {
 		databaseOperationSeconds.WithLabelValues(dbOpPut).Observe(time.Since(t0).Seconds())
 	}()
 
-	rc := make(chan error)
-
-	s.inbox <- func() {
-		size := rec.Size()
-		if len(s.marshalBuf) < size {
-			s.marshalBuf = make([]byte, size)
-		}
-		n, _ := rec.MarshalTo(s.marshalBuf)
-		rc <- s.db.Put([]byte(key), s.marshalBuf[:n], nil)
-	}
-
-	err := <-rc
+	err := s.write(func(w storageWriter) error {
+		return s.putRecord(w, key, rec)
+	})
 	if err != nil {
 		databaseOperations.WithLabelValues(dbOpPut, dbResError).Inc()
 	} else {
@@ -81,41 +80,28 @@ func (s *levelDBStore) put(key string, rec DatabaseRecord) error {
 	return err
 }
 
-func (s *levelDBStore) merge(key string, addrs []DatabaseAddress, seen int64) error {
+func (s *recordStore) merge(key string, addrs []DatabaseAddress, seen int64) error {
 	t0 := time.Now()
 	defer func() {
 		databaseOperationSeconds.WithLabelValues(dbOpMerge).Observe(time.Since(t0).Seconds())
 	}()
 
-	rc := make(chan error)
 	newRec := DatabaseRecord{
 		Addresses: addrs,
 		Seen:      seen,
 	}
 
-	s.inbox <- func() {
+	// The get and put are serialized together, within the same update.
+	err := s.write(func(w storageWriter) error {
 		// grab the existing record
-		oldRec, err := s.get(key)
+		oldRec, err := s.getRecord(w, key)
 		if err != nil {
 			// "not found" is not an error from get, so this is serious
 			// stuff only
-			rc <- err
-			return
+			return err
 		}
-		newRec = merge(newRec, oldRec)
-
-		// We replicate s.put() functionality here ourselves instead of
-		// calling it because we want to serialize our get above together
-		// with the put in the same function.
-		size := newRec.Size()
-		if len(s.marshalBuf) < size {
-			s.marshalBuf = make([]byte, size)
-		}
-		n, _ := newRec.MarshalTo(s.marshalBuf)
-		rc <- s.db.Put([]byte(key), s.marshalBuf[:n], nil)
-	}
-
-	err := <-rc
+		return s.putRecord(w, key, merge(newRec, oldRec))
+	})
 	if err != nil {
 		databaseOperations.WithLabelValues(dbOpMerge, dbResError).Inc()
 	} else {
@@ -125,15 +111,36 @@ func (s *levelDBStore) merge(key string, addrs []DatabaseAddress, seen int64) er
 	return err
 }
 
-func (s *levelDBStore) get(key string) (DatabaseRecord, error) {
+// write has fn run by the serialized writer, returning its outcome.
+func (s *recordStore) write(fn func(w storageWriter) error) error {
+	rc := make(chan error, 1)
+	s.inbox <- storeWrite{fn: fn, rc: rc}
+	return <-rc
+}
+
+// putRecord is only called by the serialized writer, which owns marshalBuf.
+func (s *recordStore) putRecord(w storageWriter, key string, rec DatabaseRecord) error {
+	size := rec.Size()
+	if len(s.marshalBuf) < size {
+		s.marshalBuf = make([]byte, size)
+	}
+	n, _ := rec.MarshalTo(s.marshalBuf)
+	return w.put([]byte(key), s.marshalBuf[:n])
+}
+
+func (s *recordStore) get(key string) (DatabaseRecord, error) {
 	t0 := time.Now()
 	defer func() {
 		databaseOperationSeconds.WithLabelValues(dbOpGet).Observe(time.Since(t0).Seconds())
 	}()
 
+	return s.getRecord(s.db, key)
+}
+
+func (s *recordStore) getRecord(r storageReader, key string) (DatabaseRecord, error) {
 	keyBs := []byte(key)
-	val, err := s.db.Get(keyBs, nil)
-	if err == leveldb.ErrNotFound {
+	val, err := r.get(keyBs)
+	if err == errNotFound {
 		databaseOperations.WithLabelValues(dbOpGet, dbResNotFound).Inc()
 		return DatabaseRecord{}, nil
 	}
@@ -154,10 +161,23 @@ func (s *levelDBStore) get(key string) (DatabaseRecord, error) {
 	return rec, nil
 }
 
-func (s *levelDBStore) Serve(ctx context.Context) error {
+func (s *recordStore) iterate(prefix string, fn func(key string, rec DatabaseRecord) bool) error {
+	nowNanos := s.clock.Now().UnixNano()
+	return s.db.iterate([]byte(prefix), func(key, val []byte) bool {
+		var rec DatabaseRecord
+		if err := rec.Unmarshal(val); err != nil {
+			databaseOperations.WithLabelValues(dbOpGet, dbResUnmarshalError).Inc()
+			return true
+		}
+		rec.Addresses = expire(rec.Addresses, nowNanos)
+		return fn(string(key), rec)
+	})
+}
+
+func (s *recordStore) Serve(ctx context.Context) error {
 	t := time.NewTimer(0)
 	defer t.Stop()
-	defer s.db.Close()
+	defer s.db.close()
 
 	// Start the statistics serve routine. It will exit with us when
 	// statisticsTrigger is closed.
@@ -168,9 +188,21 @@ func (s *levelDBStore) Serve(ctx context.Context) error {
 loop:
 	for {
 		select {
-		case fn := <-s.inbox:
-			// Run function in serialized order.
-			fn()
+		case first := <-s.inbox:
+			// Run the writes in serialized order. Whatever else is queued
+			// is committed together with the first, to share the cost of
+			// syncing to disk.
+			writes := []storeWrite{first}
+		batch:
+			for len(writes) < maxStoreBatch {
+				select {
+				case w := <-s.inbox:
+					writes = append(writes, w)
+				default:
+					break batch
+				}
+			}
+			s.runWrites(writes)
 
 		case <-t.C:
 			// Trigger the statistics routine to do its thing in the
@@ -195,7 +227,25 @@ loop:
 	return nil
 }
 
-func (s *levelDBStore) statisticsServe(trigger <-chan struct{}, done chan<- struct{}) {
+func (s *recordStore) runWrites(writes []storeWrite) {
+	errs := make([]error, len(writes))
+	err := s.db.update(func(w storageWriter) error {
+		for i, write := range writes {
+			errs[i] = write.fn(w)
+		}
+		return nil
+	})
+	for i, write := range writes {
+		if err != nil {
+			// Nothing was committed.
+			write.rc <- err
+		} else {
+			write.rc <- errs[i]
+		}
+	}
+}
+
+func (s *recordStore) statisticsServe(trigger <-chan struct{}, done chan<- struct{}) {
 	defer close(done)
 
 	for range trigger {
@@ -205,15 +255,15 @@ func (s *levelDBStore) statisticsServe(trigger <-chan struct{}, done chan<- stru
 		cutoff1w := t0.Add(-7 * 24 * time.Hour).UnixNano()
 		cutoff2Mon := t0.Add(-60 * 24 * time.Hour).UnixNano()
 		current, last24h, last1w, inactive, errors := 0, 0, 0, 0, 0
+		var stale [][]byte
 
-		iter := s.db.NewIterator(&util.Range{}, nil)
-		for iter.Next() {
+		err := s.db.iterate(nil, func(key, val []byte) bool {
 			// Attempt to unmarshal the record and count the
 			// failure if there's something wrong with it.
 			var rec DatabaseRecord
-			if err := rec.Unmarshal(iter.Value()); err != nil {
+			if err := rec.Unmarshal(val); err != nil {
 				errors++
-				continue
+				return true
 			}
 
 			// If there are addresses that have not expired it's a current
@@ -231,19 +281,32 @@ func (s *levelDBStore) statisticsServe(trigger <-chan struct{}, done chan<- stru
 			case rec.Missed < cutoff2Mon:
 				// It hasn't been seen lately and we haven't recorded
 				// someone asking for this device in a long time either;
-				// delete the record.
-				if err := s.db.Delete(iter.Key(), nil); err != nil {
+				// delete the record. Not all backends allow changes while
+				// iterating, so that happens afterwards.
+				stale = append(stale, append([]byte{}, key...))
+			default:
+				inactive++
+			}
+			return true
+		})
+		if err != nil {
+			log.Println("Database statistics:", err)
+		}
+
+		err = s.db.update(func(w storageWriter) error {
+			for _, key := range stale {
+				if err := w.delete(key); err != nil {
 					databaseOperations.WithLabelValues(dbOpDelete, dbResError).Inc()
 				} else {
 					databaseOperations.WithLabelValues(dbOpDelete, dbResSuccess).Inc()
 				}
-			default:
-				inactive++
 			}
+			return nil
+		})
+		if err != nil {
+			log.Println("Database cleanup:", err)
 		}
 
-		iter.Release()
-
 		databaseKeys.WithLabelValues("current").Set(float64(current))
 		databaseKeys.WithLabelValues("last24h").Set(float64(last24h))
 		databaseKeys.WithLabelValues("last1w").Set(float64(last1w))
@@ -15,12 +15,21 @@ import (
 )
 
 func TestDatabaseGetSet(t *testing.T) {
+	for _, kind := range []string{backendLevelDB, backendBolt} {
+		t.Run(kind, func(t *testing.T) {
+			testDatabaseGetSet(t, kind)
+		})
+	}
+}
+
+func testDatabaseGetSet(t *testing.T, kind string) {
 	os.RemoveAll("_database")
 	defer os.RemoveAll("_database")
-	db, err := newLevelDBStore("_database")
+	backend, err := openBackend(kind, "_database")
 	if err != nil {
 		t.Fatal(err)
 	}
+	db := newRecordStore(backend)
 	ctx, cancel := context.WithCancel(context.Background())
 	go db.Serve(ctx)
 	defer cancel()
@@ -54,6 +54,16 @@ const (
 
 	// Size of the replication outbox channel
 	replicationOutboxSize = 10000
+
+	// How often we check the allowlist files for changes
+	allowlistReloadInterval = 10 * time.Second
+
+	// Rate limited clients are told to come back after
+	// rateLimitRetryAfterSeconds + random(rateLimitRetryFuzzSeconds), and
+	// forgotten after being idle for rateLimitIdleTime.
+	rateLimitRetryAfterSeconds = 60
+	rateLimitRetryFuzzSeconds  = 30
+	rateLimitIdleTime          = 10 * time.Minute
 )
 
 // These options make the database a little more optimized for writes, at
@@ -71,23 +81,31 @@ var (
 func main() {
 	var listen string
 	var dir string
+	var dbBackend string
 	var metricsListen string
 	var replicationListen string
 	var replicationPeers string
 	var certFile string
 	var keyFile string
 	var useHTTP bool
+	var allowlistPath string
+	var rateLimit float64
+	var rateBurst int
 
 	log.SetOutput(os.Stdout)
 	log.SetFlags(0)
 
+	flag.StringVar(&allowlistPath, "allowlist", "", "File or directory of device IDs allowed to announce and look up (default allow all)")
 	flag.StringVar(&certFile, "cert", "./cert.pem", "Certificate file")
+	flag.StringVar(&dbBackend, "db-backend", backendLevelDB, "Database backend (leveldb or bolt)")
 	flag.StringVar(&dir, "db-dir", "./discovery.db", "Database directory")
 	flag.BoolVar(&debug, "debug", false, "Print debug output")
 	flag.BoolVar(&useHTTP, "http", false, "Listen on HTTP (behind an HTTPS proxy)")
 	flag.StringVar(&listen, "listen", ":8443", "Listen address")
 	flag.StringVar(&keyFile, "key", "./key.pem", "Key file")
 	flag.StringVar(&metricsListen, "metrics-listen", "", "Metrics listen address")
+	flag.Float64Var(&rateLimit, "rate-limit", 0, "Requests per second allowed per client (0 for no limit)")
+	flag.IntVar(&rateBurst, "rate-burst", 10, "Requests allowed per client in a burst over the rate limit")
 	flag.StringVar(&replicationPeers, "replicate", "", "Replication peers, id@address, comma separated")
 	flag.StringVar(&replicationListen, "replication-listen", ":19200", "Replication listen address")
 	showVersion := flag.Bool("version", false, "Show version")
@@ -156,28 +174,51 @@ func main() {
 	})
 
 	// Start the database.
-	db, err := newLevelDBStore(dir)
+	backend, err := openBackend(dbBackend, dir)
 	if err != nil {
 		log.Fatalln("Open database:", err)
 	}
+	db := newRecordStore(backend)
 	main.Add(db)
 
+	// Load the allowlist, if any, and keep it up to date.
+	var allow *allowlist
+	if allowlistPath != "" {
+		allow, err = newAllowlist(allowlistPath)
+		if err != nil {
+			log.Fatalln("Load allowlist:", err)
+		}
+		main.Add(allow)
+	}
+
+	// With an allowlist, records of other devices are neither served nor
+	// replicated.
+	var records database = db
+	if allow != nil {
+		records = allowlistDatabase{database: db, allow: allow}
+	}
+
 	// Start any replication senders.
 	var repl replicationMultiplexer
 	for _, dst := range replicationDestinations {
-		rs := newReplicationSender(dst, cert, allowedReplicationPeers)
+		rs := newReplicationSender(dst, cert, allowedReplicationPeers, records)
 		main.Add(rs)
 		repl = append(repl, rs)
 	}
 
 	// If we have replication configured, start the replication listener.
 	if len(allowedReplicationPeers) > 0 {
-		rl := newReplicationListener(replicationListen, cert, allowedReplicationPeers, db)
+		rl := newReplicationListener(replicationListen, cert, allowedReplicationPeers, records)
 		main.Add(rl)
 	}
 
+	var limiter *clientLimiter
+	if rateLimit > 0 {
+		limiter = newClientLimiter(rateLimit, rateBurst)
+	}
+
 	// Start the main API server.
-	qs := newAPISrv(listen, cert, db, repl, useHTTP)
+	qs := newAPISrv(listen, cert, records, repl, useHTTP, allow, limiter)
 	main.Add(qs)
 
 	// If we have a metrics port configured, start a metrics handler.
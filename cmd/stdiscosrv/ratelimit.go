@@ -0,0 +1,68 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// A clientLimiter keeps a token bucket per client.
+type clientLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mut       sync.Mutex
+	clients   map[string]*clientBucket
+	lastPrune time.Time
+}
+
+type clientBucket struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+func newClientLimiter(perSecond float64, burst int) *clientLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &clientLimiter{
+		limit:     rate.Limit(perSecond),
+		burst:     burst,
+		clients:   make(map[string]*clientBucket),
+		lastPrune: time.Now(),
+	}
+}
+
+// allow returns whether the client may make another request now.
+func (l *clientLimiter) allow(client string) bool {
+	now := time.Now()
+
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	if now.Sub(l.lastPrune) > rateLimitIdleTime {
+		// Forget clients that have been quiet long enough for their bucket
+		// to be full again anyway.
+		for key, b := range l.clients {
+			if now.Sub(b.lastSeen) > rateLimitIdleTime {
+				delete(l.clients, key)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	b, ok := l.clients[client]
+	if !ok {
+		b = &clientBucket{Limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[client] = b
+	}
+	b.lastSeen = now
+	return b.AllowN(now, 1)
+}
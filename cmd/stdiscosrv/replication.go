@@ -7,13 +7,19 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash"
 	io "io"
 	"log"
 	"net"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -21,6 +27,31 @@ import (
 
 const replicationReadTimeout = time.Minute
 const replicationHeartbeatInterval = time.Second * 30
+const replicationAntiEntropyInterval = 10 * time.Minute
+
+// Live replication only reaches peers that are connected at the time. To
+// catch up on what they missed, senders periodically send digests of their
+// records per key range. Keys are device IDs, so a range is given by a
+// prefix in the base32 alphabet, starting with the single characters. A
+// peer receiving digests answers those that differ from its own either with
+// the digests of the sub-ranges one character longer, or, when the range is
+// small enough, with its records in the range followed by a request for the
+// other side's. Both sides handle digests and requests the same way.
+const antiEntropyPrefixes = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+
+const (
+	// Ranges with at most this many records are exchanged in whole rather
+	// than narrowed down further.
+	antiEntropyLeafRecords = 64
+	// The longest key range prefix. Device IDs have a dash after the
+	// seventh character.
+	antiEntropyMaxDepth = 4
+	// Addresses that expire within this time are left out of the digests,
+	// as the peers expire them at slightly different times.
+	antiEntropyExpiryMargin = 5 * time.Minute
+	// The most anti-entropy messages queued from a peer.
+	antiEntropyQueueSize = 1024
+)
 
 type replicator interface {
 	send(key string, addrs []DatabaseAddress, seen int64)
@@ -32,14 +63,16 @@ type replicationSender struct {
 	dst        string
 	cert       tls.Certificate // our certificate
 	allowedIDs []protocol.DeviceID
+	db         database
 	outbox     chan ReplicationRecord
 }
 
-func newReplicationSender(dst string, cert tls.Certificate, allowedIDs []protocol.DeviceID) *replicationSender {
+func newReplicationSender(dst string, cert tls.Certificate, allowedIDs []protocol.DeviceID, db database) *replicationSender {
 	return &replicationSender{
 		dst:        dst,
 		cert:       cert,
 		allowedIDs: allowedIDs,
+		db:         db,
 		outbox:     make(chan ReplicationRecord, replicationOutboxSize),
 	}
 }
@@ -81,11 +114,29 @@ func (s *replicationSender) Serve(ctx context.Context) error {
 		return err
 	}
 
+	// Read the anti-entropy replies. The reader exits when the connection
+	// is closed on return.
+	work := newAntiEntropyQueue()
+	readErr := make(chan error, 1)
+	go func() {
+		readErr <- s.readReplies(conn, work)
+	}()
+
 	heartBeatTicker := time.NewTicker(replicationHeartbeatInterval)
 	defer heartBeatTicker.Stop()
 
+	// Start anti-entropy right away, to catch up on whatever happened
+	// while we were disconnected.
+	antiEntropyTimer := time.NewTimer(0)
+	defer antiEntropyTimer.Stop()
+
 	// Send records.
 	buf := make([]byte, 1024)
+	write := func(rec ReplicationRecord) error {
+		var err error
+		buf, err = writeReplicationRecord(conn, rec, buf)
+		return err
+	}
 	for {
 		select {
 		case <-heartBeatTicker.C:
@@ -98,32 +149,29 @@ func (s *replicationSender) Serve(ctx context.Context) error {
 			s.outbox <- ReplicationRecord{}
 
 		case rec := <-s.outbox:
-			// Buffer must hold record plus four bytes for size
-			size := rec.Size()
-			if len(buf) < size+4 {
-				buf = make([]byte, size+4)
+			if err := write(rec); err != nil {
+				// Yes, we are loosing the replication event here.
+				return err
 			}
 
-			// Record comes after the four bytes size
-			n, err := rec.MarshalTo(buf[4:])
+		case <-antiEntropyTimer.C:
+			digests, err := digestRecords(s.db, "")
 			if err != nil {
-				// odd to get an error here, but we haven't sent anything
-				// yet so it's not fatal
-				replicationSendsTotal.WithLabelValues("error").Inc()
-				log.Println("Replication marshal:", err)
-				continue
+				log.Println("Replication digest:", err)
+			} else if err := write(ReplicationRecord{Digests: digests}); err != nil {
+				return err
 			}
-			binary.BigEndian.PutUint32(buf, uint32(n))
+			antiEntropyTimer.Reset(replicationAntiEntropyInterval)
 
-			// Send
-			conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
-			if _, err := conn.Write(buf[:4+n]); err != nil {
-				replicationSendsTotal.WithLabelValues("error").Inc()
-				log.Println("Replication write:", err)
-				// Yes, we are loosing the replication event here.
-				return err
+		case <-work.ready:
+			for _, rec := range work.pop() {
+				if err := answerAntiEntropy(s.db, rec, write); err != nil {
+					return err
+				}
 			}
-			replicationSendsTotal.WithLabelValues("success").Inc()
+
+		case err := <-readErr:
+			return err
 
 		case <-ctx.Done():
 			return nil
@@ -131,6 +179,31 @@ func (s *replicationSender) Serve(ctx context.Context) error {
 	}
 }
 
+// readReplies reads the records sent back to us as part of anti-entropy,
+// storing them and queueing the digests and requests to be answered.
+func (s *replicationSender) readReplies(conn net.Conn, work *antiEntropyQueue) error {
+	buf := make([]byte, 1024)
+	for {
+		var rec ReplicationRecord
+		var err error
+		rec, buf, err = readReplicationRecord(conn, buf)
+		if err == errBadRecord {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		switch {
+		case len(rec.Digests) > 0 || len(rec.Requests) > 0:
+			work.push(rec)
+
+		case rec.Key != "":
+			s.db.merge(rec.Key, rec.Addresses, rec.Seen)
+			replicationRecvsTotal.WithLabelValues("success").Inc()
+		}
+	}
+}
+
 func (s *replicationSender) String() string {
 	return fmt.Sprintf("replicationSender(%q)", s.dst)
 }
@@ -139,6 +212,7 @@ func (s *replicationSender) send(key string, ps []DatabaseAddress, seen int64) {
 	item := ReplicationRecord{
 		Key:       key,
 		Addresses: ps,
+		Seen:      seen,
 	}
 
 	// The send should never block. The inbox is suitably buffered for at
@@ -238,6 +312,13 @@ func (l *replicationListener) handle(ctx context.Context, conn net.Conn) {
 		conn.Close()
 	}()
 
+	// Anti-entropy answers are written in the background, so that we keep
+	// reading meanwhile.
+	work := newAntiEntropyQueue()
+	done := make(chan struct{})
+	defer close(done)
+	go l.answer(conn, work, done)
+
 	buf := make([]byte, 1024)
 
 	for {
@@ -249,11 +330,201 @@ func (l *replicationListener) handle(ctx context.Context, conn net.Conn) {
 
 		conn.SetReadDeadline(time.Now().Add(replicationReadTimeout))
 
+		var rec ReplicationRecord
+		var err error
+		rec, buf, err = readReplicationRecord(conn, buf)
+		if err == errBadRecord {
+			continue
+		} else if err != nil {
+			return
+		}
+
+		switch {
+		case len(rec.Digests) > 0 || len(rec.Requests) > 0:
+			work.push(rec)
+
+		case rec.Key != "":
+			// Store
+			l.db.merge(rec.Key, rec.Addresses, rec.Seen)
+			replicationRecvsTotal.WithLabelValues("success").Inc()
+		}
+	}
+}
+
+// answer writes the answers to the queued anti-entropy messages until done
+// is closed.
+func (l *replicationListener) answer(conn net.Conn, work *antiEntropyQueue, done <-chan struct{}) {
+	buf := make([]byte, 1024)
+	write := func(rec ReplicationRecord) error {
+		var err error
+		buf, err = writeReplicationRecord(conn, rec, buf)
+		return err
+	}
+	for {
+		select {
+		case <-work.ready:
+			for _, rec := range work.pop() {
+				if err := answerAntiEntropy(l.db, rec, write); err != nil {
+					// Makes the reading side give up as well.
+					conn.Close()
+					return
+				}
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// An antiEntropyQueue passes the anti-entropy messages read from a peer on
+// to the writing side of the connection. Pushing never blocks, as both
+// peers may be busy writing records to each other.
+type antiEntropyQueue struct {
+	mut   sync.Mutex
+	recs  []ReplicationRecord
+	ready chan struct{}
+}
+
+func newAntiEntropyQueue() *antiEntropyQueue {
+	return &antiEntropyQueue{
+		ready: make(chan struct{}, 1),
+	}
+}
+
+func (q *antiEntropyQueue) push(rec ReplicationRecord) {
+	q.mut.Lock()
+	if len(q.recs) < antiEntropyQueueSize {
+		q.recs = append(q.recs, rec)
+	} else {
+		replicationRecvsTotal.WithLabelValues("drop").Inc()
+	}
+	q.mut.Unlock()
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (q *antiEntropyQueue) pop() []ReplicationRecord {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	recs := q.recs
+	q.recs = nil
+	return recs
+}
+
+// answerAntiEntropy answers the digests or requests in the record. Only
+// write errors are returned.
+func answerAntiEntropy(db database, rec ReplicationRecord, write func(ReplicationRecord) error) error {
+	if len(rec.Digests) > 0 {
+		return answerDigests(db, rec.Digests, write)
+	}
+	for _, prefix := range rec.Requests {
+		if err := sendRecords(db, prefix, write); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// answerDigests compares the remote digests with ours. Small ranges that
+// differ are exchanged in whole, by sending our records and requesting the
+// remote ones, while larger ones are narrowed down by sending the digests of
+// their sub-ranges.
+func answerDigests(db database, remote []ReplicationDigest, write func(ReplicationRecord) error) error {
+	var narrowed []ReplicationDigest
+	var requests []string
+	matched := 0
+	for _, digest := range remote {
+		if !validAntiEntropyPrefix(digest.Prefix) {
+			log.Printf("Replication anti-entropy: unexpected key range %q", digest.Prefix)
+			continue
+		}
+		local, records, err := digestRange(db, digest.Prefix)
+		if err != nil {
+			log.Println("Replication digest:", err)
+			return nil
+		}
+		if bytes.Equal(local.Hash, digest.Hash) {
+			matched++
+			continue
+		}
+
+		if records > antiEntropyLeafRecords && len(digest.Prefix) < antiEntropyMaxDepth {
+			subs, err := digestRecords(db, digest.Prefix)
+			if err != nil {
+				log.Println("Replication digest:", err)
+				return nil
+			}
+			narrowed = append(narrowed, subs...)
+			continue
+		}
+
+		if err := sendRecords(db, digest.Prefix, write); err != nil {
+			return err
+		}
+		if len(digest.Hash) > 0 {
+			requests = append(requests, digest.Prefix)
+		}
+	}
+	replicationAntiEntropyRangesTotal.WithLabelValues("match").Add(float64(matched))
+	replicationAntiEntropyRangesTotal.WithLabelValues("mismatch").Add(float64(len(remote) - matched))
+
+	if len(narrowed) > 0 {
+		if err := write(ReplicationRecord{Digests: narrowed}); err != nil {
+			return err
+		}
+	}
+	if len(requests) > 0 {
+		return write(ReplicationRecord{Requests: requests})
+	}
+	return nil
+}
+
+var errBadRecord = errors.New("bad replication record")
+
+// writeReplicationRecord writes the record preceded by its size, using and
+// returning the possibly grown buffer. Only write errors are returned; a
+// record that fails to marshal is skipped.
+func writeReplicationRecord(conn net.Conn, rec ReplicationRecord, buf []byte) ([]byte, error) {
+	// Buffer must hold record plus four bytes for size
+	size := rec.Size()
+	if len(buf) < size+4 {
+		buf = make([]byte, size+4)
+	}
+
+	// Record comes after the four bytes size
+	n, err := rec.MarshalTo(buf[4:])
+	if err != nil {
+		// odd to get an error here, but we haven't sent anything
+		// yet so it's not fatal
+		replicationSendsTotal.WithLabelValues("error").Inc()
+		log.Println("Replication marshal:", err)
+		return buf, nil
+	}
+	binary.BigEndian.PutUint32(buf, uint32(n))
+
+	// Send
+	conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Write(buf[:4+n]); err != nil {
+		replicationSendsTotal.WithLabelValues("error").Inc()
+		log.Println("Replication write:", err)
+		return buf, err
+	}
+	replicationSendsTotal.WithLabelValues("success").Inc()
+	return buf, nil
+}
+
+// readReplicationRecord reads the next record, using and returning the
+// possibly grown buffer. Heartbeats are skipped. A record that fails to
+// unmarshal results in errBadRecord, after which reading may continue.
+func readReplicationRecord(conn net.Conn, buf []byte) (ReplicationRecord, []byte, error) {
+	for {
 		// First four bytes are the size
 		if _, err := io.ReadFull(conn, buf[:4]); err != nil {
 			log.Println("Replication read size:", err)
 			replicationRecvsTotal.WithLabelValues("error").Inc()
-			return
+			return ReplicationRecord{}, buf, err
 		}
 
 		// Read the rest of the record
@@ -270,7 +541,7 @@ func (l *replicationListener) handle(ctx context.Context, conn net.Conn) {
 		if _, err := io.ReadFull(conn, buf[:size]); err != nil {
 			log.Println("Replication read record:", err)
 			replicationRecvsTotal.WithLabelValues("error").Inc()
-			return
+			return ReplicationRecord{}, buf, err
 		}
 
 		// Unmarshal
@@ -278,13 +549,133 @@ func (l *replicationListener) handle(ctx context.Context, conn net.Conn) {
 		if err := rec.Unmarshal(buf[:size]); err != nil {
 			log.Println("Replication unmarshal:", err)
 			replicationRecvsTotal.WithLabelValues("error").Inc()
+			return ReplicationRecord{}, buf, errBadRecord
+		}
+		return rec, buf, nil
+	}
+}
+
+// digestRecords returns the digests of our current records in each of the
+// key ranges one character longer than the given prefix.
+func digestRecords(db database, prefix string) ([]ReplicationDigest, error) {
+	hashes := make([]hash.Hash, len(antiEntropyPrefixes))
+	for i := range hashes {
+		hashes[i] = sha256.New()
+	}
+	records := make([]int, len(antiEntropyPrefixes))
+	cutoff := time.Now().Add(antiEntropyExpiryMargin).UnixNano()
+	err := db.iterate(prefix, func(key string, rec DatabaseRecord) bool {
+		if len(key) <= len(prefix) {
+			return true
+		}
+		i := strings.IndexByte(antiEntropyPrefixes, key[len(prefix)])
+		if i < 0 {
+			return true
+		}
+		if hashRecord(hashes[i], key, rec, cutoff) {
+			records[i]++
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	digests := make([]ReplicationDigest, len(antiEntropyPrefixes))
+	for i, c := range antiEntropyPrefixes {
+		digests[i].Prefix = prefix + string(c)
+		if records[i] > 0 {
+			digests[i].Hash = hashes[i].Sum(nil)
+		}
+	}
+	return digests, nil
+}
+
+// digestRange returns the digest of our current records in the key range,
+// and the number of records it covers.
+func digestRange(db database, prefix string) (ReplicationDigest, int, error) {
+	h := sha256.New()
+	records := 0
+	cutoff := time.Now().Add(antiEntropyExpiryMargin).UnixNano()
+	err := db.iterate(prefix, func(key string, rec DatabaseRecord) bool {
+		if hashRecord(h, key, rec, cutoff) {
+			records++
+		}
+		return true
+	})
+	if err != nil {
+		return ReplicationDigest{}, 0, err
+	}
+
+	digest := ReplicationDigest{Prefix: prefix}
+	if records > 0 {
+		digest.Hash = h.Sum(nil)
+	}
+	return digest, records, nil
+}
+
+// hashRecord adds the key and addresses of the record to the hash, leaving
+// out the addresses that expire before the cutoff. The expiry times
+// themselves aren't hashed, as they change with every announcement. It
+// returns whether anything was added.
+func hashRecord(h hash.Hash, key string, rec DatabaseRecord, cutoff int64) bool {
+	added := false
+	for _, addr := range sortedAddressCopy(rec.Addresses) {
+		if addr.Expires < cutoff {
 			continue
 		}
+		if !added {
+			h.Write([]byte(key))
+			h.Write([]byte{0})
+			added = true
+		}
+		h.Write([]byte(addr.Address))
+		h.Write([]byte{0})
+	}
+	if added {
+		h.Write([]byte{0})
+	}
+	return added
+}
 
-		// Store
-		l.db.merge(rec.Key, rec.Addresses, rec.Seen)
-		replicationRecvsTotal.WithLabelValues("success").Inc()
+func validAntiEntropyPrefix(prefix string) bool {
+	if len(prefix) == 0 || len(prefix) > antiEntropyMaxDepth {
+		return false
+	}
+	for _, c := range prefix {
+		if !strings.ContainsRune(antiEntropyPrefixes, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// sendRecords streams our current records in the key range to the peer.
+// Only write errors are returned.
+func sendRecords(db database, prefix string, write func(ReplicationRecord) error) error {
+	if !validAntiEntropyPrefix(prefix) {
+		log.Printf("Replication anti-entropy: unexpected key range %q", prefix)
+		return nil
+	}
+	var writeErr error
+	err := db.iterate(prefix, func(key string, rec DatabaseRecord) bool {
+		if len(rec.Addresses) == 0 {
+			return true
+		}
+		writeErr = write(ReplicationRecord{
+			Key:       key,
+			Addresses: sortedAddressCopy(rec.Addresses),
+			Seen:      rec.Seen,
+		})
+		return writeErr == nil
+	})
+	if writeErr != nil {
+		return writeErr
+	}
+	if err != nil {
+		log.Println("Replication anti-entropy:", err)
 	}
+	return nil
 }
 
 func deviceID(conn *tls.Conn) (protocol.DeviceID, error) {
@@ -0,0 +1,208 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestAntiEntropy(t *testing.T) {
+	a := newTestStore(t, backendLevelDB)
+	b := newTestStore(t, backendBolt)
+
+	expires := time.Now().Add(time.Hour).UnixNano()
+	shared := []DatabaseAddress{{Address: "tcp://192.0.2.1:22000", Expires: expires}}
+	for _, db := range []*recordStore{a, b} {
+		if err := db.merge("AAAA", shared, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Only a has this one.
+	if err := a.merge("BBBB", []DatabaseAddress{{Address: "tcp://192.0.2.2:22000", Expires: expires}}, 2); err != nil {
+		t.Fatal(err)
+	}
+	// b has a newer address for this one.
+	if err := a.merge("CCCC", []DatabaseAddress{{Address: "tcp://192.0.2.3:22000", Expires: expires}}, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.merge("CCCC", []DatabaseAddress{{Address: "tcp://192.0.2.4:22000", Expires: expires}}, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	if mismatched := mismatchedRanges(t, a, b); !reflect.DeepEqual(mismatched, []string{"B", "C"}) {
+		t.Fatalf("unexpected mismatched ranges %v", mismatched)
+	}
+
+	if sent := antiEntropyRound(t, a, b); sent != 3 {
+		t.Errorf("expected three records to be exchanged, not %d", sent)
+	}
+
+	// Now they agree, on the union of the records.
+	if mismatched := mismatchedRanges(t, a, b); len(mismatched) != 0 {
+		t.Errorf("unexpected mismatched ranges after sync: %v", mismatched)
+	}
+	if sent := antiEntropyRound(t, a, b); sent != 0 {
+		t.Errorf("unexpected %d records exchanged after sync", sent)
+	}
+	rec, err := b.get("CCCC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Addresses) != 2 || rec.Seen != 4 {
+		t.Errorf("unexpected merged record %v", rec)
+	}
+}
+
+func TestAntiEntropyNarrowsRanges(t *testing.T) {
+	a := newTestStore(t, backendLevelDB)
+	b := newTestStore(t, backendBolt)
+
+	expires := time.Now().Add(time.Hour).UnixNano()
+	var key string
+	for i := 0; i < 2000; i++ {
+		var bs [32]byte
+		rand.Read(bs[:])
+		key = protocol.NewDeviceID(bs[:]).String()
+		addrs := []DatabaseAddress{{Address: "tcp://192.0.2.1:22000", Expires: expires}}
+		if err := a.merge(key, addrs, 1); err != nil {
+			t.Fatal(err)
+		}
+		// The peers may not agree on when the addresses expire, as those
+		// are updated with every announcement.
+		addrs = []DatabaseAddress{{Address: "tcp://192.0.2.1:22000", Expires: expires + int64(i)}}
+		if err := b.merge(key, addrs, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if mismatched := mismatchedRanges(t, a, b); len(mismatched) != 0 {
+		t.Fatalf("unexpected mismatched ranges %v", mismatched)
+	}
+
+	// One new address on a, and one about to expire on b.
+	if err := a.merge(key, []DatabaseAddress{{Address: "tcp://192.0.2.2:22000", Expires: expires}}, 2); err != nil {
+		t.Fatal(err)
+	}
+	soon := time.Now().Add(antiEntropyExpiryMargin / 2).UnixNano()
+	if err := b.merge(key, []DatabaseAddress{{Address: "tcp://192.0.2.3:22000", Expires: soon}}, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the range of the changed record is exchanged, in both
+	// directions, rather than the whole top level range.
+	sent := antiEntropyRound(t, a, b)
+	if sent == 0 || sent > 2*antiEntropyLeafRecords {
+		t.Errorf("unexpected %d records exchanged", sent)
+	}
+	rec, err := b.get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Addresses) != 3 {
+		t.Errorf("unexpected merged record %v", rec)
+	}
+	if mismatched := mismatchedRanges(t, a, b); len(mismatched) != 0 {
+		t.Errorf("unexpected mismatched ranges after sync: %v", mismatched)
+	}
+}
+
+func TestAntiEntropyRejectsRanges(t *testing.T) {
+	for _, prefix := range []string{"", "ABCDE", "a", "1", "A-"} {
+		if validAntiEntropyPrefix(prefix) {
+			t.Errorf("expected key range %q to be rejected", prefix)
+		}
+	}
+	for _, prefix := range []string{"A", "Z7", "ABCD"} {
+		if !validAntiEntropyPrefix(prefix) {
+			t.Errorf("expected key range %q to be accepted", prefix)
+		}
+	}
+}
+
+// antiEntropyRound runs anti-entropy between the two databases, started by
+// a, until neither has anything left to answer. It returns the number of
+// records sent in either direction.
+func antiEntropyRound(t *testing.T, a, b database) int {
+	t.Helper()
+	type message struct {
+		from, to database
+		rec      ReplicationRecord
+	}
+	queue := []message{{from: a, to: b, rec: ReplicationRecord{Digests: mustDigest(t, a)}}}
+	sent := 0
+	for len(queue) > 0 {
+		msg := queue[0]
+		queue = queue[1:]
+		if msg.rec.Key != "" {
+			if err := msg.to.merge(msg.rec.Key, msg.rec.Addresses, msg.rec.Seen); err != nil {
+				t.Fatal(err)
+			}
+			sent++
+			continue
+		}
+		err := answerAntiEntropy(msg.to, msg.rec, func(rec ReplicationRecord) error {
+			queue = append(queue, message{from: msg.to, to: msg.from, rec: rec})
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return sent
+}
+
+// mismatchedRanges returns the top level key ranges where the databases
+// differ.
+func mismatchedRanges(t *testing.T, a, b database) []string {
+	t.Helper()
+	remote := make(map[string][]byte)
+	for _, digest := range mustDigest(t, b) {
+		remote[digest.Prefix] = digest.Hash
+	}
+	var mismatched []string
+	for _, digest := range mustDigest(t, a) {
+		if !bytes.Equal(digest.Hash, remote[digest.Prefix]) {
+			mismatched = append(mismatched, digest.Prefix)
+		}
+	}
+	return mismatched
+}
+
+func newTestStore(t *testing.T, kind string) *recordStore {
+	t.Helper()
+	backend, err := openBackend(kind, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := newRecordStore(backend)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		db.Serve(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return db
+}
+
+func mustDigest(t *testing.T, db database) []ReplicationDigest {
+	t.Helper()
+	digests, err := digestRecords(db, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return digests
+}
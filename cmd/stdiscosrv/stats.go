@@ -44,6 +44,29 @@ var (
 			Help:      "Number of announcement requests.",
 		}, []string{"result"})
 
+	allowlistDevices = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "syncthing",
+			Subsystem: "discovery",
+			Name:      "allowlist_devices",
+			Help:      "Number of device IDs in the allowlist.",
+		})
+	allowlistReloadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "discovery",
+			Name:      "allowlist_reloads_total",
+			Help:      "Number of allowlist reloads.",
+		}, []string{"result"})
+
+	replicationAntiEntropyRangesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "discovery",
+			Name:      "replication_anti_entropy_ranges_total",
+			Help:      "Number of key ranges compared with replication peers.",
+		}, []string{"result"})
+
 	replicationSendsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "syncthing",
@@ -105,7 +128,8 @@ const (
 func init() {
 	prometheus.MustRegister(apiRequestsTotal, apiRequestsSeconds,
 		lookupRequestsTotal, announceRequestsTotal,
-		replicationSendsTotal, replicationRecvsTotal,
+		allowlistDevices, allowlistReloadsTotal,
+		replicationSendsTotal, replicationRecvsTotal, replicationAntiEntropyRangesTotal,
 		databaseKeys, databaseStatisticsSeconds,
 		databaseOperations, databaseOperationSeconds)
 
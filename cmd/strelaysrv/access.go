@@ -0,0 +1,200 @@
+// Copyright (C) 2022 Audrius Butkevicius and Contributors.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
+)
+
+var errQuotaExceeded = errors.New("daily quota exceeded")
+
+var (
+	allowlistMut sync.RWMutex
+	allowedIDs   map[syncthingprotocol.DeviceID]struct{} // nil when everyone is allowed
+
+	usageMut sync.Mutex
+	usageDay int64 // days since the epoch, UTC, that usage is for
+	usage    = make(map[syncthingprotocol.DeviceID]int64)
+)
+
+// loadAllowlist reads the device IDs allowed to use the relay, one per line.
+// Empty lines and lines starting with # are ignored.
+func loadAllowlist(path string) error {
+	fd, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	ids := make(map[syncthingprotocol.DeviceID]struct{})
+	sc := bufio.NewScanner(fd)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		id, err := syncthingprotocol.DeviceIDFromString(text)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		ids[id] = struct{}{}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+
+	allowlistMut.Lock()
+	allowedIDs = ids
+	allowlistMut.Unlock()
+	log.Printf("Loaded %d device IDs from allowlist %s", len(ids), path)
+	return nil
+}
+
+// reloadAllowlistOnHangup reloads the allowlist whenever we get SIGHUP,
+// keeping the current one if the new one can't be read.
+func reloadAllowlistOnHangup(path string) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for range hup {
+		if err := loadAllowlist(path); err != nil {
+			log.Println("Failed to reload allowlist:", err)
+		}
+	}
+}
+
+func isAllowed(id syncthingprotocol.DeviceID) bool {
+	allowlistMut.RLock()
+	defer allowlistMut.RUnlock()
+	if allowedIDs == nil {
+		return true
+	}
+	_, ok := allowedIDs[id]
+	return ok
+}
+
+func numAllowed() int {
+	allowlistMut.RLock()
+	defer allowlistMut.RUnlock()
+	return len(allowedIDs)
+}
+
+// usageFlushInterval is how often the bytes counted by each session are
+// added to the daily usage of its participants.
+const usageFlushInterval = 10 * time.Second
+
+// addUsage accounts relayed bytes to the session participants, returning
+// errQuotaExceeded when either of them has used up its daily quota.
+func addUsage(ids []syncthingprotocol.DeviceID, bytes int64) error {
+	if dailyQuota <= 0 {
+		return nil
+	}
+	usageMut.Lock()
+	defer usageMut.Unlock()
+	resetUsageLocked()
+	var err error
+	for _, id := range ids {
+		usage[id] += bytes
+		if usage[id] > dailyQuota {
+			err = errQuotaExceeded
+		}
+	}
+	return err
+}
+
+// flushUsage periodically adds the bytes relayed by the active sessions to
+// the daily usage, ending the sessions whose participants went over quota.
+func flushUsage(interval time.Duration) {
+	for range time.NewTicker(interval).C {
+		sessionMut.RLock()
+		sessions := append([]*session(nil), activeSessions...)
+		sessionMut.RUnlock()
+		for _, ses := range sessions {
+			ses.flushUsage()
+		}
+	}
+}
+
+func overQuota(id syncthingprotocol.DeviceID) bool {
+	if dailyQuota <= 0 {
+		return false
+	}
+	usageMut.Lock()
+	defer usageMut.Unlock()
+	resetUsageLocked()
+	return usage[id] >= dailyQuota
+}
+
+// usageToday returns the bytes relayed today per device.
+func usageToday() map[syncthingprotocol.DeviceID]int64 {
+	usageMut.Lock()
+	defer usageMut.Unlock()
+	resetUsageLocked()
+	res := make(map[syncthingprotocol.DeviceID]int64, len(usage))
+	for id, bytes := range usage {
+		res[id] = bytes
+	}
+	return res
+}
+
+// resetUsageLocked starts over with the new day, at midnight UTC.
+func resetUsageLocked() {
+	day := time.Now().Unix() / (24 * 60 * 60)
+	if day != usageDay {
+		usageDay = day
+		usage = make(map[syncthingprotocol.DeviceID]int64)
+	}
+}
+
+// numSessions returns the number of active and pending sessions the device
+// takes part in.
+func numSessions(id syncthingprotocol.DeviceID) int {
+	n := 0
+	forEachSession(func(ses *session) {
+		if ses.HasParticipant(id) {
+			n++
+		}
+	})
+	return n
+}
+
+// sessionCounts returns the number of active and pending sessions per
+// device taking part in any.
+func sessionCounts() map[syncthingprotocol.DeviceID]int {
+	counts := make(map[syncthingprotocol.DeviceID]int)
+	forEachSession(func(ses *session) {
+		counts[ses.serverid]++
+		if ses.clientid != ses.serverid {
+			counts[ses.clientid]++
+		}
+	})
+	return counts
+}
+
+// forEachSession calls fn once for each active and pending session.
+func forEachSession(fn func(*session)) {
+	sessionMut.RLock()
+	defer sessionMut.RUnlock()
+	for _, ses := range activeSessions {
+		fn(ses)
+	}
+	// Pending sessions are listed once per key that hasn't been used yet.
+	seen := make(map[*session]struct{})
+	for _, ses := range pendingSessions {
+		if _, ok := seen[ses]; ok {
+			continue
+		}
+		seen[ses] = struct{}{}
+		fn(ses)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2022 Audrius Butkevicius and Contributors.
+
+package main
+
+import (
+	"testing"
+
+	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	device1, _ = syncthingprotocol.DeviceIDFromString("AIR6LPZ-7K4PTTV-UXQSMUU-CPQ5YWH-OEDFIIQ-JUG777G-2YQXXR5-YD6AWQR")
+	device2, _ = syncthingprotocol.DeviceIDFromString("GYRZZQB-IRNPV4Z-T7TC52W-EQYJ3TT-FDQW6MW-DFLMU42-SSSU6EM-FBK2VAY")
+	device3, _ = syncthingprotocol.DeviceIDFromString("LGFPDIT-7SKNNJL-VJZA4FC-7QNCRKA-CE753K7-2BW5QDK-2FOZ7FR-FEP57QJ")
+)
+
+func setDailyQuota(t *testing.T, quota int64) {
+	t.Helper()
+	old := dailyQuota
+	dailyQuota = quota
+	usageMut.Lock()
+	usageDay = 0
+	usage = make(map[syncthingprotocol.DeviceID]int64)
+	usageMut.Unlock()
+	t.Cleanup(func() { dailyQuota = old })
+}
+
+func TestAddUsage(t *testing.T) {
+	setDailyQuota(t, 100)
+
+	if err := addUsage([]syncthingprotocol.DeviceID{device1, device2}, 60); err != nil {
+		t.Fatal("unexpected error under quota:", err)
+	}
+	if err := addUsage([]syncthingprotocol.DeviceID{device1}, 40); err != nil {
+		t.Fatal("unexpected error at quota:", err)
+	}
+	if err := addUsage([]syncthingprotocol.DeviceID{device2, device3}, 50); err != errQuotaExceeded {
+		t.Fatal("expected quota exceeded, got", err)
+	}
+
+	today := usageToday()
+	for id, expected := range map[syncthingprotocol.DeviceID]int64{device1: 100, device2: 110, device3: 50} {
+		if today[id] != expected {
+			t.Errorf("%v used %d bytes, expected %d", id, today[id], expected)
+		}
+	}
+}
+
+func TestAddUsageWithoutQuota(t *testing.T) {
+	setDailyQuota(t, 0)
+
+	if err := addUsage([]syncthingprotocol.DeviceID{device1}, 1<<40); err != nil {
+		t.Fatal("unexpected error without quota:", err)
+	}
+	if today := usageToday(); len(today) != 0 {
+		t.Error("usage is recorded without a quota:", today)
+	}
+}
+
+func TestOverQuota(t *testing.T) {
+	setDailyQuota(t, 100)
+
+	addUsage([]syncthingprotocol.DeviceID{device1}, 99)
+	if overQuota(device1) {
+		t.Error("device is over quota before using it up")
+	}
+	addUsage([]syncthingprotocol.DeviceID{device1}, 1)
+	if !overQuota(device1) {
+		t.Error("device isn't over quota after using it up")
+	}
+	if overQuota(device2) {
+		t.Error("unrelated device is over quota")
+	}
+
+	dailyQuota = 0
+	if overQuota(device1) {
+		t.Error("device is over quota without a quota")
+	}
+}
+
+func TestResetUsage(t *testing.T) {
+	setDailyQuota(t, 100)
+
+	addUsage([]syncthingprotocol.DeviceID{device1}, 100)
+	if !overQuota(device1) {
+		t.Fatal("device isn't over quota after using it up")
+	}
+
+	// Pretend the usage is from yesterday.
+	usageMut.Lock()
+	usageDay--
+	resetUsageLocked()
+	n := len(usage)
+	usageMut.Unlock()
+	if n != 0 {
+		t.Errorf("%d devices have usage after the day changed", n)
+	}
+	if overQuota(device1) {
+		t.Error("device is still over quota after the day changed")
+	}
+
+	// Within the same day, nothing is reset.
+	addUsage([]syncthingprotocol.DeviceID{device1}, 10)
+	usageMut.Lock()
+	resetUsageLocked()
+	bytes := usage[device1]
+	usageMut.Unlock()
+	if bytes != 10 {
+		t.Errorf("usage is %d after a reset within the same day, expected 10", bytes)
+	}
+}
+
+func TestNumSessions(t *testing.T) {
+	sessionMut.Lock()
+	oldActive, oldPending := activeSessions, pendingSessions
+	ses1 := &session{serverid: device1, clientid: device2}
+	ses2 := &session{serverid: device2, clientid: device3}
+	pending := &session{serverid: device1, clientid: device3}
+	activeSessions = []*session{ses1, ses2}
+	// A pending session is listed under both of its keys.
+	pendingSessions = map[string]*session{"server": pending, "client": pending}
+	sessionMut.Unlock()
+	defer func() {
+		sessionMut.Lock()
+		activeSessions, pendingSessions = oldActive, oldPending
+		sessionMut.Unlock()
+	}()
+
+	expected := map[syncthingprotocol.DeviceID]int{device1: 2, device2: 2, device3: 2}
+	counts := sessionCounts()
+	for id, n := range expected {
+		if got := numSessions(id); got != n {
+			t.Errorf("numSessions(%v) = %d, expected %d", id, got, n)
+		}
+		if counts[id] != n {
+			t.Errorf("sessionCounts()[%v] = %d, expected %d", id, counts[id], n)
+		}
+	}
+	if len(counts) != len(expected) {
+		t.Errorf("sessionCounts() = %v, expected %v", counts, expected)
+	}
+}
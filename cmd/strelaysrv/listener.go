@@ -13,6 +13,7 @@ import (
 
 	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/tlsutil"
+	"github.com/syncthing/syncthing/lib/websocket"
 
 	"github.com/syncthing/syncthing/lib/relay/protocol"
 )
@@ -29,8 +30,23 @@ func listener(proto, addr string, config *tls.Config) {
 		log.Fatalln(err)
 	}
 
+	serveListener(tcpListener, config)
+}
+
+// wsListener accepts clients connecting over WebSocket to the given path,
+// for those that can only get through HTTP proxies.
+func wsListener(addr, path string, config *tls.Config) {
+	tcpListener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	serveListener(websocket.NewListener(tcpListener, path), config)
+}
+
+func serveListener(l net.Listener, config *tls.Config) {
 	listener := tlsutil.DowngradingListener{
-		Listener: tcpListener,
+		Listener: l,
 	}
 
 	for {
@@ -129,6 +145,15 @@ func protocolConnectionHandler(tcpConn net.Conn, config *tls.Config) {
 					continue
 				}
 
+				if !isAllowed(id) {
+					protocol.WriteMessage(conn, protocol.ResponseNotAllowed)
+					if debug {
+						log.Println("Refusing join request from", id, "as it is not in the allowlist")
+					}
+					conn.Close()
+					continue
+				}
+
 				outboxesMut.RLock()
 				_, ok := outboxes[id]
 				outboxesMut.RUnlock()
@@ -158,10 +183,18 @@ func protocolConnectionHandler(tcpConn net.Conn, config *tls.Config) {
 					conn.Close()
 					continue
 				}
+				if !isAllowed(id) {
+					if debug {
+						log.Println(id, "is not in the allowlist")
+					}
+					protocol.WriteMessage(conn, protocol.ResponseNotAllowed)
+					conn.Close()
+					continue
+				}
 				outboxesMut.RLock()
 				peerOutbox, ok := outboxes[requestedPeer]
 				outboxesMut.RUnlock()
-				if !ok {
+				if !ok || !isAllowed(requestedPeer) {
 					if debug {
 						log.Println(id, "is looking for", requestedPeer, "which does not exist")
 					}
@@ -169,6 +202,22 @@ func protocolConnectionHandler(tcpConn net.Conn, config *tls.Config) {
 					conn.Close()
 					continue
 				}
+				if overQuota(id) || overQuota(requestedPeer) {
+					if debug {
+						log.Println("Refusing session between", id, "and", requestedPeer, "as the daily quota is used up")
+					}
+					protocol.WriteMessage(conn, protocol.ResponseQuotaExceeded)
+					conn.Close()
+					continue
+				}
+				if sessionsPerDevice > 0 && (numSessions(id) >= sessionsPerDevice || numSessions(requestedPeer) >= sessionsPerDevice) {
+					if debug {
+						log.Println("Refusing session between", id, "and", requestedPeer, "as there are too many sessions")
+					}
+					protocol.WriteMessage(conn, protocol.ResponseTooManySessions)
+					conn.Close()
+					continue
+				}
 				// requestedPeer is the server, id is the client
 				ses := newSession(requestedPeer, id, sessionLimiter, globalLimiter)
 
@@ -251,6 +300,15 @@ func protocolConnectionHandler(tcpConn net.Conn, config *tls.Config) {
 				continue
 			}
 
+			if !isAllowed(id) {
+				// Removed from the allowlist since joining.
+				if debug {
+					log.Println("Dropping", id, "as it is no longer in the allowlist")
+				}
+				conn.Close()
+				continue
+			}
+
 			if err := protocol.WriteMessage(conn, protocol.Ping{}); err != nil {
 				if debug {
 					log.Println(id, err)
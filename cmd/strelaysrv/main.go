@@ -29,6 +29,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/nat"
+	_ "github.com/syncthing/syncthing/lib/pcp"
 	_ "github.com/syncthing/syncthing/lib/pmp"
 	_ "github.com/syncthing/syncthing/lib/upnp"
 
@@ -36,8 +37,10 @@ import (
 )
 
 var (
-	listen string
-	debug  bool
+	listen   string
+	wsListen string
+	wsPath   string
+	debug    bool
 
 	sessionAddress []byte
 	sessionPort    uint16
@@ -56,6 +59,10 @@ var (
 	globalLimiter     *rate.Limiter
 	networkBufferSize int
 
+	allowlistPath     string
+	dailyQuota        int64
+	sessionsPerDevice int
+
 	statusAddr       string
 	poolAddrs        string
 	pools            []string
@@ -82,12 +89,17 @@ func main() {
 	var dir, extAddress, proto string
 
 	flag.StringVar(&listen, "listen", ":22067", "Protocol listen address")
+	flag.StringVar(&wsListen, "ws-listen", "", "Listen address for clients connecting over WebSocket (blank to disable)")
+	flag.StringVar(&wsPath, "ws-path", "/", "HTTP path for clients connecting over WebSocket")
 	flag.StringVar(&dir, "keys", ".", "Directory where cert.pem and key.pem is stored")
 	flag.DurationVar(&networkTimeout, "network-timeout", networkTimeout, "Timeout for network operations between the client and the relay.\n\tIf no data is received between the client and the relay in this period of time, the connection is terminated.\n\tFurthermore, if no data is sent between either clients being relayed within this period of time, the session is also terminated.")
 	flag.DurationVar(&pingInterval, "ping-interval", pingInterval, "How often pings are sent")
 	flag.DurationVar(&messageTimeout, "message-timeout", messageTimeout, "Maximum amount of time we wait for relevant messages to arrive")
 	flag.IntVar(&sessionLimitBps, "per-session-rate", sessionLimitBps, "Per session rate limit, in bytes/s")
 	flag.IntVar(&globalLimitBps, "global-rate", globalLimitBps, "Global rate limit, in bytes/s")
+	flag.StringVar(&allowlistPath, "allowlist", "", "File of device IDs allowed to join the relay and be connected to, one per line (blank to allow everyone).\n\tReloaded on SIGHUP.")
+	flag.Int64Var(&dailyQuota, "daily-quota", dailyQuota, "Bytes each device may have relayed per day (UTC), 0 for no limit")
+	flag.IntVar(&sessionsPerDevice, "sessions-per-device", sessionsPerDevice, "Maximum number of concurrent sessions per device, 0 for no limit")
 	flag.BoolVar(&debug, "debug", debug, "Enable debug output")
 	flag.StringVar(&statusAddr, "status-srv", ":22070", "Listen address for status service (blank to disable)")
 	flag.StringVar(&poolAddrs, "pools", defaultPoolAddrs, "Comma separated list of relay pool addresses to join")
@@ -219,6 +231,17 @@ func main() {
 		}
 	}
 
+	if allowlistPath != "" {
+		if err := loadAllowlist(allowlistPath); err != nil {
+			log.Fatalln("Failed to load allowlist:", err)
+		}
+		go reloadAllowlistOnHangup(allowlistPath)
+	}
+
+	if dailyQuota > 0 {
+		go flushUsage(usageFlushInterval)
+	}
+
 	if sessionLimitBps > 0 {
 		sessionLimiter = rate.NewLimiter(rate.Limit(sessionLimitBps), 2*sessionLimitBps)
 	}
@@ -254,6 +277,9 @@ func main() {
 	}
 
 	go listener(proto, listen, tlsCfg)
+	if wsListen != "" {
+		go wsListener(wsListen, wsPath, tlsCfg)
+	}
 
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
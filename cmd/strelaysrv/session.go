@@ -101,6 +101,11 @@ func hasSessions(id syncthingprotocol.DeviceID) bool {
 }
 
 type session struct {
+	// usage is the number of bytes relayed since they were last added to
+	// the daily usage of the participants. Atomic, must remain 64-bit
+	// aligned.
+	usage int64
+
 	mut sync.Mutex
 
 	serverkey []byte
@@ -172,6 +177,7 @@ func (s *session) Serve() {
 			sessionMut.Unlock()
 
 			wg.Wait()
+			s.flushUsage()
 
 			if debug {
 				log.Println("Session", s, "ended, outcomes:", err0, "and", err1)
@@ -246,6 +252,21 @@ func (s *session) CloseConns() {
 	s.mut.Unlock()
 }
 
+// flushUsage adds the bytes relayed since the last call to the daily usage
+// of the participants, closing the session if either is over quota.
+func (s *session) flushUsage() {
+	n := atomic.SwapInt64(&s.usage, 0)
+	if n == 0 {
+		return
+	}
+	if err := addUsage([]syncthingprotocol.DeviceID{s.serverid, s.clientid}, n); err != nil {
+		if debug {
+			log.Println("Closing session", s, "as", err)
+		}
+		s.CloseConns()
+	}
+}
+
 func (s *session) proxy(c1, c2 net.Conn) error {
 	if debug {
 		log.Println("Proxy", c1.RemoteAddr(), "->", c2.RemoteAddr())
@@ -268,6 +289,10 @@ func (s *session) proxy(c1, c2 net.Conn) error {
 			log.Printf("%d bytes from %s to %s", n, c1.RemoteAddr(), c2.RemoteAddr())
 		}
 
+		if dailyQuota > 0 {
+			atomic.AddInt64(&s.usage, int64(n))
+		}
+
 		if s.rateLimit != nil {
 			s.rateLimit(n)
 		}
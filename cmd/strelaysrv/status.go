@@ -68,13 +68,22 @@ func getStatus(w http.ResponseWriter, r *http.Request) {
 		rc.rate(60*60/10) * 8 / 1000,
 	}
 	status["options"] = map[string]interface{}{
-		"network-timeout":  networkTimeout / time.Second,
-		"ping-interval":    pingInterval / time.Second,
-		"message-timeout":  messageTimeout / time.Second,
-		"per-session-rate": sessionLimitBps,
-		"global-rate":      globalLimitBps,
-		"pools":            pools,
-		"provided-by":      providedBy,
+		"network-timeout":     networkTimeout / time.Second,
+		"ping-interval":       pingInterval / time.Second,
+		"message-timeout":     messageTimeout / time.Second,
+		"per-session-rate":    sessionLimitBps,
+		"global-rate":         globalLimitBps,
+		"pools":               pools,
+		"provided-by":         providedBy,
+		"allowlist":           allowlistPath != "",
+		"daily-quota":         dailyQuota,
+		"sessions-per-device": sessionsPerDevice,
+	}
+	if allowlistPath != "" {
+		// Only private relays list their users, as the status service is
+		// public and advertised to the pool.
+		status["numAllowedDevices"] = numAllowed()
+		status["devices"] = deviceStatus()
 	}
 
 	bs, err := json.MarshalIndent(status, "", "    ")
@@ -87,6 +96,26 @@ func getStatus(w http.ResponseWriter, r *http.Request) {
 	w.Write(bs)
 }
 
+// deviceStatus returns the consumption of each device that has relayed
+// data today or has sessions.
+func deviceStatus() map[string]interface{} {
+	bytesToday := usageToday()
+	counts := sessionCounts()
+	for id := range counts {
+		bytesToday[id] += 0
+	}
+
+	devices := make(map[string]interface{}, len(bytesToday))
+	for id, bytes := range bytesToday {
+		devices[id.String()] = map[string]interface{}{
+			"bytesToday":    bytes,
+			"numSessions":   counts[id],
+			"quotaExceeded": dailyQuota > 0 && bytes >= dailyQuota,
+		}
+	}
+	return devices
+}
+
 type rateCalculator struct {
 	counter   *int64 // atomic, must remain 64-bit aligned
 	rates     []int64
@@ -80,7 +80,7 @@ func main() {
 		}()
 
 		for {
-			conn, err := client.JoinSession(ctx, <-recv)
+			conn, err := client.JoinSession(ctx, uri, <-recv)
 			if err != nil {
 				log.Fatalln("Failed to join", err)
 			}
@@ -100,7 +100,7 @@ func main() {
 		}
 
 		log.Println("Received invitation", invite)
-		conn, err := client.JoinSession(ctx, invite)
+		conn, err := client.JoinSession(ctx, uri, invite)
 		if err != nil {
 			log.Fatalln("Failed to join", err)
 		}
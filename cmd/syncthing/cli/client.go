@@ -70,14 +70,14 @@ func (f *apiClientFactory) getClient() (APIClient, error) {
 	}, nil
 }
 
-func loadGUIConfig() (config.GUIConfiguration, error) {
+func loadConfig() (config.Wrapper, error) {
 	// Load the certs and get the ID
 	cert, err := tls.LoadX509KeyPair(
 		locations.Get(locations.CertFile),
 		locations.Get(locations.KeyFile),
 	)
 	if err != nil {
-		return config.GUIConfiguration{}, fmt.Errorf("reading device ID: %w", err)
+		return nil, fmt.Errorf("reading device ID: %w", err)
 	}
 
 	myID := protocol.NewDeviceID(cert.Certificate[0])
@@ -85,7 +85,15 @@ func loadGUIConfig() (config.GUIConfiguration, error) {
 	// Load the config
 	cfg, _, err := config.Load(locations.Get(locations.ConfigFile), myID, events.NoopLogger)
 	if err != nil {
-		return config.GUIConfiguration{}, fmt.Errorf("loading config: %w", err)
+		return nil, fmt.Errorf("loading config: %w", err)
+	}
+	return cfg, nil
+}
+
+func loadGUIConfig() (config.GUIConfiguration, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return config.GUIConfiguration{}, err
 	}
 
 	guiCfg := cfg.GUI()
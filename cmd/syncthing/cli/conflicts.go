@@ -0,0 +1,47 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"net/url"
+
+	"github.com/urfave/cli"
+)
+
+var conflictsCommand = cli.Command{
+	Name:     "conflicts",
+	HideHelp: true,
+	Usage:    "Conflicts command group",
+	Subcommands: []cli.Command{
+		{
+			Name:      "list",
+			Usage:     "List conflicted files with their competing versions",
+			ArgsUsage: "[folder id]",
+			Action:    expects(1, conflictsList),
+		},
+		{
+			Name:      "resolve",
+			Usage:     "Resolve a conflict by keeping one version, the file itself or one of its conflict copies",
+			ArgsUsage: "[folder id] [file] [version to keep]",
+			Action:    expects(3, conflictsResolve),
+		},
+	},
+}
+
+func conflictsList(c *cli.Context) error {
+	query := make(url.Values)
+	query.Set("folder", c.Args()[0])
+	return indexDumpOutput("folder/conflicts?" + query.Encode())(c)
+}
+
+func conflictsResolve(c *cli.Context) error {
+	query := make(url.Values)
+	query.Set("folder", c.Args()[0])
+	query.Set("file", c.Args()[1])
+	query.Set("keep", c.Args()[2])
+	return emptyPost("folder/conflicts?" + query.Encode())(c)
+}
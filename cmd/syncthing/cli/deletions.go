@@ -0,0 +1,54 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"net/url"
+
+	"github.com/urfave/cli"
+)
+
+var deletionsCommand = cli.Command{
+	Name:     "deletions",
+	HideHelp: true,
+	Usage:    "Held deletions command group",
+	Subcommands: []cli.Command{
+		{
+			Name:      "show",
+			Usage:     "Show the deletions held on a folder",
+			ArgsUsage: "[folder id]",
+			Action:    expects(1, deletionsShow),
+		},
+		{
+			Name:      "approve",
+			Usage:     "Approve the held deletions, applying them locally",
+			ArgsUsage: "[folder id]",
+			Action:    expects(1, deletionsAction("approve")),
+		},
+		{
+			Name:      "reject",
+			Usage:     "Reject the held deletions, restoring the deleted items on all devices",
+			ArgsUsage: "[folder id]",
+			Action:    expects(1, deletionsAction("reject")),
+		},
+	},
+}
+
+func deletionsShow(c *cli.Context) error {
+	query := make(url.Values)
+	query.Set("folder", c.Args()[0])
+	return indexDumpOutput("folder/deletionhold?" + query.Encode())(c)
+}
+
+func deletionsAction(action string) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		query := make(url.Values)
+		query.Set("folder", c.Args()[0])
+		query.Set("action", action)
+		return emptyPost("folder/deletionhold?" + query.Encode())(c)
+	}
+}
@@ -34,5 +34,11 @@ var indexCommand = cli.Command{
 			Usage:  "Print key and value size statistics per key type",
 			Action: expects(0, indexAccount),
 		},
+		{
+			Name:      "migrate",
+			Usage:     "Copy the database from one backend to another (\"leveldb\" or \"bolt\")",
+			ArgsUsage: "FROM TO",
+			Action:    expects(2, indexMigrate),
+		},
 	},
 }
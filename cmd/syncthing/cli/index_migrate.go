@@ -0,0 +1,111 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/syncthing"
+)
+
+// indexMigrate copies all keys from the database of one backend to that of
+// another. Syncthing must not be running. The source database is left
+// untouched; switching to the new one is done by setting the database
+// backend option.
+func indexMigrate(c *cli.Context) error {
+	from, err := parseDatabaseBackend(c.Args()[0])
+	if err != nil {
+		return err
+	}
+	to, err := parseDatabaseBackend(c.Args()[1])
+	if err != nil {
+		return err
+	}
+	if from == to {
+		return errors.New("source and destination backends are the same")
+	}
+
+	src, err := backend.OpenRO(syncthing.DBLocation(from), backend.Type(from))
+	if err != nil {
+		return fmt.Errorf("opening %v database: %w", from, err)
+	}
+	defer src.Close()
+	dst, err := backend.Open(syncthing.DBLocation(to), backend.Type(to), backend.TuningAuto)
+	if err != nil {
+		return fmt.Errorf("opening %v database: %w", to, err)
+	}
+	defer dst.Close()
+
+	if err := checkEmptyDB(dst); err != nil {
+		return fmt.Errorf("%v database: %w", to, err)
+	}
+
+	n, err := copyDB(src, dst)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Copied %d keys from %v to %v database at %s\n", n, from, to, syncthing.DBLocation(to))
+	fmt.Printf("Set the database backend option to %q to use it.\n", to)
+	return nil
+}
+
+func parseDatabaseBackend(s string) (config.DatabaseBackend, error) {
+	var typ config.DatabaseBackend
+	err := typ.UnmarshalText([]byte(s))
+	return typ, err
+}
+
+func checkEmptyDB(db backend.Backend) error {
+	it, err := db.NewPrefixIterator(nil)
+	if err != nil {
+		return err
+	}
+	defer it.Release()
+	if it.Next() {
+		return errors.New("refusing to overwrite existing data")
+	}
+	return it.Error()
+}
+
+func copyDB(src, dst backend.Backend) (int, error) {
+	snap, err := src.NewReadTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Release()
+	it, err := snap.NewPrefixIterator(nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Release()
+
+	t, err := dst.NewWriteTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer t.Release()
+
+	n := 0
+	for it.Next() {
+		if err := t.Put(it.Key(), it.Value()); err != nil {
+			return n, err
+		}
+		if err := t.Checkpoint(); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if err := it.Error(); err != nil {
+		return n, err
+	}
+	return n, t.Commit()
+}
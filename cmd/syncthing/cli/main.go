@@ -97,6 +97,9 @@ func Run() error {
 			operationCommand,
 			errorsCommand,
 			debugCommand,
+			deletionsCommand,
+			conflictsCommand,
+			scrubCommand,
 			{
 				Name:     "-",
 				HideHelp: true,
@@ -0,0 +1,45 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"net/url"
+
+	"github.com/urfave/cli"
+)
+
+var scrubCommand = cli.Command{
+	Name:     "scrub",
+	HideHelp: true,
+	Usage:    "Folder integrity scrub command group",
+	Subcommands: []cli.Command{
+		{
+			Name:      "show",
+			Usage:     "Show the result of the last scrub of a folder",
+			ArgsUsage: "[folder id]",
+			Action:    expects(1, scrubShow),
+		},
+		{
+			Name:      "start",
+			Usage:     "Verify the data of a folder against its recorded hashes now",
+			ArgsUsage: "[folder id]",
+			Action:    expects(1, scrubStart),
+		},
+	},
+}
+
+func scrubShow(c *cli.Context) error {
+	query := make(url.Values)
+	query.Set("folder", c.Args()[0])
+	return indexDumpOutput("folder/scrub?" + query.Encode())(c)
+}
+
+func scrubStart(c *cli.Context) error {
+	query := make(url.Values)
+	query.Set("folder", c.Args()[0])
+	return emptyPost("folder/scrub?" + query.Encode())(c)
+}
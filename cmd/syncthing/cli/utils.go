@@ -18,7 +18,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db/backend"
-	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/syncthing"
 	"github.com/urfave/cli"
 )
 
@@ -143,8 +143,15 @@ func prettyPrintResponse(response *http.Response) error {
 	return prettyPrintJSON(data)
 }
 
+// getDB opens the database read only, using the backend set in the
+// configuration.
 func getDB() (backend.Backend, error) {
-	return backend.OpenLevelDBRO(locations.Get(locations.Database))
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	typ := cfg.Options().DatabaseBackend
+	return backend.OpenRO(syncthing.DBLocation(typ), backend.Type(typ))
 }
 
 func nulString(bs []byte) string {
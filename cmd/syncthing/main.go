@@ -39,7 +39,6 @@ import (
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db"
-	"github.com/syncthing/syncthing/lib/db/backend"
 	"github.com/syncthing/syncthing/lib/dialer"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
@@ -371,13 +370,10 @@ func (options serveOptions) Run() error {
 	if options.Upgrade {
 		release, err := checkUpgrade()
 		if err == nil {
-			// Use leveldb database locks to protect against concurrent upgrades
-			var ldb backend.Backend
-			ldb, err = syncthing.OpenDBBackend(locations.Get(locations.Database), config.TuningAuto)
-			if err != nil {
+			// Use database locks to protect against concurrent upgrades
+			if err = checkDBUnlocked(); err != nil {
 				err = upgradeViaRest()
 			} else {
-				_ = ldb.Close()
 				err = upgrade.To(release)
 			}
 		}
@@ -575,8 +571,9 @@ func syncthingMain(options serveOptions) {
 		})
 	}
 
-	dbFile := locations.Get(locations.Database)
-	ldb, err := syncthing.OpenDBBackend(dbFile, cfgWrapper.Options().DatabaseTuning)
+	dbBackend := cfgWrapper.Options().DatabaseBackend
+	dbFile := syncthing.DBLocation(dbBackend)
+	ldb, err := syncthing.OpenDBBackend(dbFile, dbBackend, cfgWrapper.Options().DatabaseTuning)
 	if err != nil {
 		l.Warnln("Error opening database:", err)
 		os.Exit(1)
@@ -758,7 +755,31 @@ func auditWriter(auditFile string) io.Writer {
 }
 
 func resetDB() error {
-	return os.RemoveAll(locations.Get(locations.Database))
+	if err := os.RemoveAll(locations.Get(locations.Database)); err != nil {
+		return err
+	}
+	return os.RemoveAll(locations.Get(locations.DatabaseBolt))
+}
+
+// checkDBUnlocked returns an error if the database is in use by another
+// Syncthing instance. We don't know which backend is configured, so we check
+// the leveldb database, which is created if it doesn't exist, and the bolt
+// one if it does exist.
+func checkDBUnlocked() error {
+	ldb, err := syncthing.OpenDBBackend(locations.Get(locations.Database), config.DatabaseBackendLevelDB, config.TuningAuto)
+	if err != nil {
+		return err
+	}
+	_ = ldb.Close()
+	boltFile := locations.Get(locations.DatabaseBolt)
+	if _, err := os.Stat(boltFile); err != nil {
+		return nil
+	}
+	bdb, err := syncthing.OpenDBBackend(boltFile, config.DatabaseBackendBolt, config.TuningAuto)
+	if err != nil {
+		return err
+	}
+	return bdb.Close()
 }
 
 func standbyMonitor(app *syncthing.App, cfg config.Wrapper) {
@@ -915,11 +936,13 @@ func cleanConfigDirectory() {
 func showPaths(options serveOptions) {
 	fmt.Printf("Configuration file:\n\t%s\n\n", locations.Get(locations.ConfigFile))
 	fmt.Printf("Database directory:\n\t%s\n\n", locations.Get(locations.Database))
+	fmt.Printf("Database file (bolt backend):\n\t%s\n\n", locations.Get(locations.DatabaseBolt))
 	fmt.Printf("Device private key & certificate files:\n\t%s\n\t%s\n\n", locations.Get(locations.KeyFile), locations.Get(locations.CertFile))
 	fmt.Printf("HTTPS private key & certificate files:\n\t%s\n\t%s\n\n", locations.Get(locations.HTTPSKeyFile), locations.Get(locations.HTTPSCertFile))
 	fmt.Printf("Log file:\n\t%s\n\n", options.LogFile)
 	fmt.Printf("GUI override directory:\n\t%s\n\n", options.DebugGUIAssetsDir)
 	fmt.Printf("Default sync folder directory:\n\t%s\n\n", locations.Get(locations.DefFolder))
+	fmt.Printf("On-demand folder block cache directory:\n\t%s\n\n", locations.Get(locations.OnDemandCache))
 }
 
 func setPauseState(cfgWrapper config.Wrapper, paused bool) {
@@ -77,6 +77,9 @@ angular.module('syncthing.core')
             STARTUP_COMPLETED: 'StartupCompleted',   // Emitted exactly once, when initialization is complete and Syncthing is ready to start exchanging data with other devices
             STATE_CHANGED: 'StateChanged',   // Emitted when a folder changes state
             FOLDER_ERRORS: 'FolderErrors',   // Emitted when a folder has errors preventing a full sync
+            FOLDER_DELETION_HOLD: 'FolderDeletionHold',   // Emitted when a folder stops pulling because too many remote deletions are pending
+            CONFLICT_DETECTED: 'ConflictDetected',   // Emitted when a remote change conflicts with the local version of an item
+            CORRUPTION_DETECTED: 'CorruptionDetected',   // Emitted when a folder scrub finds file contents not matching their recorded hashes
             FOLDER_SCAN_PROGRESS: 'FolderScanProgress',   // Emitted every ScanProgressIntervalS seconds, indicating how far into the scan it is at.
             FOLDER_PAUSED: 'FolderPaused',   // Emitted when a folder is paused
             FOLDER_RESUMED: 'FolderResumed',   // Emitted when a folder is resumed
@@ -31,6 +31,8 @@ import (
 	"unicode"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rcrowley/go-metrics"
 	"github.com/thejerf/suture/v4"
 	"github.com/vitrun/qart/qr"
@@ -80,6 +82,7 @@ type service struct {
 	connectionsService   connections.Service
 	fss                  model.FolderSummaryService
 	urService            *ur.Service
+	metricsRegistry      *prometheus.Registry
 	noUpgrade            bool
 	tlsDefaultCommonName string
 	configChanged        chan struct{} // signals intentional listener close due to config change
@@ -102,6 +105,11 @@ type Service interface {
 }
 
 func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonName string, m model.Model, defaultSub, diskSub events.BufferedSubscription, evLogger events.Logger, discoverer discover.Manager, connectionsService connections.Service, urService *ur.Service, fss model.FolderSummaryService, errors, systemLog logger.Recorder, noUpgrade bool) Service {
+	// The state collector is registered per service instance, while
+	// instrumentation in other packages lives in the default registry.
+	metricsRegistry := prometheus.NewRegistry()
+	metricsRegistry.MustRegister(newMetricsCollector(cfg, m, fss))
+
 	return &service{
 		id:      id,
 		cfg:     cfg,
@@ -117,6 +125,7 @@ func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonNam
 		connectionsService:   connectionsService,
 		fss:                  fss,
 		urService:            urService,
+		metricsRegistry:      metricsRegistry,
 		guiErrors:            errors,
 		systemLog:            systemLog,
 		noUpgrade:            noUpgrade,
@@ -254,8 +263,12 @@ func (s *service) Serve(ctx context.Context) error {
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)         // folder
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)             // folder [perpage] [page]
 	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)         // folder (deprecated)
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/deletionhold", s.getFolderDeletionHold) // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/conflicts", s.getFolderConflicts)       // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/scrub", s.getFolderScrub)               // folder
 	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                     // [since] [limit] [timeout] [events]
 	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                 // [since] [limit] [timeout]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/stream", s.getEventStream)              // [since] [events]
 	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)               // -
 	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                  // id
@@ -281,6 +294,9 @@ func (s *service) Serve(ctx context.Context) error {
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                      // folder
 	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay]
 	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)   // folder <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/deletionhold", s.postFolderDeletionHold)  // folder action
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/conflicts", s.postFolderConflicts)        // folder file keep
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/scrub", s.postFolderScrub)                // folder
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                // <body>
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)     // -
 	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                        // -
@@ -317,6 +333,7 @@ func (s *service) Serve(ctx context.Context) error {
 	configBuilder.registerOptions("/rest/config/options")
 	configBuilder.registerLDAP("/rest/config/ldap")
 	configBuilder.registerGUI("/rest/config/gui")
+	configBuilder.registerWebhooks("/rest/config/webhooks")
 
 	// Deprecated config endpoints
 	configBuilder.registerConfigDeprecated("/rest/system/config") // POST instead of PUT
@@ -340,6 +357,9 @@ func (s *service) Serve(ctx context.Context) error {
 	mux.Handle("/rest/", noCacheRestMux)
 	mux.HandleFunc("/qr/", s.getQR)
 
+	// The Prometheus metrics endpoint
+	mux.Handle("/metrics", noCacheMiddleware(promhttp.HandlerFor(prometheus.Gatherers{prometheus.DefaultGatherer, s.metricsRegistry}, promhttp.HandlerOpts{})))
+
 	// Serve compiled in assets unless an asset directory was set (for development)
 	mux.Handle("/", s.statics)
 
@@ -1666,6 +1686,84 @@ func (s *service) getFolderErrors(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+func (s *service) getFolderDeletionHold(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	hold, ok, err := s.model.DeletionHold(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	res := map[string]interface{}{
+		"folder": folder,
+		"held":   ok,
+	}
+	if ok {
+		res["hold"] = hold
+	}
+	sendJSON(w, res)
+}
+
+func (s *service) postFolderDeletionHold(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	folder := qs.Get("folder")
+	var err error
+	switch action := qs.Get("action"); action {
+	case "approve":
+		err = s.model.ApproveDeletions(folder)
+	case "reject":
+		err = s.model.RejectDeletions(folder)
+	default:
+		http.Error(w, fmt.Sprintf("unknown action %q (must be approve or reject)", action), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *service) getFolderConflicts(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	conflicts, err := s.model.FolderConflicts(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	sendJSON(w, map[string]interface{}{
+		"folder":    folder,
+		"conflicts": conflicts,
+	})
+}
+
+func (s *service) postFolderConflicts(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if err := s.model.ResolveConflict(qs.Get("folder"), qs.Get("file"), qs.Get("keep")); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *service) getFolderScrub(w http.ResponseWriter, r *http.Request) {
+	folder := r.URL.Query().Get("folder")
+	res, ok, err := s.model.FolderScrub(folder)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	out := map[string]interface{}{
+		"folder":   folder,
+		"scrubbed": ok,
+	}
+	if ok {
+		out["result"] = res
+	}
+	sendJSON(w, out)
+}
+
+func (s *service) postFolderScrub(w http.ResponseWriter, r *http.Request) {
+	if err := s.model.ScrubFolder(r.URL.Query().Get("folder")); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 func (s *service) getSystemBrowse(w http.ResponseWriter, r *http.Request) {
 	qs := r.URL.Query()
 	current := qs.Get("current")
@@ -0,0 +1,160 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// eventStreamKeepalive is how often we send a comment line on an otherwise
+// idle event stream, to keep proxies and the like from timing it out. It's
+// also how long it may take to notice a client went away.
+var eventStreamKeepalive = 20 * time.Second
+
+// getEventStream serves events as a text/event-stream (server-sent events).
+// Each event carries its global event ID as the SSE event ID, so a client
+// that reconnects with a Last-Event-ID header (or the since parameter)
+// gets the events it missed, as far as they are still buffered, before
+// the stream continues with live events. Events are read from the same
+// buffer, so a client that falls behind doesn't lose any until it is more
+// than the buffer size behind, at which point it gets a "reset" event
+// telling it to reload its state. The same happens when resuming from an
+// ID we can't continue from, because it's no longer buffered or is from
+// before a restart.
+func (s *service) getEventStream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	qs := r.URL.Query()
+	mask := s.getEventMask(qs.Get("events"))
+	resumeFrom := r.Header.Get("Last-Event-ID")
+	if resumeFrom == "" {
+		resumeFrom = qs.Get("since")
+	}
+	lastID, err := strconv.Atoi(resumeFrom)
+	resume := err == nil && lastID >= 0
+	if !resume {
+		lastID = 0
+	}
+
+	bufsub := s.getEventSub(mask)
+
+	refreshSummaries := mask&(events.FolderSummary|events.FolderCompletion) != 0
+	if refreshSummaries {
+		s.fss.OnEventRequest()
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// The buffered subscription numbers its events sequentially, which
+	// lets us continue exactly where we left off and notice when events
+	// were lost in between.
+	var lastSubID int
+	buffered := bufsub.Since(0, nil, 0)
+	if len(buffered) > 0 {
+		lastSubID = buffered[len(buffered)-1].SubscriptionID
+	}
+	if resume {
+		var reset bool
+		switch {
+		case len(buffered) == 0 && lastID > 0, len(buffered) > 0 && lastID > buffered[len(buffered)-1].GlobalID:
+			// The ID is from before a restart, as event IDs start over
+			// from one. Nothing we have can be skipped.
+			reset = true
+			lastID = 0
+		case len(buffered) > 0 && buffered[0].SubscriptionID > 1 && buffered[0].GlobalID > lastID+1:
+			// The buffer wrapped around, events after the ID may have
+			// been lost.
+			reset = true
+		}
+		if reset {
+			if err := writeStreamReset(w); err != nil {
+				return
+			}
+		}
+		for _, ev := range buffered {
+			if ev.GlobalID <= lastID {
+				continue
+			}
+			if err := writeStreamEvent(w, ev); err != nil {
+				return
+			}
+			lastID = ev.GlobalID
+		}
+		flusher.Flush()
+	}
+
+	lastRefresh := time.Now()
+	for {
+		evs := bufsub.Since(lastSubID, nil, eventStreamKeepalive)
+		if r.Context().Err() != nil {
+			return
+		}
+
+		if refreshSummaries && time.Since(lastRefresh) >= eventStreamKeepalive {
+			// The summary service stops sending summaries when
+			// nobody's asked for events in a while.
+			s.fss.OnEventRequest()
+			lastRefresh = time.Now()
+		}
+
+		if len(evs) == 0 {
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+			continue
+		}
+
+		if evs[0].SubscriptionID > lastSubID+1 {
+			// The buffer wrapped around since we last read from it.
+			if err := writeStreamReset(w); err != nil {
+				return
+			}
+		}
+		for _, ev := range evs {
+			lastSubID = ev.SubscriptionID
+			if ev.GlobalID <= lastID {
+				continue
+			}
+			if err := writeStreamEvent(w, ev); err != nil {
+				return
+			}
+			lastID = ev.GlobalID
+		}
+		flusher.Flush()
+	}
+}
+
+// writeStreamReset tells the client that events were lost and it should
+// reload its state.
+func writeStreamReset(w http.ResponseWriter) error {
+	_, err := fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+	return err
+}
+
+func writeStreamEvent(w http.ResponseWriter, ev events.Event) error {
+	bs, err := json.Marshal(ev)
+	if err != nil {
+		l.Debugln("Event stream: marshalling event:", err)
+		return nil
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.GlobalID, ev.Type, bs)
+	return err
+}
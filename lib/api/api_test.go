@@ -7,6 +7,7 @@
 package api
 
 import (
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -18,6 +19,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
@@ -463,6 +465,19 @@ func TestAPIServiceRequests(t *testing.T) {
 			Type:   "application/json",
 			Prefix: "{",
 		},
+		{
+			URL:  "/rest/config/webhooks",
+			Code: 200,
+			Type: "application/json",
+		},
+
+		// /metrics
+		{
+			URL:    "/metrics",
+			Code:   200,
+			Type:   "text/plain",
+			Prefix: "# HELP",
+		},
 	}
 
 	for _, tc := range cases {
@@ -1131,6 +1146,217 @@ func TestEventMasks(t *testing.T) {
 	}
 }
 
+func TestEventStream(t *testing.T) {
+	eventStreamKeepalive = 100 * time.Millisecond
+	defer func() { eventStreamKeepalive = 20 * time.Second }()
+
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	cfg := newMockedConfig()
+	defSub := new(eventmocks.BufferedSubscription)
+	diskSub := new(eventmocks.BufferedSubscription)
+	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, evLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	defer os.Remove(token)
+
+	srv := httptest.NewServer(http.HandlerFunc(svc.getEventStream))
+	defer srv.Close()
+
+	// Make sure there is a buffered subscription to replay from, with an
+	// event in it.
+	mask := events.StateChanged | events.FolderErrors
+	bufsub := svc.getEventSub(mask)
+	evLogger.Log(events.StateChanged, "first")
+	first := bufsub.Since(0, nil, time.Second)
+	if len(first) != 1 {
+		t.Fatal("expected one buffered event, got", len(first))
+	}
+
+	connect := func(lastID int) (*bufio.Reader, func()) {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"?events=StateChanged,FolderErrors", nil)
+		if lastID >= 0 {
+			req.Header.Set("Last-Event-ID", strconv.Itoa(lastID))
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/event-stream") {
+			t.Fatal("unexpected content type", ct)
+		}
+		return bufio.NewReader(resp.Body), func() { resp.Body.Close() }
+	}
+
+	// Reads one event from the stream, returning the id and event lines.
+	readEvent := func(br *bufio.Reader) (string, string) {
+		var id, typ string
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil {
+				t.Fatal(err)
+			}
+			line = strings.TrimSpace(line)
+			switch {
+			case line == "" && id != "":
+				return id, typ
+			case strings.HasPrefix(line, "id: "):
+				id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				typ = strings.TrimPrefix(line, "event: ")
+			}
+		}
+	}
+
+	// Resuming from before the first event replays it.
+	br, closeFn := connect(first[0].GlobalID - 1)
+	id, typ := readEvent(br)
+	if id != strconv.Itoa(first[0].GlobalID) || typ != "StateChanged" {
+		t.Errorf("unexpected replayed event %s %s", id, typ)
+	}
+
+	// Live events follow, and events outside the mask are filtered out.
+	evLogger.Log(events.Starting, "filtered")
+	evLogger.Log(events.FolderErrors, "second")
+	id, typ = readEvent(br)
+	if typ != "FolderErrors" {
+		t.Errorf("unexpected live event %s %s", id, typ)
+	}
+	secondID, _ := strconv.Atoi(id)
+	closeFn()
+
+	// Resuming from the first event gets us only the second one.
+	evLogger.Log(events.StateChanged, "third")
+	br, closeFn = connect(first[0].GlobalID)
+	defer closeFn()
+	id, typ = readEvent(br)
+	if id != strconv.Itoa(secondID) || typ != "FolderErrors" {
+		t.Errorf("unexpected resumed event %s %s", id, typ)
+	}
+	_, typ = readEvent(br)
+	if typ != "StateChanged" {
+		t.Errorf("unexpected resumed event %s", typ)
+	}
+}
+
+func TestEventStreamReset(t *testing.T) {
+	// The buffer holds event 1 when we connect, and has moved on to
+	// event 5 by the time we look again.
+	bufsub := new(eventmocks.BufferedSubscription)
+	bufsub.SinceCalls(func(id int, into []events.Event, timeout time.Duration) []events.Event {
+		switch id {
+		case 0:
+			return []events.Event{{SubscriptionID: 1, GlobalID: 10, Type: events.StateChanged}}
+		case 1:
+			return []events.Event{{SubscriptionID: 5, GlobalID: 50, Type: events.StateChanged}}
+		default:
+			time.Sleep(timeout)
+			return nil
+		}
+	})
+
+	lines := readEventStream(t, bufsub, "", 4)
+	expected := []string{"event: reset", "data: {}", "id: 50", "event: StateChanged"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("got %q, expected %q", lines, expected)
+	}
+}
+
+func TestEventStreamResumeAfterRestart(t *testing.T) {
+	// The client last saw event 5000 before a restart, we're only at 20.
+	bufsub := new(eventmocks.BufferedSubscription)
+	bufsub.SinceCalls(func(id int, into []events.Event, timeout time.Duration) []events.Event {
+		if id == 0 {
+			return []events.Event{{SubscriptionID: 1, GlobalID: 20, Type: events.StateChanged}}
+		}
+		time.Sleep(timeout)
+		return nil
+	})
+
+	lines := readEventStream(t, bufsub, "5000", 4)
+	expected := []string{"event: reset", "data: {}", "id: 20", "event: StateChanged"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("got %q, expected %q", lines, expected)
+	}
+}
+
+func TestEventStreamResumeWrapped(t *testing.T) {
+	// The client last saw event 10, but the buffer has since dropped
+	// everything before event 30.
+	bufsub := new(eventmocks.BufferedSubscription)
+	bufsub.SinceCalls(func(id int, into []events.Event, timeout time.Duration) []events.Event {
+		if id == 0 {
+			return []events.Event{
+				{SubscriptionID: 8, GlobalID: 30, Type: events.StateChanged},
+				{SubscriptionID: 9, GlobalID: 40, Type: events.StateChanged},
+			}
+		}
+		time.Sleep(timeout)
+		return nil
+	})
+
+	lines := readEventStream(t, bufsub, "10", 6)
+	expected := []string{"event: reset", "data: {}", "id: 30", "event: StateChanged", "id: 40", "event: StateChanged"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("got %q, expected %q", lines, expected)
+	}
+
+	// Resuming from within the buffer replays the rest, without a reset.
+	lines = readEventStream(t, bufsub, "30", 2)
+	expected = []string{"id: 40", "event: StateChanged"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("got %q, expected %q", lines, expected)
+	}
+}
+
+// readEventStream reads the given number of event and data lines from the
+// StateChanged event stream served from the subscription, resuming from
+// the given event ID if it's not empty.
+func readEventStream(t *testing.T, bufsub events.BufferedSubscription, lastID string, n int) []string {
+	t.Helper()
+
+	eventStreamKeepalive = 100 * time.Millisecond
+	defer func() { eventStreamKeepalive = 20 * time.Second }()
+
+	cfg := newMockedConfig()
+	defSub := new(eventmocks.BufferedSubscription)
+	diskSub := new(eventmocks.BufferedSubscription)
+	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, events.NoopLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	defer os.Remove(token)
+	svc.eventSubs[events.StateChanged] = bufsub
+
+	srv := httptest.NewServer(http.HandlerFunc(svc.getEventStream))
+	defer srv.Close()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"?events=StateChanged", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastID != "" {
+		req.Header.Set("Last-Event-ID", lastID)
+	}
+	// Fails rather than hangs if the stream stays silent.
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var lines []string
+	br := bufio.NewReader(resp.Body)
+	for len(lines) < n {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, ":") && !strings.HasPrefix(line, "data: {\"") {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
 func TestBrowse(t *testing.T) {
 	t.Parallel()
 
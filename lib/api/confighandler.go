@@ -299,6 +299,28 @@ func (c *configMuxBuilder) registerGUI(path string) {
 	})
 }
 
+func (c *configMuxBuilder) registerWebhooks(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
+		sendJSON(w, c.cfg.RawCopy().Webhooks)
+	})
+
+	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		var webhooks []config.WebhookConfiguration
+		if err := unmarshalTo(r.Body, &webhooks); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+			cfg.Webhooks = webhooks
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		c.finish(w, waiter)
+	})
+}
+
 func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request) {
 	to, err := config.ReadJSON(r.Body, c.id)
 	r.Body.Close()
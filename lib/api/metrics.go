@@ -0,0 +1,204 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/model"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	descFolderState = prometheus.NewDesc(
+		"syncthing_folder_state",
+		"Current folder state; the state label carries the state name.",
+		[]string{"folder", "state"}, nil)
+	descFolderSummary = prometheus.NewDesc(
+		"syncthing_folder_summary",
+		"Folder item counts and sizes, by scope (global, local, need) and type.",
+		[]string{"folder", "scope", "type"}, nil)
+	descFolderErrors = prometheus.NewDesc(
+		"syncthing_folder_errors",
+		"Number of current folder errors.",
+		[]string{"folder"}, nil)
+	descFolderSequence = prometheus.NewDesc(
+		"syncthing_folder_sequence",
+		"Combined local and remote sequence number of the folder.",
+		[]string{"folder"}, nil)
+
+	descDeviceConnected = prometheus.NewDesc(
+		"syncthing_device_connected",
+		"Whether the device is currently connected.",
+		[]string{"device"}, nil)
+	descDeviceInBytes = prometheus.NewDesc(
+		"syncthing_device_in_bytes_total",
+		"Bytes received from the device on the current connection.",
+		[]string{"device"}, nil)
+	descDeviceOutBytes = prometheus.NewDesc(
+		"syncthing_device_out_bytes_total",
+		"Bytes sent to the device on the current connection.",
+		[]string{"device"}, nil)
+	descTotalInBytes = prometheus.NewDesc(
+		"syncthing_connections_in_bytes_total",
+		"Bytes received from all devices.",
+		nil, nil)
+	descTotalOutBytes = prometheus.NewDesc(
+		"syncthing_connections_out_bytes_total",
+		"Bytes sent to all devices.",
+		nil, nil)
+
+	descDatabaseSize = prometheus.NewDesc(
+		"syncthing_database_size_bytes",
+		"Size on disk of the database directory.",
+		nil, nil)
+)
+
+// folderSummaryMetrics maps the folder summary keys to the scope and type
+// labels they are exported with.
+var folderSummaryMetrics = []struct {
+	key, scope, typ string
+}{
+	{"globalFiles", "global", "files"},
+	{"globalDirectories", "global", "directories"},
+	{"globalSymlinks", "global", "symlinks"},
+	{"globalDeleted", "global", "deleted"},
+	{"globalBytes", "global", "bytes"},
+	{"localFiles", "local", "files"},
+	{"localDirectories", "local", "directories"},
+	{"localSymlinks", "local", "symlinks"},
+	{"localDeleted", "local", "deleted"},
+	{"localBytes", "local", "bytes"},
+	{"needFiles", "need", "files"},
+	{"needDirectories", "need", "directories"},
+	{"needSymlinks", "need", "symlinks"},
+	{"needDeletes", "need", "deleted"},
+	{"needBytes", "need", "bytes"},
+}
+
+// metricsCollector exports the state of folders, connections and the
+// database at scrape time. Things that happen over time (scans, pulls,
+// events) are instrumented where they happen, in their own packages.
+type metricsCollector struct {
+	cfg   config.Wrapper
+	model model.Model
+	fss   model.FolderSummaryService
+}
+
+func newMetricsCollector(cfg config.Wrapper, m model.Model, fss model.FolderSummaryService) *metricsCollector {
+	return &metricsCollector{
+		cfg:   cfg,
+		model: m,
+		fss:   fss,
+	}
+}
+
+func (c *metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descFolderState
+	ch <- descFolderSummary
+	ch <- descFolderErrors
+	ch <- descFolderSequence
+	ch <- descDeviceConnected
+	ch <- descDeviceInBytes
+	ch <- descDeviceOutBytes
+	ch <- descTotalInBytes
+	ch <- descTotalOutBytes
+	ch <- descDatabaseSize
+}
+
+func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectFolders(ch)
+	c.collectConnections(ch)
+	c.collectDatabase(ch)
+}
+
+func (c *metricsCollector) collectFolders(ch chan<- prometheus.Metric) {
+	for folder := range c.cfg.Folders() {
+		summary, err := c.fss.Summary(folder)
+		if err != nil {
+			l.Debugf("Metrics: getting summary for folder %v: %v", folder, err)
+			continue
+		}
+		if state, ok := summary["state"].(string); ok && state != "" {
+			ch <- prometheus.MustNewConstMetric(descFolderState, prometheus.GaugeValue, 1, folder, state)
+		}
+		for _, m := range folderSummaryMetrics {
+			if val, ok := metricValue(summary[m.key]); ok {
+				ch <- prometheus.MustNewConstMetric(descFolderSummary, prometheus.GaugeValue, val, folder, m.scope, m.typ)
+			}
+		}
+		if val, ok := metricValue(summary["errors"]); ok {
+			ch <- prometheus.MustNewConstMetric(descFolderErrors, prometheus.GaugeValue, val, folder)
+		}
+		if val, ok := metricValue(summary["sequence"]); ok {
+			ch <- prometheus.MustNewConstMetric(descFolderSequence, prometheus.CounterValue, val, folder)
+		}
+	}
+}
+
+func (c *metricsCollector) collectConnections(ch chan<- prometheus.Metric) {
+	if conns, ok := c.model.ConnectionStats()["connections"].(map[string]model.ConnectionInfo); ok {
+		for device, ci := range conns {
+			connected := 0.0
+			if ci.Connected {
+				connected = 1
+			}
+			ch <- prometheus.MustNewConstMetric(descDeviceConnected, prometheus.GaugeValue, connected, device)
+			if !ci.Connected {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(descDeviceInBytes, prometheus.CounterValue, float64(ci.InBytesTotal), device)
+			ch <- prometheus.MustNewConstMetric(descDeviceOutBytes, prometheus.CounterValue, float64(ci.OutBytesTotal), device)
+		}
+	}
+
+	in, out := protocol.TotalInOut()
+	ch <- prometheus.MustNewConstMetric(descTotalInBytes, prometheus.CounterValue, float64(in))
+	ch <- prometheus.MustNewConstMetric(descTotalOutBytes, prometheus.CounterValue, float64(out))
+}
+
+func (c *metricsCollector) collectDatabase(ch chan<- prometheus.Metric) {
+	dbPath := locations.Get(locations.Database)
+	if c.cfg.Options().DatabaseBackend == config.DatabaseBackendBolt {
+		dbPath = locations.Get(locations.DatabaseBolt)
+	}
+	var size int64
+	err := filepath.Walk(dbPath, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		l.Debugln("Metrics: getting database size:", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(descDatabaseSize, prometheus.GaugeValue, float64(size))
+}
+
+func metricValue(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
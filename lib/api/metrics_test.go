@@ -0,0 +1,143 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/model"
+	modelmocks "github.com/syncthing/syncthing/lib/model/mocks"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestMetricsCollector(t *testing.T) {
+	// A database directory with known contents.
+	dataDir := t.TempDir()
+	orig := locations.GetBaseDir(locations.DataBaseDir)
+	if err := locations.SetBaseDir(locations.DataBaseDir, dataDir); err != nil {
+		t.Fatal(err)
+	}
+	defer locations.SetBaseDir(locations.DataBaseDir, orig)
+	dbDir := locations.Get(locations.Database)
+	if err := os.MkdirAll(filepath.Join(dbDir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dbDir, "000001.log"), make([]byte, 1000), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dbDir, "sub", "MANIFEST"), make([]byte, 234), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := newMockedConfig()
+	cfg.FoldersReturns(map[string]config.FolderConfiguration{"default": {ID: "default"}})
+	cfg.OptionsReturns(config.OptionsConfiguration{DatabaseBackend: config.DatabaseBackendLevelDB})
+
+	// Three files and a directory, one of the files still needed.
+	fss := new(modelmocks.FolderSummaryService)
+	fss.SummaryReturns(map[string]interface{}{
+		"state":             "syncing",
+		"globalFiles":       3,
+		"globalDirectories": 1,
+		"globalSymlinks":    0,
+		"globalDeleted":     0,
+		"globalBytes":       int64(300),
+		"localFiles":        2,
+		"localDirectories":  1,
+		"localSymlinks":     0,
+		"localDeleted":      0,
+		"localBytes":        int64(200),
+		"needFiles":         1,
+		"needDirectories":   0,
+		"needSymlinks":      0,
+		"needDeletes":       0,
+		"needBytes":         int64(100),
+		"errors":            2,
+		"sequence":          int64(7),
+	}, nil)
+
+	device1, _ := protocol.DeviceIDFromString("AIR6LPZ-7K4PTTV-UXQSMUU-CPQ5YWH-OEDFIIQ-JUG777G-2YQXXR5-YD6AWQR")
+	device2, _ := protocol.DeviceIDFromString("GYRZZQB-IRNPV4Z-T7TC52W-EQYJ3TT-FDQW6MW-DFLMU42-SSSU6EM-FBK2VAY")
+	m := new(modelmocks.Model)
+	m.ConnectionStatsReturns(map[string]interface{}{
+		"connections": map[string]model.ConnectionInfo{
+			device1.String(): {
+				Statistics: protocol.Statistics{InBytesTotal: 1234, OutBytesTotal: 5678},
+				Connected:  true,
+			},
+			device2.String(): {},
+		},
+	})
+
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(newMetricsCollector(cfg, m, fss))
+
+	expected := `
+# HELP syncthing_database_size_bytes Size on disk of the database directory.
+# TYPE syncthing_database_size_bytes gauge
+syncthing_database_size_bytes 1234
+# HELP syncthing_device_connected Whether the device is currently connected.
+# TYPE syncthing_device_connected gauge
+syncthing_device_connected{device="` + device1.String() + `"} 1
+syncthing_device_connected{device="` + device2.String() + `"} 0
+# HELP syncthing_device_in_bytes_total Bytes received from the device on the current connection.
+# TYPE syncthing_device_in_bytes_total counter
+syncthing_device_in_bytes_total{device="` + device1.String() + `"} 1234
+# HELP syncthing_device_out_bytes_total Bytes sent to the device on the current connection.
+# TYPE syncthing_device_out_bytes_total counter
+syncthing_device_out_bytes_total{device="` + device1.String() + `"} 5678
+# HELP syncthing_folder_errors Number of current folder errors.
+# TYPE syncthing_folder_errors gauge
+syncthing_folder_errors{folder="default"} 2
+# HELP syncthing_folder_sequence Combined local and remote sequence number of the folder.
+# TYPE syncthing_folder_sequence counter
+syncthing_folder_sequence{folder="default"} 7
+# HELP syncthing_folder_state Current folder state; the state label carries the state name.
+# TYPE syncthing_folder_state gauge
+syncthing_folder_state{folder="default",state="syncing"} 1
+# HELP syncthing_folder_summary Folder item counts and sizes, by scope (global, local, need) and type.
+# TYPE syncthing_folder_summary gauge
+syncthing_folder_summary{folder="default",scope="global",type="bytes"} 300
+syncthing_folder_summary{folder="default",scope="global",type="deleted"} 0
+syncthing_folder_summary{folder="default",scope="global",type="directories"} 1
+syncthing_folder_summary{folder="default",scope="global",type="files"} 3
+syncthing_folder_summary{folder="default",scope="global",type="symlinks"} 0
+syncthing_folder_summary{folder="default",scope="local",type="bytes"} 200
+syncthing_folder_summary{folder="default",scope="local",type="deleted"} 0
+syncthing_folder_summary{folder="default",scope="local",type="directories"} 1
+syncthing_folder_summary{folder="default",scope="local",type="files"} 2
+syncthing_folder_summary{folder="default",scope="local",type="symlinks"} 0
+syncthing_folder_summary{folder="default",scope="need",type="bytes"} 100
+syncthing_folder_summary{folder="default",scope="need",type="deleted"} 0
+syncthing_folder_summary{folder="default",scope="need",type="directories"} 0
+syncthing_folder_summary{folder="default",scope="need",type="files"} 1
+syncthing_folder_summary{folder="default",scope="need",type="symlinks"} 0
+`
+	// The connection totals are process wide and vary with other tests,
+	// so they're left out.
+	names := []string{
+		"syncthing_database_size_bytes",
+		"syncthing_device_connected",
+		"syncthing_device_in_bytes_total",
+		"syncthing_device_out_bytes_total",
+		"syncthing_folder_errors",
+		"syncthing_folder_sequence",
+		"syncthing_folder_state",
+		"syncthing_folder_summary",
+	}
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), names...); err != nil {
+		t.Error(err)
+	}
+}
@@ -10,11 +10,19 @@ import (
 	"context"
 	"errors"
 	"net"
+	"strconv"
 	"time"
 
+	"golang.org/x/net/ipv4"
 	"golang.org/x/net/ipv6"
+
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/sync"
 )
 
+// NewMulticast returns a beacon sending to and receiving from the given
+// IPv4 or IPv6 multicast group address, on all multicast capable
+// interfaces.
 func NewMulticast(addr string) Interface {
 	c := newCast("multicastBeacon")
 	c.addReader(func(ctx context.Context) error {
@@ -26,14 +34,63 @@ func NewMulticast(addr string) Interface {
 	return c
 }
 
+// multicastNetwork returns the network, udp4 or udp6, of the given group
+// address.
+func multicastNetwork(addr string) (string, *net.UDPAddr, error) {
+	gaddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return "", nil, err
+	}
+	if gaddr.IP.To4() != nil {
+		return "udp4", gaddr, nil
+	}
+	return "udp6", gaddr, nil
+}
+
+// NewMulticastPort is like NewMulticast, but sends from the socket bound to
+// the group port instead of from an ephemeral port, with the hop limit set
+// to 255. This is what multicast DNS requires; receivers ignore responses
+// from any other source port (RFC 6762, section 11).
+func NewMulticastPort(addr string) Interface {
+	c := newCast("multicastPortBeacon")
+	shared := &sharedConn{mut: sync.NewMutex()}
+	c.addReader(func(ctx context.Context) error {
+		return readMulticastsShared(ctx, c.outbox, addr, shared)
+	})
+	c.addWriter(func(ctx context.Context) error {
+		return writeMulticastsShared(ctx, c.inbox, addr, shared)
+	})
+	return c
+}
+
+// sharedConn holds the socket opened by the reader, for the writer to
+// send from.
+type sharedConn struct {
+	conn    net.PacketConn
+	writeTo func(bs []byte, intf *net.Interface) error
+	mut     sync.Mutex
+}
+
+func (s *sharedConn) set(conn net.PacketConn, writeTo func(bs []byte, intf *net.Interface) error) {
+	s.mut.Lock()
+	s.conn, s.writeTo = conn, writeTo
+	s.mut.Unlock()
+}
+
+func (s *sharedConn) get() (net.PacketConn, func(bs []byte, intf *net.Interface) error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.conn, s.writeTo
+}
+
 func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string) error {
-	gaddr, err := net.ResolveUDPAddr("udp6", addr)
+	network, gaddr, err := multicastNetwork(addr)
 	if err != nil {
 		l.Debugln(err)
 		return err
 	}
 
-	conn, err := net.ListenPacket("udp6", ":0")
+	conn, err := net.ListenPacket(network, ":0")
 	if err != nil {
 		l.Debugln(err)
 		return err
@@ -45,12 +102,7 @@ func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string) erro
 		conn.Close()
 	}()
 
-	pconn := ipv6.NewPacketConn(conn)
-
-	wcm := &ipv6.ControlMessage{
-		HopLimit: 1,
-	}
-
+	writeTo := multicastWriter(network, conn, gaddr, 1)
 	for {
 		var bs []byte
 		select {
@@ -59,53 +111,141 @@ func writeMulticasts(ctx context.Context, inbox <-chan []byte, addr string) erro
 			return doneCtx.Err()
 		}
 
-		intfs, err := net.Interfaces()
-		if err != nil {
-			l.Debugln(err)
+		if err := writeToInterfaces(doneCtx, conn, writeTo, bs, gaddr); err != nil {
 			return err
 		}
+	}
+}
 
-		success := 0
-		for _, intf := range intfs {
-			if intf.Flags&net.FlagMulticast == 0 {
-				continue
-			}
+func writeMulticastsShared(ctx context.Context, inbox <-chan []byte, addr string, shared *sharedConn) error {
+	_, gaddr, err := multicastNetwork(addr)
+	if err != nil {
+		l.Debugln(err)
+		return err
+	}
+
+	for {
+		var bs []byte
+		select {
+		case bs = <-inbox:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 
-			wcm.IfIndex = intf.Index
-			pconn.SetWriteDeadline(time.Now().Add(time.Second))
-			_, err = pconn.WriteTo(bs, wcm, gaddr)
-			pconn.SetWriteDeadline(time.Time{})
+		conn, writeTo := shared.get()
+		if conn == nil {
+			l.Debugln("dropping message, not listening on", gaddr)
+			continue
+		}
+		if err := writeToInterfaces(ctx, conn, writeTo, bs, gaddr); err != nil {
+			return err
+		}
+	}
+}
 
-			if err != nil {
-				l.Debugln(err, "on write to", gaddr, intf.Name)
-				continue
+// multicastWriter returns a function sending to the group address on the
+// given interface, with the given hop limit.
+func multicastWriter(network string, conn net.PacketConn, gaddr *net.UDPAddr, hops int) func(bs []byte, intf *net.Interface) error {
+	if network == "udp4" {
+		pconn := ipv4.NewPacketConn(conn)
+		_ = pconn.SetMulticastTTL(hops)
+		return func(bs []byte, intf *net.Interface) error {
+			if err := pconn.SetMulticastInterface(intf); err != nil {
+				return err
 			}
+			_, err := pconn.WriteTo(bs, nil, gaddr)
+			return err
+		}
+	}
+	pconn := ipv6.NewPacketConn(conn)
+	wcm := &ipv6.ControlMessage{
+		HopLimit: hops,
+	}
+	return func(bs []byte, intf *net.Interface) error {
+		wcm.IfIndex = intf.Index
+		_, err := pconn.WriteTo(bs, wcm, gaddr)
+		return err
+	}
+}
 
-			l.Debugf("sent %d bytes to %v on %s", len(bs), gaddr, intf.Name)
+// writeToInterfaces sends the message on all multicast capable interfaces.
+// It returns an error if it couldn't be sent on any of them.
+func writeToInterfaces(ctx context.Context, conn net.PacketConn, writeTo func(bs []byte, intf *net.Interface) error, bs []byte, gaddr *net.UDPAddr) error {
+	intfs, err := net.Interfaces()
+	if err != nil {
+		l.Debugln(err)
+		return err
+	}
 
-			success++
+	success := 0
+	for _, intf := range intfs {
+		if intf.Flags&net.FlagMulticast == 0 {
+			continue
+		}
 
-			select {
-			case <-doneCtx.Done():
-				return doneCtx.Err()
-			default:
-			}
+		conn.SetWriteDeadline(time.Now().Add(time.Second))
+		err = writeTo(bs, &intf)
+		conn.SetWriteDeadline(time.Time{})
+
+		if err != nil {
+			l.Debugln(err, "on write to", gaddr, intf.Name)
+			continue
 		}
 
-		if success == 0 {
-			return err
+		l.Debugf("sent %d bytes to %v on %s", len(bs), gaddr, intf.Name)
+
+		success++
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
 		}
 	}
+
+	if success == 0 {
+		return err
+	}
+	return nil
 }
 
 func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error {
-	gaddr, err := net.ResolveUDPAddr("udp6", addr)
+	network, gaddr, err := multicastNetwork(addr)
+	if err != nil {
+		l.Debugln(err)
+		return err
+	}
+
+	conn, err := net.ListenPacket(network, addr)
+	if err != nil {
+		l.Debugln(err)
+		return err
+	}
+	doneCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-doneCtx.Done()
+		conn.Close()
+	}()
+
+	if err := joinMulticastGroup(network, conn, gaddr); err != nil {
+		return err
+	}
+	return readPackets(doneCtx, conn, outbox)
+}
+
+func readMulticastsShared(ctx context.Context, outbox chan<- recv, addr string, shared *sharedConn) error {
+	network, gaddr, err := multicastNetwork(addr)
 	if err != nil {
 		l.Debugln(err)
 		return err
 	}
 
-	conn, err := net.ListenPacket("udp6", addr)
+	// Bind the wildcard address, so that we can also send from this
+	// socket, and allow other responders on the same host to share the
+	// port.
+	lc := net.ListenConfig{Control: dialer.ReusePortControl}
+	conn, err := lc.ListenPacket(ctx, network, net.JoinHostPort("", strconv.Itoa(gaddr.Port)))
 	if err != nil {
 		l.Debugln(err)
 		return err
@@ -117,20 +257,42 @@ func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error
 		conn.Close()
 	}()
 
+	if err := joinMulticastGroup(network, conn, gaddr); err != nil {
+		return err
+	}
+
+	shared.set(conn, multicastWriter(network, conn, gaddr, 255))
+	defer shared.set(nil, nil)
+
+	return readPackets(doneCtx, conn, outbox)
+}
+
+func joinMulticastGroup(network string, conn net.PacketConn, gaddr *net.UDPAddr) error {
 	intfs, err := net.Interfaces()
 	if err != nil {
 		l.Debugln(err)
 		return err
 	}
 
-	pconn := ipv6.NewPacketConn(conn)
+	var joinGroup func(intf *net.Interface) error
+	if network == "udp4" {
+		pconn := ipv4.NewPacketConn(conn)
+		joinGroup = func(intf *net.Interface) error {
+			return pconn.JoinGroup(intf, &net.UDPAddr{IP: gaddr.IP})
+		}
+	} else {
+		pconn := ipv6.NewPacketConn(conn)
+		joinGroup = func(intf *net.Interface) error {
+			return pconn.JoinGroup(intf, &net.UDPAddr{IP: gaddr.IP})
+		}
+	}
 	joined := 0
 	for _, intf := range intfs {
-		err := pconn.JoinGroup(&intf, &net.UDPAddr{IP: gaddr.IP})
+		err := joinGroup(&intf)
 		if err != nil {
-			l.Debugln("IPv6 join", intf.Name, "failed:", err)
+			l.Debugln("Multicast join", intf.Name, "failed:", err)
 		} else {
-			l.Debugln("IPv6 join", intf.Name, "success")
+			l.Debugln("Multicast join", intf.Name, "success")
 		}
 		joined++
 	}
@@ -139,15 +301,18 @@ func readMulticasts(ctx context.Context, outbox chan<- recv, addr string) error
 		l.Debugln("no multicast interfaces available")
 		return errors.New("no multicast interfaces available")
 	}
+	return nil
+}
 
+func readPackets(ctx context.Context, conn net.PacketConn, outbox chan<- recv) error {
 	bs := make([]byte, 65536)
 	for {
 		select {
-		case <-doneCtx.Done():
-			return doneCtx.Err()
+		case <-ctx.Done():
+			return ctx.Err()
 		default:
 		}
-		n, _, addr, err := pconn.ReadFrom(bs)
+		n, addr, err := conn.ReadFrom(bs)
 		if err != nil {
 			l.Debugln(err)
 			return err
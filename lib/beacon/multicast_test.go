@@ -0,0 +1,54 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package beacon
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestMulticastPortSendsFromGroupPort(t *testing.T) {
+	const addr = "224.0.0.251:35353"
+
+	b := NewMulticastPort(addr)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go b.Serve(ctx)
+
+	// Our own messages are looped back; keep sending until the socket is
+	// up and one arrives.
+	msg := []byte("multicast port test")
+	received := make(chan net.Addr, 1)
+	go func() {
+		for {
+			data, src := b.Recv()
+			if string(data) == string(msg) {
+				received <- src
+				return
+			}
+		}
+	}()
+
+	timeout := time.After(5 * time.Second)
+	tick := time.NewTicker(100 * time.Millisecond)
+	defer tick.Stop()
+	for {
+		select {
+		case src := <-received:
+			if port := src.(*net.UDPAddr).Port; port != 35353 {
+				t.Errorf("message sent from port %d, expected the group port", port)
+			}
+			return
+		case <-tick.C:
+			b.Send(msg)
+		case <-timeout:
+			t.Skip("no multicast loopback available:", b.Error())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"time"
+)
+
+func (e BandwidthScheduleEntry) Copy() BandwidthScheduleEntry {
+	c := e
+	c.Days = make([]string, len(e.Days))
+	copy(c.Days, e.Days)
+	return c
+}
+
+// Active returns whether the entry applies at the given time.
+func (e BandwidthScheduleEntry) Active(t time.Time) bool {
+	p, err := parsePeriod(e.Days, e.Start, e.End)
+	if err != nil {
+		return false
+	}
+	return p.active(t)
+}
+
+func (e *BandwidthScheduleEntry) prepare() error {
+	if _, err := parsePeriod(e.Days, e.Start, e.End); err != nil {
+		return err
+	}
+	if e.Name == "" {
+		e.Name = e.Start + "-" + e.End
+	}
+	return nil
+}
+
+// activeBandwidthEntry returns the first entry of the schedule that is
+// active at the given time.
+func activeBandwidthEntry(schedule []BandwidthScheduleEntry, t time.Time) (BandwidthScheduleEntry, bool) {
+	for _, e := range schedule {
+		if e.Active(t) {
+			return e, true
+		}
+	}
+	return BandwidthScheduleEntry{}, false
+}
+
+func prepareBandwidthSchedule(schedule []BandwidthScheduleEntry) []BandwidthScheduleEntry {
+	prepared := make([]BandwidthScheduleEntry, 0, len(schedule))
+	for _, e := range schedule {
+		if err := e.prepare(); err != nil {
+			l.Warnf("Ignoring bandwidth schedule entry %q: %v", e.Name, err)
+			continue
+		}
+		prepared = append(prepared, e)
+	}
+	return prepared
+}
+
+func copyBandwidthSchedule(schedule []BandwidthScheduleEntry) []BandwidthScheduleEntry {
+	c := make([]BandwidthScheduleEntry, len(schedule))
+	for i, e := range schedule {
+		c[i] = e.Copy()
+	}
+	return c
+}
@@ -226,6 +226,11 @@ func (cfg Configuration) Copy() Configuration {
 	newCfg.IgnoredDevices = make([]ObservedDevice, len(cfg.IgnoredDevices))
 	copy(newCfg.IgnoredDevices, cfg.IgnoredDevices)
 
+	newCfg.Webhooks = make([]WebhookConfiguration, len(cfg.Webhooks))
+	for i := range newCfg.Webhooks {
+		newCfg.Webhooks[i] = cfg.Webhooks[i].Copy()
+	}
+
 	return newCfg
 }
 
@@ -258,6 +263,10 @@ func (cfg *Configuration) prepare(myID protocol.DeviceID) error {
 
 	cfg.Defaults.prepare(myID, existingDevices)
 
+	for i := range cfg.Webhooks {
+		cfg.Webhooks[i].prepare()
+	}
+
 	cfg.removeDeprecatedProtocols()
 
 	util.FillNilExceptDeprecated(cfg)
@@ -24,15 +24,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Configuration struct {
-	Version                  int                   `protobuf:"varint,1,opt,name=version,proto3,casttype=int" json:"version" xml:"version,attr"`
-	Folders                  []FolderConfiguration `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders" xml:"folder"`
-	Devices                  []DeviceConfiguration `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices" xml:"device"`
-	GUI                      GUIConfiguration      `protobuf:"bytes,4,opt,name=gui,proto3" json:"gui" xml:"gui"`
-	LDAP                     LDAPConfiguration     `protobuf:"bytes,5,opt,name=ldap,proto3" json:"ldap" xml:"ldap"`
-	Options                  OptionsConfiguration  `protobuf:"bytes,6,opt,name=options,proto3" json:"options" xml:"options"`
-	IgnoredDevices           []ObservedDevice      `protobuf:"bytes,7,rep,name=ignored_devices,json=ignoredDevices,proto3" json:"remoteIgnoredDevices" xml:"remoteIgnoredDevice"`
-	DeprecatedPendingDevices []ObservedDevice      `protobuf:"bytes,8,rep,name=pending_devices,json=pendingDevices,proto3" json:"-" xml:"pendingDevice,omitempty"` // Deprecated: Do not use.
-	Defaults                 Defaults              `protobuf:"bytes,9,opt,name=defaults,proto3" json:"defaults" xml:"defaults"`
+	Version                  int                    `protobuf:"varint,1,opt,name=version,proto3,casttype=int" json:"version" xml:"version,attr"`
+	Folders                  []FolderConfiguration  `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	Devices                  []DeviceConfiguration  `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices" xml:"device"`
+	GUI                      GUIConfiguration       `protobuf:"bytes,4,opt,name=gui,proto3" json:"gui" xml:"gui"`
+	LDAP                     LDAPConfiguration      `protobuf:"bytes,5,opt,name=ldap,proto3" json:"ldap" xml:"ldap"`
+	Options                  OptionsConfiguration   `protobuf:"bytes,6,opt,name=options,proto3" json:"options" xml:"options"`
+	IgnoredDevices           []ObservedDevice       `protobuf:"bytes,7,rep,name=ignored_devices,json=ignoredDevices,proto3" json:"remoteIgnoredDevices" xml:"remoteIgnoredDevice"`
+	DeprecatedPendingDevices []ObservedDevice       `protobuf:"bytes,8,rep,name=pending_devices,json=pendingDevices,proto3" json:"-" xml:"pendingDevice,omitempty"` // Deprecated: Do not use.
+	Defaults                 Defaults               `protobuf:"bytes,9,opt,name=defaults,proto3" json:"defaults" xml:"defaults"`
+	Webhooks                 []WebhookConfiguration `protobuf:"bytes,10,rep,name=webhooks,proto3" json:"webhooks" xml:"webhook"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -153,52 +154,54 @@ func init() {
 func init() { proto.RegisterFile("lib/config/config.proto", fileDescriptor_baadf209193dc627) }
 
 var fileDescriptor_baadf209193dc627 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x8d, 0x9b, 0xed, 0x0b, 0x19, 0x44, 0x5d, 0x1e, 0xde, 0xb0, 0x0a, 0x28,
-	0xa0, 0x3e, 0xa4, 0x72, 0xa9, 0xb8, 0x11, 0x22, 0x4a, 0x55, 0x24, 0x2a, 0xa3, 0x22, 0xe0, 0x82,
-	0x92, 0x78, 0xeb, 0xae, 0x94, 0xd8, 0x96, 0xed, 0x54, 0xed, 0x91, 0x23, 0x37, 0xc4, 0x27, 0xe0,
-	0x84, 0xc4, 0x37, 0xe9, 0xad, 0x39, 0x72, 0x5a, 0xa9, 0xcd, 0xcd, 0x47, 0x1f, 0x39, 0xa1, 0x7d,
-	0x39, 0xb6, 0x6a, 0xe0, 0x94, 0xcc, 0xfc, 0xff, 0xf3, 0xdb, 0xd5, 0xec, 0x8c, 0xc1, 0xea, 0x80,
-	0xf4, 0xb6, 0xfa, 0xbe, 0x77, 0x44, 0x5c, 0xf9, 0xb3, 0x19, 0x84, 0x7e, 0xec, 0x1b, 0x35, 0x11,
-	0xdd, 0x69, 0xe6, 0x0c, 0x47, 0xfe, 0xc0, 0xc1, 0xa1, 0x08, 0x46, 0x61, 0x37, 0x26, 0xbe, 0x27,
-	0xdc, 0x05, 0x97, 0x83, 0x4f, 0x48, 0x1f, 0x97, 0xb9, 0x1e, 0xe4, 0x5c, 0xee, 0x88, 0x94, 0x59,
-	0x50, 0xce, 0x32, 0x70, 0xba, 0x41, 0x99, 0xe7, 0x61, 0xce, 0xe3, 0x07, 0x4c, 0x88, 0xca, 0x6c,
-	0x6b, 0x79, 0x5b, 0x2f, 0xc2, 0xe1, 0x09, 0x76, 0xa4, 0x54, 0xc7, 0xa7, 0xb1, 0xf8, 0x8b, 0x7e,
-	0xe8, 0x60, 0xe9, 0x45, 0xbe, 0xda, 0xb0, 0x81, 0x7e, 0x82, 0xc3, 0x88, 0xf8, 0x9e, 0xa9, 0x35,
-	0xb4, 0xd6, 0x5c, 0x7b, 0x27, 0xa1, 0x50, 0xa5, 0x52, 0x0a, 0x8d, 0xd3, 0xe1, 0xe0, 0x19, 0x92,
-	0xf1, 0x7a, 0x37, 0x8e, 0x43, 0xf4, 0x9b, 0xc2, 0x2a, 0xf1, 0xe2, 0xe4, 0xa2, 0xb9, 0x98, 0xcf,
-	0xdb, 0xaa, 0xca, 0x78, 0x07, 0x74, 0xd1, 0xbc, 0xc8, 0x9c, 0x69, 0x54, 0x5b, 0x0b, 0xdb, 0x77,
-	0x37, 0x65, 0xb7, 0x5f, 0xf2, 0x74, 0xe1, 0x06, 0x6d, 0x78, 0x4e, 0x61, 0x85, 0x1d, 0x2a, 0x6b,
-	0x52, 0x0a, 0x17, 0xf9, 0xa1, 0x22, 0x46, 0xb6, 0x12, 0x18, 0x57, 0xb4, 0x3b, 0x32, 0xab, 0x45,
-	0x6e, 0x87, 0xa7, 0xff, 0xc2, 0x95, 0x35, 0x19, 0x57, 0xc4, 0xc8, 0x56, 0x82, 0x61, 0x83, 0xaa,
-	0x3b, 0x22, 0xe6, 0x6c, 0x43, 0x6b, 0x2d, 0x6c, 0x9b, 0x8a, 0xb9, 0x7b, 0xb8, 0x57, 0x04, 0x3e,
-	0x62, 0xc0, 0x2b, 0x0a, 0xab, 0xbb, 0x87, 0x7b, 0x09, 0x85, 0xac, 0x26, 0xa5, 0xb0, 0xce, 0x99,
-	0xee, 0x88, 0xa0, 0x6f, 0xe3, 0x26, 0x93, 0x6c, 0x26, 0x18, 0x1f, 0xc0, 0x2c, 0x7b, 0x51, 0x73,
-	0x8e, 0x43, 0xd7, 0x14, 0xf4, 0x75, 0xe7, 0xf9, 0x41, 0x91, 0xfa, 0x44, 0x52, 0x67, 0x99, 0x94,
-	0x50, 0xc8, 0xcb, 0x52, 0x0a, 0x01, 0xe7, 0xb2, 0x80, 0x81, 0xb9, 0x6a, 0x73, 0xcd, 0x78, 0x0f,
-	0x74, 0x39, 0x08, 0x66, 0x8d, 0xd3, 0xef, 0x29, 0xfa, 0x1b, 0x91, 0x2e, 0x1e, 0xd0, 0x50, 0x7d,
-	0x90, 0x45, 0x29, 0x85, 0x4b, 0x9c, 0x2d, 0x63, 0x64, 0x2b, 0xc5, 0xf8, 0xa9, 0x81, 0x15, 0xe2,
-	0x7a, 0x7e, 0x88, 0x9d, 0x4f, 0xaa, 0xd3, 0x3a, 0xef, 0xf4, 0xed, 0xec, 0x08, 0x39, 0x5b, 0xa2,
-	0xe3, 0xed, 0x63, 0x09, 0xbf, 0x15, 0xe2, 0xa1, 0x1f, 0xe3, 0x3d, 0x51, 0xdc, 0xc9, 0x3a, 0xbe,
-	0xc6, 0x4f, 0x2a, 0x11, 0x51, 0x72, 0xd1, 0xbc, 0x59, 0x92, 0x4f, 0x2f, 0x9a, 0xa5, 0x2c, 0x7b,
-	0x99, 0x14, 0x62, 0xe3, 0x8b, 0x06, 0x56, 0x02, 0xec, 0x39, 0xc4, 0x73, 0xb3, 0xbb, 0xce, 0xff,
-	0xf3, 0xae, 0xaf, 0x64, 0xa7, 0xcd, 0x0e, 0x0e, 0x42, 0xdc, 0xef, 0xc6, 0xd8, 0x39, 0x10, 0x00,
-	0xc9, 0x4c, 0x28, 0xd4, 0x36, 0x52, 0x0a, 0xef, 0xf3, 0x4b, 0x07, 0x79, 0x6d, 0xdd, 0x1f, 0x92,
-	0x18, 0x0f, 0x83, 0xf8, 0x0c, 0x99, 0x9a, 0xbd, 0x5c, 0xd0, 0x22, 0xe3, 0x00, 0xcc, 0x3b, 0xf8,
-	0xa8, 0x3b, 0x1a, 0xc4, 0x91, 0x59, 0xe7, 0x4f, 0x72, 0x63, 0x3a, 0x99, 0x22, 0xdf, 0x46, 0xb2,
-	0x53, 0x99, 0x33, 0xa5, 0x70, 0x59, 0xce, 0xa3, 0x48, 0x20, 0x3b, 0xd3, 0xd0, 0xe7, 0x19, 0x30,
-	0xaf, 0x4a, 0x8d, 0xb7, 0xa0, 0x26, 0x56, 0x80, 0xaf, 0xe8, 0x7f, 0xd6, 0xc9, 0x92, 0xe7, 0xc8,
-	0x92, 0x6b, 0xdb, 0x24, 0xf3, 0x0c, 0x2a, 0xda, 0x66, 0xce, 0x14, 0xa1, 0x65, 0xbb, 0x94, 0x41,
-	0x45, 0xc9, 0xb5, 0x55, 0x92, 0x79, 0x63, 0x1f, 0xe8, 0xe2, 0x99, 0xd8, 0x86, 0x32, 0xea, 0x8a,
-	0xa2, 0x8a, 0xd7, 0x8c, 0xa6, 0xd3, 0x28, 0x7d, 0xd9, 0x34, 0xca, 0x18, 0xd9, 0x4a, 0x41, 0x3b,
-	0x40, 0x97, 0x55, 0xc6, 0x06, 0x98, 0x1b, 0x10, 0x0f, 0x47, 0xa6, 0xd6, 0xa8, 0xb6, 0xea, 0xed,
-	0xd5, 0x84, 0x42, 0x91, 0x98, 0x2e, 0x0a, 0xf1, 0x30, 0xb2, 0x45, 0xb2, 0xbd, 0x7f, 0x7e, 0x69,
-	0x55, 0xc6, 0x97, 0x56, 0xe5, 0xfc, 0xca, 0xd2, 0xc6, 0x57, 0x96, 0xf6, 0x75, 0x62, 0x55, 0xbe,
-	0x4f, 0x2c, 0x6d, 0x3c, 0xb1, 0x2a, 0xbf, 0x26, 0x56, 0xe5, 0xe3, 0x63, 0x97, 0xc4, 0xc7, 0xa3,
-	0xde, 0x66, 0xdf, 0x1f, 0x6e, 0x45, 0x67, 0x5e, 0x3f, 0x3e, 0x26, 0x9e, 0x9b, 0xfb, 0x37, 0xfd,
-	0x9a, 0xf6, 0x6a, 0xfc, 0xd3, 0xf9, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x7d, 0xcb,
-	0x2a, 0x3d, 0x06, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4b, 0x6f, 0xd3, 0x4c,
+	0x14, 0x86, 0xe3, 0xa6, 0xcd, 0x65, 0x7a, 0xfb, 0xe4, 0x0f, 0x51, 0x97, 0x8b, 0x27, 0x8c, 0x02,
+	0x0a, 0xa8, 0x17, 0xa9, 0x6c, 0x2a, 0x76, 0x84, 0x88, 0x52, 0x15, 0x89, 0xca, 0xa8, 0xdc, 0x36,
+	0x28, 0x89, 0x27, 0xce, 0x88, 0xc4, 0x8e, 0x6c, 0xa7, 0xb4, 0x4b, 0x96, 0x6c, 0x10, 0xe2, 0x17,
+	0xb0, 0xe5, 0x9f, 0x74, 0xd7, 0x2c, 0x59, 0x8d, 0xd4, 0x66, 0xe7, 0xa5, 0x97, 0xac, 0xd0, 0xdc,
+	0x1c, 0x5b, 0x35, 0xb0, 0xb2, 0xcf, 0x79, 0xdf, 0xf3, 0xcc, 0xe8, 0x9c, 0x99, 0x01, 0x6b, 0x03,
+	0xd2, 0xd9, 0xee, 0x7a, 0x6e, 0x8f, 0x38, 0xf2, 0xb3, 0x35, 0xf2, 0xbd, 0xd0, 0xd3, 0x4b, 0x22,
+	0xba, 0x51, 0x4f, 0x19, 0x7a, 0xde, 0xc0, 0xc6, 0xbe, 0x08, 0xc6, 0x7e, 0x3b, 0x24, 0x9e, 0x2b,
+	0xdc, 0x19, 0x97, 0x8d, 0x8f, 0x49, 0x17, 0xe7, 0xb9, 0xee, 0xa4, 0x5c, 0xce, 0x98, 0xe4, 0x59,
+	0x50, 0xca, 0x32, 0xb0, 0xdb, 0xa3, 0x3c, 0xcf, 0xdd, 0x94, 0xc7, 0x1b, 0x31, 0x21, 0xc8, 0xb3,
+	0xad, 0xa7, 0x6d, 0x9d, 0x00, 0xfb, 0xc7, 0xd8, 0xce, 0x21, 0x7c, 0xc4, 0x9d, 0xbe, 0xe7, 0x7d,
+	0xc8, 0x23, 0x54, 0xf1, 0x49, 0x28, 0x7e, 0xd1, 0x97, 0x0a, 0x58, 0x7e, 0x92, 0xb6, 0xe8, 0x16,
+	0x28, 0x1f, 0x63, 0x3f, 0x20, 0x9e, 0x6b, 0x68, 0x35, 0xad, 0xb1, 0xd0, 0xdc, 0x8d, 0x28, 0x54,
+	0xa9, 0x98, 0x42, 0xfd, 0x64, 0x38, 0x78, 0x84, 0x64, 0xbc, 0xd1, 0x0e, 0x43, 0x1f, 0xfd, 0xa2,
+	0xb0, 0x48, 0xdc, 0x30, 0x3a, 0xaf, 0x2f, 0xa5, 0xf3, 0x96, 0xaa, 0xd2, 0x5f, 0x81, 0xb2, 0xe8,
+	0x71, 0x60, 0xcc, 0xd5, 0x8a, 0x8d, 0xc5, 0x9d, 0x9b, 0x5b, 0x72, 0x28, 0x4f, 0x79, 0x3a, 0xb3,
+	0x83, 0x26, 0x3c, 0xa3, 0xb0, 0xc0, 0x16, 0x95, 0x35, 0x31, 0x85, 0x4b, 0x7c, 0x51, 0x11, 0x23,
+	0x4b, 0x09, 0x8c, 0x2b, 0xa6, 0x12, 0x18, 0xc5, 0x2c, 0xb7, 0xc5, 0xd3, 0x7f, 0xe0, 0xca, 0x9a,
+	0x84, 0x2b, 0x62, 0x64, 0x29, 0x41, 0xb7, 0x40, 0xd1, 0x19, 0x13, 0x63, 0xbe, 0xa6, 0x35, 0x16,
+	0x77, 0x0c, 0xc5, 0xdc, 0x3b, 0xda, 0xcf, 0x02, 0xef, 0x31, 0xe0, 0x25, 0x85, 0xc5, 0xbd, 0xa3,
+	0xfd, 0x88, 0x42, 0x56, 0x13, 0x53, 0x58, 0xe5, 0x4c, 0x67, 0x4c, 0xd0, 0xb7, 0x49, 0x9d, 0x49,
+	0x16, 0x13, 0xf4, 0xb7, 0x60, 0x9e, 0x0d, 0xde, 0x58, 0xe0, 0xd0, 0x75, 0x05, 0x7d, 0xde, 0x7a,
+	0x7c, 0x98, 0xa5, 0x3e, 0x90, 0xd4, 0x79, 0x26, 0x45, 0x14, 0xf2, 0xb2, 0x98, 0x42, 0xc0, 0xb9,
+	0x2c, 0x60, 0x60, 0xae, 0x5a, 0x5c, 0xd3, 0xdf, 0x80, 0xb2, 0x3c, 0x2f, 0x46, 0x89, 0xd3, 0x6f,
+	0x29, 0xfa, 0x0b, 0x91, 0xce, 0x2e, 0x50, 0x53, 0x7d, 0x90, 0x45, 0x31, 0x85, 0xcb, 0x9c, 0x2d,
+	0x63, 0x64, 0x29, 0x45, 0xff, 0xa1, 0x81, 0x55, 0xe2, 0xb8, 0x9e, 0x8f, 0xed, 0xf7, 0xaa, 0xd3,
+	0x65, 0xde, 0xe9, 0xeb, 0xc9, 0x12, 0xf2, 0x08, 0x8a, 0x8e, 0x37, 0xfb, 0x12, 0x7e, 0xcd, 0xc7,
+	0x43, 0x2f, 0xc4, 0xfb, 0xa2, 0xb8, 0x95, 0x74, 0x7c, 0x9d, 0xaf, 0x94, 0x23, 0xa2, 0xe8, 0xbc,
+	0xfe, 0x7f, 0x4e, 0x3e, 0x3e, 0xaf, 0xe7, 0xb2, 0xac, 0x15, 0x92, 0x89, 0xf5, 0xcf, 0x1a, 0x58,
+	0x1d, 0x61, 0xd7, 0x26, 0xae, 0x93, 0xec, 0xb5, 0xf2, 0xd7, 0xbd, 0x3e, 0x93, 0x9d, 0x36, 0x5a,
+	0x78, 0xe4, 0xe3, 0x6e, 0x3b, 0xc4, 0xf6, 0xa1, 0x00, 0x48, 0x66, 0x44, 0xa1, 0xb6, 0x19, 0x53,
+	0x78, 0x9b, 0x6f, 0x7a, 0x94, 0xd6, 0x36, 0xbc, 0x21, 0x09, 0xf1, 0x70, 0x14, 0x9e, 0x22, 0x43,
+	0xb3, 0x56, 0x32, 0x5a, 0xa0, 0x1f, 0x82, 0x8a, 0x8d, 0x7b, 0xed, 0xf1, 0x20, 0x0c, 0x8c, 0x2a,
+	0x1f, 0xc9, 0x7f, 0xb3, 0x93, 0x29, 0xf2, 0x4d, 0x24, 0x3b, 0x95, 0x38, 0x63, 0x0a, 0x57, 0xe4,
+	0x79, 0x14, 0x09, 0x64, 0x25, 0x9a, 0xde, 0x03, 0x15, 0x79, 0xa3, 0x03, 0x03, 0xd4, 0x8a, 0xe9,
+	0x21, 0xbf, 0x16, 0xf9, 0xec, 0x90, 0x37, 0x14, 0x5d, 0x55, 0x25, 0x53, 0x96, 0x09, 0xd6, 0xef,
+	0xb2, 0xfc, 0xb7, 0x12, 0x17, 0xfa, 0x34, 0x07, 0x2a, 0x6a, 0x8b, 0xfa, 0x4b, 0x50, 0x12, 0x57,
+	0x8d, 0x3f, 0x05, 0xff, 0xb8, 0xb6, 0xa6, 0x5c, 0x51, 0x96, 0x5c, 0xb9, 0xb5, 0x32, 0xcf, 0xa0,
+	0x62, 0x3c, 0xc6, 0x5c, 0x16, 0x9a, 0x77, 0x67, 0x13, 0xa8, 0x28, 0xb9, 0x72, 0x65, 0x65, 0x5e,
+	0x3f, 0x00, 0x65, 0x71, 0x1c, 0xd8, 0x4b, 0xc0, 0xa8, 0xab, 0x8a, 0x2a, 0x4e, 0x4d, 0x30, 0x3b,
+	0xf5, 0xd2, 0x97, 0xf4, 0x43, 0xc6, 0xc8, 0x52, 0x0a, 0xda, 0x05, 0x65, 0x59, 0xa5, 0x6f, 0x82,
+	0x85, 0x01, 0x71, 0x71, 0x60, 0x68, 0xb5, 0x62, 0xa3, 0xda, 0x5c, 0x8b, 0x28, 0x14, 0x89, 0xd9,
+	0x85, 0x24, 0x2e, 0x46, 0x96, 0x48, 0x36, 0x0f, 0xce, 0x2e, 0xcc, 0xc2, 0xe4, 0xc2, 0x2c, 0x9c,
+	0x5d, 0x9a, 0xda, 0xe4, 0xd2, 0xd4, 0xbe, 0x4e, 0xcd, 0xc2, 0xf7, 0xa9, 0xa9, 0x4d, 0xa6, 0x66,
+	0xe1, 0xe7, 0xd4, 0x2c, 0xbc, 0xbb, 0xef, 0x90, 0xb0, 0x3f, 0xee, 0x6c, 0x75, 0xbd, 0xe1, 0x76,
+	0x70, 0xea, 0x76, 0xc3, 0x3e, 0x71, 0x9d, 0xd4, 0xdf, 0xec, 0x05, 0xef, 0x94, 0xf8, 0x13, 0xfd,
+	0xf0, 0xf7, 0x00, 0x5a, 0x26, 0x57, 0x5e, 0xcc, 0x06, 0x00, 0x00,
 }
 
 func (m *Configuration) Marshal() (dAtA []byte, err error) {
@@ -221,6 +224,20 @@ func (m *Configuration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Webhooks) > 0 {
+		for iNdEx := len(m.Webhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Webhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConfig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Defaults.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -462,6 +479,12 @@ func (m *Configuration) ProtoSize() (n int) {
 	}
 	l = m.Defaults.ProtoSize()
 	n += 1 + l + sovConfig(uint64(l))
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.ProtoSize()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -817,6 +840,40 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, WebhookConfiguration{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
@@ -972,10 +1029,7 @@ func (m *Defaults) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthConfig
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthConfig
 			}
 			if (iNdEx + skippy) > l {
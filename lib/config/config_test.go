@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/d4l3k/messagediff"
 
@@ -85,6 +86,7 @@ func TestDefaultValues(t *testing.T) {
 			RawStunServers:          []string{"default"},
 			AnnounceLANAddresses:    true,
 			FeatureFlags:            []string{},
+			BandwidthSchedule:       []BandwidthScheduleEntry{},
 		},
 		Defaults: Defaults{
 			Folder: FolderConfiguration{
@@ -106,18 +108,29 @@ func TestDefaultValues(t *testing.T) {
 				WeakHashThresholdPct: 25,
 				MarkerName:           ".stfolder",
 				MaxConcurrentWrites:  2,
+				XattrFilter: XattrFilter{
+					Entries:            []XattrFilterEntry{},
+					MaxSingleEntrySize: 1024,
+					MaxTotalSize:       4096,
+				},
+				SyncWindows:       []SyncWindow{},
+				SelectedPaths:     []string{},
+				OnDemandCacheSize: Size{10, "GB"},
 			},
 			Device: DeviceConfiguration{
-				Addresses:       []string{"dynamic"},
-				AllowedNetworks: []string{},
-				Compression:     protocol.CompressionMetadata,
-				IgnoredFolders:  []ObservedFolder{},
+				Addresses:         []string{"dynamic"},
+				AllowedNetworks:   []string{},
+				Compression:       protocol.CompressionMetadata,
+				IgnoredFolders:    []ObservedFolder{},
+				BandwidthSchedule: []BandwidthScheduleEntry{},
+				NumConnections:    1,
 			},
 			Ignores: Ignores{
 				Lines: []string{},
 			},
 		},
 		IgnoredDevices: []ObservedDevice{},
+		Webhooks:       []WebhookConfiguration{},
 	}
 	expected.Devices = []DeviceConfiguration{expected.Defaults.Device.Copy()}
 	expected.Devices[0].DeviceID = device1
@@ -177,25 +190,36 @@ func TestDeviceConfig(t *testing.T) {
 				MarkerName:           DefaultMarkerName,
 				JunctionsAsDirs:      true,
 				MaxConcurrentWrites:  maxConcurrentWritesDefault,
+				XattrFilter: XattrFilter{
+					Entries:            []XattrFilterEntry{},
+					MaxSingleEntrySize: xattrMaxSingleEntrySizeDefault,
+					MaxTotalSize:       xattrMaxTotalSizeDefault,
+				},
+				SyncWindows:   []SyncWindow{},
+				SelectedPaths: []string{},
 			},
 		}
 
 		expectedDevices := []DeviceConfiguration{
 			{
-				DeviceID:        device1,
-				Name:            "node one",
-				Addresses:       []string{"tcp://a"},
-				Compression:     protocol.CompressionMetadata,
-				AllowedNetworks: []string{},
-				IgnoredFolders:  []ObservedFolder{},
+				DeviceID:          device1,
+				Name:              "node one",
+				Addresses:         []string{"tcp://a"},
+				Compression:       protocol.CompressionMetadata,
+				AllowedNetworks:   []string{},
+				IgnoredFolders:    []ObservedFolder{},
+				BandwidthSchedule: []BandwidthScheduleEntry{},
+				NumConnections:    1,
 			},
 			{
-				DeviceID:        device4,
-				Name:            "node two",
-				Addresses:       []string{"tcp://b"},
-				Compression:     protocol.CompressionMetadata,
-				AllowedNetworks: []string{},
-				IgnoredFolders:  []ObservedFolder{},
+				DeviceID:          device4,
+				Name:              "node two",
+				Addresses:         []string{"tcp://b"},
+				Compression:       protocol.CompressionMetadata,
+				AllowedNetworks:   []string{},
+				IgnoredFolders:    []ObservedFolder{},
+				BandwidthSchedule: []BandwidthScheduleEntry{},
+				NumConnections:    1,
 			},
 		}
 		expectedDeviceIDs := []protocol.DeviceID{device1, device4}
@@ -271,6 +295,8 @@ func TestOverriddenValues(t *testing.T) {
 		StunKeepaliveMinS:       900,
 		RawStunServers:          []string{"foo"},
 		FeatureFlags:            []string{"feature"},
+		BandwidthSchedule:       []BandwidthScheduleEntry{},
+		PeerExchangeEnabled:     true,
 	}
 	expectedPath := "/media/syncthing"
 
@@ -294,30 +320,38 @@ func TestDeviceAddressesDynamic(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device1,
+			Addresses:         []string{"dynamic"},
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device2,
+			Addresses:         []string{"dynamic"},
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"dynamic"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device3,
+			Addresses:         []string{"dynamic"},
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device4,
+			Name:              name, // Set when auto created
+			Addresses:         []string{"dynamic"},
+			Compression:       protocol.CompressionMetadata,
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 	}
 
@@ -337,33 +371,41 @@ func TestDeviceCompression(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device1,
+			Addresses:         []string{"dynamic"},
+			Compression:       protocol.CompressionMetadata,
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device2,
+			Addresses:         []string{"dynamic"},
+			Compression:       protocol.CompressionMetadata,
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionNever,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device3,
+			Addresses:         []string{"dynamic"},
+			Compression:       protocol.CompressionNever,
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device4,
+			Name:              name, // Set when auto created
+			Addresses:         []string{"dynamic"},
+			Compression:       protocol.CompressionMetadata,
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 	}
 
@@ -383,30 +425,38 @@ func TestDeviceAddressesStatic(t *testing.T) {
 	name, _ := os.Hostname()
 	expected := map[protocol.DeviceID]DeviceConfiguration{
 		device1: {
-			DeviceID:        device1,
-			Addresses:       []string{"tcp://192.0.2.1", "tcp://192.0.2.2"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device1,
+			Addresses:         []string{"tcp://192.0.2.1", "tcp://192.0.2.2"},
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device2: {
-			DeviceID:        device2,
-			Addresses:       []string{"tcp://192.0.2.3:6070", "tcp://[2001:db8::42]:4242"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device2,
+			Addresses:         []string{"tcp://192.0.2.3:6070", "tcp://[2001:db8::42]:4242"},
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device3: {
-			DeviceID:        device3,
-			Addresses:       []string{"tcp://[2001:db8::44]:4444", "tcp://192.0.2.4:6090"},
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device3,
+			Addresses:         []string{"tcp://[2001:db8::44]:4444", "tcp://192.0.2.4:6090"},
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 		device4: {
-			DeviceID:        device4,
-			Name:            name, // Set when auto created
-			Addresses:       []string{"dynamic"},
-			Compression:     protocol.CompressionMetadata,
-			AllowedNetworks: []string{},
-			IgnoredFolders:  []ObservedFolder{},
+			DeviceID:          device4,
+			Name:              name, // Set when auto created
+			Addresses:         []string{"dynamic"},
+			Compression:       protocol.CompressionMetadata,
+			AllowedNetworks:   []string{},
+			IgnoredFolders:    []ObservedFolder{},
+			BandwidthSchedule: []BandwidthScheduleEntry{},
+			NumConnections:    1,
 		},
 	}
 
@@ -1428,3 +1478,197 @@ func TestReceiveEncryptedFolderFixed(t *testing.T) {
 		t.Error("IgnorePerms should be true")
 	}
 }
+
+func TestXattrFilter(t *testing.T) {
+	cases := []struct {
+		entries []string
+		permit  []string
+		deny    []string
+	}{
+		// Empty filter permits everything
+		{entries: nil, permit: []string{"user.foo", "system.acl"}},
+		// Last entry is a catch all deny
+		{
+			entries: []string{"+user.*", "+security.selinux", "-*"},
+			permit:  []string{"user.foo", "user.bar.baz", "security.selinux"},
+			deny:    []string{"system.posix_acl_access", "security.capability"},
+		},
+		// No catch all; unmatched names are denied
+		{
+			entries: []string{"-user.private*", "+user.*"},
+			permit:  []string{"user.foo"},
+			deny:    []string{"user.private", "user.privateparts", "trusted.foo"},
+		},
+	}
+
+	for _, tc := range cases {
+		var filter XattrFilter
+		for _, e := range tc.entries {
+			filter.Entries = append(filter.Entries, XattrFilterEntry{
+				Match:  e[1:],
+				Permit: e[0] == '+',
+			})
+		}
+		for _, name := range tc.permit {
+			if !filter.Permit(name) {
+				t.Errorf("%v: %q should be permitted", tc.entries, name)
+			}
+		}
+		for _, name := range tc.deny {
+			if filter.Permit(name) {
+				t.Errorf("%v: %q should be denied", tc.entries, name)
+			}
+		}
+	}
+}
+
+func TestWebhookDefaults(t *testing.T) {
+	xmlCfg := `<configuration version="36">
+	<webhook id="all">
+		<url>https://example.com/all</url>
+	</webhook>
+	<webhook id="some" enabled="false">
+		<url>https://example.com/some</url>
+		<event>FolderErrors</event>
+		<folder>default</folder>
+		<maxRetries>0</maxRetries>
+	</webhook>
+</configuration>`
+	cfg, _, err := ReadXML(strings.NewReader(xmlCfg), device1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Webhooks) != 2 {
+		t.Fatal("expected two webhooks, got", len(cfg.Webhooks))
+	}
+
+	all := cfg.Webhooks[0]
+	if !all.Enabled || all.MaxRetries != 5 || all.TimeoutS != 10 {
+		t.Errorf("defaults not applied: %+v", all)
+	}
+	if len(all.Events) != 4 {
+		t.Errorf("expected default events, got %v", all.Events)
+	}
+	if !all.PermitsFolder("default") || !all.PermitsDevice(device1.String()) {
+		t.Error("webhook without filters should permit everything")
+	}
+
+	some := cfg.Webhooks[1]
+	if some.Enabled || some.MaxRetries != 0 {
+		t.Errorf("explicit values not kept: %+v", some)
+	}
+	if len(some.Events) != 1 || some.Events[0] != "FolderErrors" {
+		t.Errorf("unexpected events %v", some.Events)
+	}
+	if !some.PermitsFolder("default") || some.PermitsFolder("other") {
+		t.Error("folder filter not applied")
+	}
+}
+
+func TestBandwidthSchedule(t *testing.T) {
+	opts := OptionsConfiguration{
+		MaxSendKbps: 100,
+		MaxRecvKbps: 200,
+		BandwidthSchedule: prepareBandwidthSchedule([]BandwidthScheduleEntry{
+			{Name: "office", Days: []string{"mon", "Tuesday", "WED", "thu", "fri"}, Start: "08:00", End: "17:30", MaxSendKbps: 10, MaxRecvKbps: 20},
+			{Days: []string{"fri", "sat"}, Start: "22:00", End: "06:00"},
+			{Name: "invalid", Start: "25:00"},
+		}),
+	}
+	if len(opts.BandwidthSchedule) != 2 {
+		t.Fatal("expected the invalid entry to be dropped, got", opts.BandwidthSchedule)
+	}
+
+	// 2022-10-17 is a Monday
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2022, 10, 17+day, hour, min, 0, 0, time.Local)
+	}
+	cases := []struct {
+		t          time.Time
+		send, recv int
+		profile    string
+	}{
+		{at(0, 7, 59), 100, 200, ""},
+		{at(0, 8, 0), 10, 20, "office"},
+		{at(4, 17, 29), 10, 20, "office"},
+		{at(0, 17, 30), 100, 200, ""},
+		{at(5, 12, 0), 100, 200, ""},
+		{at(4, 23, 0), 0, 0, "22:00-06:00"},
+		{at(5, 5, 59), 0, 0, "22:00-06:00"},
+		{at(6, 3, 0), 0, 0, "22:00-06:00"},
+		{at(7, 3, 0), 100, 200, ""},
+		{at(3, 3, 0), 100, 200, ""},
+	}
+	for _, tc := range cases {
+		send, recv, profile := opts.BandwidthLimits(tc.t)
+		if send != tc.send || recv != tc.recv || profile != tc.profile {
+			t.Errorf("%v: got %d/%d %q, expected %d/%d %q", tc.t, send, recv, profile, tc.send, tc.recv, tc.profile)
+		}
+	}
+}
+
+func TestSyncWindows(t *testing.T) {
+	fcfg := FolderConfiguration{
+		SyncWindows: prepareSyncWindows("test", []SyncWindow{
+			{Days: []string{"sat", "sun"}},
+			{Days: []string{"mon", "tue", "wed", "thu", "fri"}, Start: "20:00", End: "07:00"},
+			{Start: "7:60"},
+		}),
+	}
+	if len(fcfg.SyncWindows) != 2 {
+		t.Fatal("expected the invalid window to be dropped, got", fcfg.SyncWindows)
+	}
+
+	// 2022-10-17 is a Monday
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2022, 10, 17+day, hour, min, 0, 0, time.Local)
+	}
+	cases := []struct {
+		t, next time.Time
+	}{
+		{at(0, 12, 0), at(0, 20, 0)},
+		{at(0, 20, 0), at(0, 20, 0)},
+		{at(1, 6, 59), at(1, 6, 59)},
+		{at(1, 7, 0), at(1, 20, 0)},
+		{at(5, 12, 0), at(5, 12, 0)},
+		{at(6, 23, 59), at(6, 23, 59)},
+		// Sunday to Monday morning is not covered by the weekday window.
+		{at(7, 3, 0), at(7, 20, 0)},
+	}
+	for _, tc := range cases {
+		if in := fcfg.InSyncWindow(tc.t); in != tc.t.Equal(tc.next) {
+			t.Errorf("%v: in sync window %v", tc.t, in)
+		}
+		if next := fcfg.NextSyncWindow(tc.t); !next.Equal(tc.next) {
+			t.Errorf("%v: next sync window %v, expected %v", tc.t, next, tc.next)
+		}
+	}
+
+	if next := (FolderConfiguration{}).NextSyncWindow(at(0, 0, 0)); !next.Equal(at(0, 0, 0)) {
+		t.Error("folder without sync windows should always sync")
+	}
+
+	ends := []struct {
+		t, end time.Time
+	}{
+		{at(0, 21, 0), at(1, 7, 0)},
+		{at(1, 6, 0), at(1, 7, 0)},
+		// The weekend adjoins the window starting Friday evening.
+		{at(4, 21, 0), at(7, 0, 0)},
+		{at(5, 12, 0), at(7, 0, 0)},
+		// Outside of the windows it's closed already.
+		{at(0, 12, 0), at(0, 12, 0)},
+	}
+	for _, tc := range ends {
+		if end := fcfg.SyncWindowEnd(tc.t); !end.Equal(tc.end) {
+			t.Errorf("%v: sync window ends %v, expected %v", tc.t, end, tc.end)
+		}
+	}
+	if end := (FolderConfiguration{}).SyncWindowEnd(at(0, 0, 0)); !end.IsZero() {
+		t.Error("folder without sync windows should never stop syncing")
+	}
+	always := FolderConfiguration{SyncWindows: []SyncWindow{{}}}
+	if end := always.SyncWindowEnd(at(0, 0, 0)); !end.IsZero() {
+		t.Errorf("window that is always open ends %v", end)
+	}
+}
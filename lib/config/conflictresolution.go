@@ -0,0 +1,42 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (r ConflictResolution) String() string {
+	switch r {
+	case ConflictResolutionKeepBoth:
+		return "keepBoth"
+	case ConflictResolutionNewest:
+		return "newest"
+	case ConflictResolutionPreferDevice:
+		return "preferDevice"
+	case ConflictResolutionPreferLocal:
+		return "preferLocal"
+	default:
+		return "unknown"
+	}
+}
+
+func (r ConflictResolution) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *ConflictResolution) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "keepBoth":
+		*r = ConflictResolutionKeepBoth
+	case "newest":
+		*r = ConflictResolutionNewest
+	case "preferDevice":
+		*r = ConflictResolutionPreferDevice
+	case "preferLocal":
+		*r = ConflictResolutionPreferLocal
+	default:
+		*r = ConflictResolutionKeepBoth
+	}
+	return nil
+}
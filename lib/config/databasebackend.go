@@ -0,0 +1,36 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import "fmt"
+
+func (b DatabaseBackend) String() string {
+	switch b {
+	case DatabaseBackendLevelDB:
+		return "leveldb"
+	case DatabaseBackendBolt:
+		return "bolt"
+	default:
+		return "unknown"
+	}
+}
+
+func (b DatabaseBackend) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func (b *DatabaseBackend) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "leveldb":
+		*b = DatabaseBackendLevelDB
+	case "bolt":
+		*b = DatabaseBackendBolt
+	default:
+		return fmt.Errorf("unknown database backend %q", bs)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ package config
 
 import (
 	"sort"
+	"time"
 )
 
 func (cfg DeviceConfiguration) Copy() DeviceConfiguration {
@@ -18,9 +19,14 @@ func (cfg DeviceConfiguration) Copy() DeviceConfiguration {
 	copy(c.AllowedNetworks, cfg.AllowedNetworks)
 	c.IgnoredFolders = make([]ObservedFolder, len(cfg.IgnoredFolders))
 	copy(c.IgnoredFolders, cfg.IgnoredFolders)
+	c.BandwidthSchedule = copyBandwidthSchedule(cfg.BandwidthSchedule)
 	return c
 }
 
+// maxNumConnections is the upper limit on parallel connections to a single
+// device.
+const maxNumConnections = 16
+
 func (cfg *DeviceConfiguration) prepare(sharedFolders []string) {
 	if len(cfg.Addresses) == 0 || len(cfg.Addresses) == 1 && cfg.Addresses[0] == "" {
 		cfg.Addresses = []string{"dynamic"}
@@ -33,6 +39,24 @@ func (cfg *DeviceConfiguration) prepare(sharedFolders []string) {
 	}
 
 	cfg.IgnoredFolders = sortedObservedFolderSlice(ignoredFolders)
+
+	cfg.BandwidthSchedule = prepareBandwidthSchedule(cfg.BandwidthSchedule)
+
+	if cfg.NumConnections < 1 {
+		cfg.NumConnections = 1
+	} else if cfg.NumConnections > maxNumConnections {
+		cfg.NumConnections = maxNumConnections
+	}
+}
+
+// BandwidthLimits returns the send and receive rate limits for the device,
+// in KiB/s, in effect at the given time, and the name of the bandwidth
+// schedule entry they come from or the empty string if none is active.
+func (cfg DeviceConfiguration) BandwidthLimits(t time.Time) (sendKbps, recvKbps int, profile string) {
+	if e, ok := activeBandwidthEntry(cfg.BandwidthSchedule, t); ok {
+		return e.MaxSendKbps, e.MaxRecvKbps, e.Name
+	}
+	return cfg.MaxSendKbps, cfg.MaxRecvKbps, ""
 }
 
 func (cfg *DeviceConfiguration) IgnoredFolder(folder string) bool {
@@ -44,6 +44,9 @@ type DeviceConfiguration struct {
 	MaxRequestKiB            int                                                  `protobuf:"varint,16,opt,name=max_request_kib,json=maxRequestKib,proto3,casttype=int" json:"maxRequestKiB" xml:"maxRequestKiB"`
 	Untrusted                bool                                                 `protobuf:"varint,17,opt,name=untrusted,proto3" json:"untrusted" xml:"untrusted"`
 	RemoteGUIPort            int                                                  `protobuf:"varint,18,opt,name=remote_gui_port,json=remoteGuiPort,proto3,casttype=int" json:"remoteGUIPort" xml:"remoteGUIPort"`
+	BandwidthSchedule        []BandwidthScheduleEntry                             `protobuf:"bytes,19,rep,name=bandwidth_schedule,json=bandwidthSchedule,proto3" json:"bandwidthSchedule" xml:"bandwidthSchedule,omitempty"`
+	CompressionAlgorithm     protocol.CompressionAlgorithm                        `protobuf:"varint,20,opt,name=compression_algorithm,json=compressionAlgorithm,proto3,enum=protocol.CompressionAlgorithm" json:"compressionAlgorithm" xml:"compressionAlgorithm,attr"`
+	NumConnections           int                                                  `protobuf:"varint,21,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections" default:"1"`
 }
 
 func (m *DeviceConfiguration) Reset()         { *m = DeviceConfiguration{} }
@@ -88,72 +91,82 @@ func init() {
 }
 
 var fileDescriptor_744b782bd13071dd = []byte{
-	// 1026 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
-	0x18, 0x15, 0xeb, 0xc4, 0xb6, 0xce, 0x3f, 0x64, 0xd3, 0x88, 0xc3, 0x18, 0x88, 0x4e, 0x50, 0x35,
-	0x28, 0x68, 0x22, 0x17, 0x6e, 0x27, 0xa3, 0x2d, 0x50, 0xc6, 0x68, 0x63, 0x18, 0x4d, 0x5c, 0x16,
-	0x5d, 0xbc, 0xb0, 0x24, 0xef, 0xac, 0x1c, 0x2c, 0xf2, 0x58, 0xf2, 0xa8, 0x58, 0x40, 0xff, 0x80,
-	0x76, 0x2b, 0x02, 0x74, 0xea, 0x92, 0xf6, 0xdf, 0xe8, 0xd0, 0xd5, 0x9b, 0x35, 0x16, 0x1d, 0x0e,
-	0x88, 0xbd, 0x71, 0x29, 0xc0, 0x31, 0x53, 0x71, 0x77, 0x14, 0x45, 0xca, 0x51, 0x50, 0xa0, 0x1b,
-	0xef, 0xbd, 0x77, 0xef, 0xdd, 0xf7, 0xe9, 0xbb, 0x13, 0xe8, 0x0c, 0x88, 0xbb, 0xeb, 0xd1, 0xe0,
-	0x94, 0xf4, 0x77, 0x11, 0x1e, 0x12, 0x0f, 0xab, 0x45, 0x12, 0x39, 0x8c, 0xd0, 0xa0, 0x17, 0x46,
-	0x94, 0x51, 0x7d, 0x51, 0x81, 0x3b, 0xdb, 0x42, 0x2d, 0x21, 0x8f, 0x0e, 0x76, 0x5d, 0x1c, 0x2a,
-	0x7e, 0xe7, 0x5e, 0xc9, 0x85, 0xba, 0x31, 0x8e, 0x86, 0x18, 0xe5, 0x54, 0x1d, 0x9f, 0x33, 0xf5,
-	0xd9, 0xfe, 0x67, 0x03, 0x6c, 0x1d, 0xc8, 0x8c, 0xc7, 0xe5, 0x0c, 0xfd, 0x4f, 0x0d, 0xd4, 0x55,
-	0xb6, 0x4d, 0x90, 0xa1, 0xb5, 0xb4, 0xee, 0xaa, 0xf9, 0x9b, 0x76, 0xc1, 0x61, 0xed, 0x6f, 0x0e,
-	0x3f, 0xee, 0x13, 0xf6, 0x3c, 0x71, 0x7b, 0x1e, 0xf5, 0x77, 0xe3, 0x51, 0xe0, 0xb1, 0xe7, 0x24,
-	0xe8, 0x97, 0xbe, 0xca, 0x27, 0xea, 0x29, 0xf7, 0xc3, 0x83, 0x2b, 0x0e, 0x97, 0x27, 0xdf, 0x29,
-	0x87, 0xcb, 0x28, 0xff, 0xce, 0x38, 0x6c, 0x9e, 0xfb, 0x83, 0xfd, 0x36, 0x41, 0x0f, 0x1d, 0xc6,
-	0xa2, 0x76, 0x2b, 0xa0, 0x08, 0x9f, 0x3a, 0xc9, 0x80, 0xed, 0xb7, 0x59, 0x94, 0xe0, 0x76, 0x7a,
-	0xd9, 0x59, 0xca, 0xc9, 0xec, 0xb2, 0x53, 0x6c, 0xfc, 0x71, 0xdc, 0xd1, 0x5e, 0x8e, 0x3b, 0x85,
-	0xe9, 0xab, 0x71, 0x47, 0xb3, 0x26, 0x2c, 0xd2, 0x8f, 0xc1, 0xad, 0xc0, 0xf1, 0xb1, 0xf1, 0x5e,
-	0x4b, 0xeb, 0xd6, 0xcd, 0x4f, 0x52, 0x0e, 0xe5, 0x3a, 0xe3, 0xf0, 0x9e, 0x8c, 0x13, 0x0b, 0xe9,
-	0xf9, 0x90, 0xfa, 0x84, 0x61, 0x3f, 0x64, 0x23, 0x91, 0xb4, 0xf5, 0x16, 0xdc, 0x92, 0x3b, 0xf5,
-	0x73, 0x50, 0x77, 0x10, 0x8a, 0x70, 0x1c, 0xe3, 0xd8, 0x58, 0x68, 0x2d, 0x74, 0xeb, 0xe6, 0x49,
-	0xca, 0xe1, 0x14, 0xcc, 0x38, 0x7c, 0x20, 0xbd, 0x73, 0xa4, 0xe4, 0xdc, 0x2a, 0x4a, 0x42, 0xa3,
-	0xc0, 0xf1, 0x89, 0x27, 0xb2, 0x36, 0x6f, 0xe8, 0xde, 0x5c, 0x76, 0x96, 0x72, 0x81, 0x35, 0xf5,
-	0xd5, 0x87, 0x60, 0xc5, 0xa3, 0x7e, 0x28, 0x56, 0x84, 0x06, 0xc6, 0xad, 0x96, 0xd6, 0x5d, 0xdf,
-	0xbb, 0xd3, 0x2b, 0x7a, 0xfc, 0x78, 0x4a, 0x9a, 0x9f, 0xa6, 0x1c, 0x96, 0xd5, 0x19, 0x87, 0xdb,
-	0xf2, 0x50, 0x25, 0x4c, 0x35, 0x3a, 0xbd, 0xec, 0x6c, 0xcc, 0x82, 0x56, 0x79, 0xab, 0x8e, 0x41,
-	0xdd, 0xc3, 0x11, 0xb3, 0x65, 0x23, 0x6f, 0xcb, 0x46, 0x3e, 0x11, 0xbf, 0x9d, 0x00, 0x9f, 0xaa,
-	0x66, 0xde, 0x57, 0xde, 0x39, 0xf0, 0x96, 0x86, 0xde, 0x9d, 0xc3, 0x59, 0x85, 0x8b, 0x7e, 0x02,
-	0x00, 0x09, 0x58, 0x44, 0x51, 0xe2, 0xe1, 0xc8, 0x58, 0x6c, 0x69, 0xdd, 0x65, 0x73, 0x3f, 0xe5,
-	0xb0, 0x84, 0x66, 0x1c, 0xde, 0x51, 0x53, 0x52, 0x40, 0x45, 0x11, 0x8d, 0x19, 0xcc, 0x2a, 0xed,
-	0xd3, 0x7f, 0xd7, 0xc0, 0x4e, 0x7c, 0x46, 0x42, 0x7b, 0x82, 0x89, 0xf1, 0xb6, 0x23, 0xec, 0xd3,
-	0xa1, 0x33, 0x88, 0x8d, 0x25, 0x19, 0x86, 0x52, 0x0e, 0x0d, 0xa1, 0x3a, 0x2c, 0x89, 0xac, 0x5c,
-	0x93, 0x71, 0xf8, 0xbe, 0x8c, 0x9e, 0x27, 0x28, 0x0e, 0x72, 0xff, 0x9d, 0x0a, 0x6b, 0x6e, 0x82,
-	0xfe, 0x87, 0x06, 0xd6, 0x8a, 0x33, 0x23, 0xdb, 0x1d, 0x19, 0xcb, 0xf2, 0xc6, 0xfd, 0xf2, 0xbf,
-	0x6e, 0x5c, 0xca, 0xe1, 0xea, 0xd4, 0xd5, 0x1c, 0x65, 0x1c, 0x76, 0xab, 0x3d, 0x44, 0xe6, 0x68,
-	0xfe, 0x9d, 0xdb, 0xbc, 0x21, 0x13, 0x37, 0x4e, 0xde, 0xb2, 0x8a, 0xad, 0xbe, 0x07, 0x16, 0x43,
-	0x27, 0x89, 0x31, 0x32, 0xea, 0xb2, 0x9b, 0x3b, 0x29, 0x87, 0x39, 0x92, 0x71, 0xb8, 0x2a, 0x23,
-	0xd5, 0xb2, 0x6d, 0xe5, 0xb8, 0xfe, 0x03, 0xd8, 0x70, 0x06, 0x03, 0xfa, 0x02, 0x23, 0x3b, 0xc0,
-	0xec, 0x05, 0x8d, 0xce, 0x62, 0x03, 0xc8, 0x2b, 0xf5, 0x75, 0xca, 0x61, 0x23, 0xe7, 0x9e, 0xe6,
-	0x54, 0xf1, 0x46, 0x54, 0xf1, 0xea, 0xa0, 0x19, 0xf3, 0x48, 0x6b, 0xd6, 0x4e, 0xff, 0x0e, 0x6c,
-	0x39, 0x09, 0xa3, 0xb6, 0xe3, 0x79, 0x38, 0x64, 0xf6, 0x29, 0x1d, 0x20, 0x1c, 0xc5, 0xc6, 0x8a,
-	0x3c, 0xfe, 0x87, 0x29, 0x87, 0x9b, 0x82, 0xfe, 0x5c, 0xb2, 0x5f, 0x28, 0x32, 0xe3, 0xf0, 0xae,
-	0x3a, 0xc2, 0x2c, 0xd3, 0xb6, 0x6e, 0xaa, 0xf5, 0x67, 0x60, 0xcd, 0x77, 0xce, 0xed, 0x18, 0x07,
-	0xc8, 0x3e, 0x73, 0xc3, 0xd8, 0x58, 0x6d, 0x69, 0xdd, 0xdb, 0xe6, 0x07, 0xe2, 0x72, 0xfa, 0xce,
-	0xf9, 0x37, 0x38, 0x40, 0x47, 0x6e, 0x28, 0x5c, 0x37, 0xa5, 0x6b, 0x09, 0x6b, 0xbf, 0xe1, 0x70,
-	0x81, 0x04, 0xcc, 0x2a, 0x0b, 0x27, 0x86, 0x11, 0xf6, 0x86, 0xca, 0x70, 0xad, 0x62, 0x68, 0x61,
-	0x6f, 0x38, 0x6b, 0x38, 0xc1, 0x2a, 0x86, 0x13, 0x50, 0x0f, 0x40, 0x83, 0xf4, 0x03, 0x1a, 0x61,
-	0x54, 0xd4, 0xbf, 0xde, 0x5a, 0xe8, 0xae, 0xec, 0x6d, 0xf7, 0xd4, 0xbf, 0x46, 0xef, 0x59, 0xfe,
-	0xaf, 0xa1, 0x6a, 0x32, 0x1f, 0x89, 0x59, 0x4c, 0x39, 0x5c, 0xcf, 0xb7, 0x4d, 0x1b, 0xb3, 0xa5,
-	0xa6, 0xaa, 0x0c, 0xb7, 0xad, 0x19, 0x99, 0xfe, 0x93, 0x06, 0x1a, 0x21, 0x0e, 0x10, 0x09, 0xfa,
-	0x45, 0x60, 0xe3, 0x9d, 0x81, 0x4f, 0x44, 0xe0, 0x15, 0x87, 0xc6, 0x01, 0x0e, 0x23, 0xec, 0x39,
-	0x0c, 0xa3, 0x63, 0x65, 0x90, 0x7b, 0xa6, 0x1c, 0x6a, 0x8f, 0x8a, 0x37, 0x28, 0x2c, 0x73, 0xa5,
-	0xd1, 0x30, 0x34, 0x6b, 0xbd, 0xc2, 0xc5, 0xfa, 0xaf, 0x1a, 0x68, 0xa8, 0x6e, 0x7e, 0x9f, 0xe0,
-	0x98, 0xd9, 0x67, 0xc4, 0x35, 0x36, 0x64, 0x3f, 0xe3, 0x2b, 0x0e, 0xd7, 0xbe, 0x12, 0x6d, 0x92,
-	0xcc, 0x11, 0x31, 0x53, 0x0e, 0xd7, 0xfc, 0x32, 0x50, 0x14, 0x5c, 0x41, 0x27, 0x4d, 0x4e, 0x2f,
-	0x3b, 0x33, 0xf2, 0x59, 0xe0, 0xe5, 0xb8, 0x53, 0x4d, 0xb0, 0x2a, 0xbc, 0xab, 0x7f, 0x06, 0xea,
-	0x49, 0xc0, 0xa2, 0x24, 0x66, 0x18, 0x19, 0x9b, 0x72, 0x26, 0x5b, 0xe2, 0x7f, 0xa6, 0x00, 0x33,
-	0x0e, 0x1b, 0xf2, 0x04, 0x05, 0xd2, 0xb6, 0xa6, 0xac, 0xac, 0x4e, 0x3c, 0x70, 0x0c, 0xdb, 0xfd,
-	0x84, 0xd8, 0x21, 0x8d, 0x98, 0xa1, 0x4f, 0xab, 0xb3, 0x24, 0xf5, 0xe5, 0xb7, 0x87, 0xc7, 0x34,
-	0x62, 0xa2, 0xba, 0xa8, 0x0c, 0x14, 0xd5, 0x55, 0xd0, 0x72, 0x75, 0x55, 0xf9, 0x2c, 0x20, 0xaa,
-	0xab, 0x24, 0x58, 0x13, 0x3e, 0x21, 0x62, 0x69, 0x1e, 0x5d, 0xbc, 0x6e, 0xd6, 0xc6, 0xaf, 0x9b,
-	0xb5, 0x8b, 0xab, 0xa6, 0x36, 0xbe, 0x6a, 0x6a, 0x3f, 0x5f, 0x37, 0x6b, 0xaf, 0xae, 0x9b, 0xda,
-	0xf8, 0xba, 0x59, 0xfb, 0xeb, 0xba, 0x59, 0x3b, 0x79, 0xf0, 0x1f, 0x1e, 0x3b, 0x35, 0x31, 0xee,
-	0xa2, 0x7c, 0xf4, 0x3e, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x4a, 0x4f, 0x60, 0x33, 0x09,
-	0x00, 0x00,
+	// 1190 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0xc4,
+	0x1f, 0x5e, 0xff, 0xd3, 0xa6, 0xd9, 0x69, 0x92, 0xed, 0x3a, 0x2f, 0x75, 0xf2, 0x57, 0x77, 0x96,
+	0x65, 0x0f, 0x5b, 0xd1, 0x6e, 0x68, 0xe0, 0x14, 0x01, 0x52, 0x9d, 0x00, 0x8d, 0x22, 0xda, 0xe0,
+	0x0a, 0x09, 0x45, 0x42, 0xc6, 0xf6, 0x4c, 0x36, 0x56, 0xd6, 0x63, 0x63, 0x8f, 0x37, 0x59, 0x89,
+	0x0f, 0x00, 0x37, 0x54, 0x89, 0x13, 0x97, 0xc2, 0x19, 0x3e, 0x41, 0x0f, 0x5c, 0x73, 0xdb, 0x3d,
+	0x22, 0x0e, 0x23, 0x35, 0xb9, 0xf9, 0xe8, 0x63, 0x4e, 0xc8, 0xe3, 0x97, 0xb5, 0x9d, 0x6c, 0x84,
+	0xc4, 0xcd, 0xf3, 0x3c, 0xcf, 0x3c, 0xbf, 0x17, 0xff, 0xc6, 0x63, 0xd0, 0xee, 0x9b, 0xfa, 0x86,
+	0x61, 0x93, 0x43, 0xb3, 0xb7, 0x81, 0xf0, 0xc0, 0x34, 0x70, 0xbc, 0xf0, 0x5d, 0x8d, 0x9a, 0x36,
+	0xe9, 0x3a, 0xae, 0x4d, 0x6d, 0x71, 0x36, 0x06, 0xd7, 0x57, 0x23, 0x35, 0x87, 0x0c, 0xbb, 0xbf,
+	0xa1, 0x63, 0x27, 0xe6, 0xd7, 0xd7, 0x72, 0x2e, 0xb6, 0xee, 0x61, 0x77, 0x80, 0x51, 0x42, 0xb5,
+	0x72, 0x94, 0xae, 0x11, 0x74, 0x62, 0x22, 0x7a, 0xe4, 0x19, 0x47, 0x18, 0xf9, 0x7d, 0x9c, 0x68,
+	0xaa, 0xf8, 0x94, 0xc6, 0x8f, 0xad, 0x37, 0xcb, 0x60, 0x69, 0x87, 0xe7, 0xb1, 0x9d, 0xcf, 0x43,
+	0xfc, 0x53, 0x00, 0xd5, 0x38, 0x3f, 0xd5, 0x44, 0x92, 0xd0, 0x14, 0x3a, 0xf3, 0xf2, 0xaf, 0xc2,
+	0x19, 0x83, 0x95, 0xbf, 0x19, 0xfc, 0xb0, 0x67, 0xd2, 0x23, 0x5f, 0xef, 0x1a, 0xb6, 0xb5, 0xe1,
+	0x0d, 0x89, 0x41, 0x8f, 0x4c, 0xd2, 0xcb, 0x3d, 0xe5, 0xb3, 0xee, 0xc6, 0xee, 0xbb, 0x3b, 0xe7,
+	0x0c, 0xce, 0xa5, 0xcf, 0x01, 0x83, 0x73, 0x28, 0x79, 0x0e, 0x19, 0x6c, 0x9c, 0x5a, 0xfd, 0xad,
+	0x96, 0x89, 0x1e, 0x69, 0x94, 0xba, 0xad, 0x26, 0xb1, 0x11, 0x3e, 0xd4, 0xfc, 0x3e, 0xdd, 0x6a,
+	0x51, 0xd7, 0xc7, 0xad, 0x60, 0xd4, 0xbe, 0x93, 0x90, 0xe1, 0xa8, 0x9d, 0x6d, 0xfc, 0x61, 0xdc,
+	0x16, 0x5e, 0x8d, 0xdb, 0x99, 0xe9, 0xeb, 0x71, 0x5b, 0x50, 0x52, 0x16, 0x89, 0xfb, 0xe0, 0x16,
+	0xd1, 0x2c, 0x2c, 0xfd, 0xaf, 0x29, 0x74, 0xaa, 0xf2, 0x47, 0x01, 0x83, 0x7c, 0x1d, 0x32, 0xb8,
+	0xc6, 0xc3, 0x45, 0x0b, 0xee, 0xf9, 0xc8, 0xb6, 0x4c, 0x8a, 0x2d, 0x87, 0x0e, 0xa3, 0x48, 0x4b,
+	0xd7, 0xe0, 0x0a, 0xdf, 0x29, 0x9e, 0x82, 0xaa, 0x86, 0x90, 0x8b, 0x3d, 0x0f, 0x7b, 0xd2, 0x4c,
+	0x73, 0xa6, 0x53, 0x95, 0x0f, 0x02, 0x06, 0x27, 0x60, 0xc8, 0xe0, 0x43, 0xee, 0x9d, 0x20, 0x39,
+	0xe7, 0x66, 0x56, 0x12, 0x1a, 0x12, 0xcd, 0x32, 0x8d, 0x28, 0x56, 0xfd, 0x8a, 0xee, 0x72, 0xd4,
+	0xbe, 0x93, 0x08, 0x94, 0x89, 0xaf, 0x38, 0x00, 0x77, 0x0d, 0xdb, 0x72, 0xa2, 0x95, 0x69, 0x13,
+	0xe9, 0x56, 0x53, 0xe8, 0x2c, 0x6e, 0xae, 0x74, 0xb3, 0x1e, 0x6f, 0x4f, 0x48, 0xf9, 0xe3, 0x80,
+	0xc1, 0xbc, 0x3a, 0x64, 0x70, 0x95, 0x27, 0x95, 0xc3, 0xe2, 0x46, 0x07, 0xa3, 0xf6, 0xbd, 0x32,
+	0xa8, 0xe4, 0xb7, 0x8a, 0x18, 0x54, 0x0d, 0xec, 0x52, 0x95, 0x37, 0xf2, 0x36, 0x6f, 0xe4, 0xb3,
+	0xe8, 0xdd, 0x45, 0xe0, 0xf3, 0xb8, 0x99, 0x0f, 0x62, 0xef, 0x04, 0xb8, 0xa6, 0xa1, 0xf7, 0xa7,
+	0x70, 0x4a, 0xe6, 0x22, 0x1e, 0x00, 0x60, 0x12, 0xea, 0xda, 0xc8, 0x37, 0xb0, 0x2b, 0xcd, 0x36,
+	0x85, 0xce, 0x9c, 0xbc, 0x15, 0x30, 0x98, 0x43, 0x43, 0x06, 0x57, 0xe2, 0x29, 0xc9, 0xa0, 0xac,
+	0x88, 0x5a, 0x09, 0x53, 0x72, 0xfb, 0xc4, 0xdf, 0x04, 0xb0, 0xee, 0x1d, 0x9b, 0x8e, 0x9a, 0x62,
+	0xd1, 0x78, 0xab, 0x2e, 0xb6, 0xec, 0x81, 0xd6, 0xf7, 0xa4, 0x3b, 0x3c, 0x18, 0x0a, 0x18, 0x94,
+	0x22, 0xd5, 0x6e, 0x4e, 0xa4, 0x24, 0x9a, 0x90, 0xc1, 0x77, 0x79, 0xe8, 0x69, 0x82, 0x2c, 0x91,
+	0x07, 0x37, 0x2a, 0x94, 0xa9, 0x11, 0xc4, 0x37, 0x02, 0x58, 0xc8, 0x72, 0x46, 0xaa, 0x3e, 0x94,
+	0xe6, 0xf8, 0x89, 0xfb, 0xf9, 0x3f, 0x9d, 0xb8, 0x80, 0xc1, 0xf9, 0x89, 0xab, 0x3c, 0x0c, 0x19,
+	0xec, 0x14, 0x7b, 0x88, 0xe4, 0xe1, 0xf4, 0x33, 0x57, 0xbf, 0x22, 0x8b, 0x4e, 0x1c, 0x3f, 0x65,
+	0x05, 0x5b, 0x71, 0x13, 0xcc, 0x3a, 0x9a, 0xef, 0x61, 0x24, 0x55, 0x79, 0x37, 0xd7, 0x03, 0x06,
+	0x13, 0x24, 0x64, 0x70, 0x9e, 0x87, 0x8c, 0x97, 0x2d, 0x25, 0xc1, 0xc5, 0xef, 0xc1, 0x3d, 0xad,
+	0xdf, 0xb7, 0x4f, 0x30, 0x52, 0x09, 0xa6, 0x27, 0xb6, 0x7b, 0xec, 0x49, 0x80, 0x1f, 0xa9, 0x2f,
+	0x03, 0x06, 0x6b, 0x09, 0xf7, 0x3c, 0xa1, 0xb2, 0x6f, 0x44, 0x11, 0x2f, 0x0e, 0x9a, 0x34, 0x8d,
+	0x54, 0xca, 0x76, 0xe2, 0xb7, 0x60, 0x49, 0xf3, 0xa9, 0xad, 0x6a, 0x86, 0x81, 0x1d, 0xaa, 0x1e,
+	0xda, 0x7d, 0x84, 0x5d, 0x4f, 0xba, 0xcb, 0xd3, 0x7f, 0x3f, 0x60, 0xb0, 0x1e, 0xd1, 0x4f, 0x39,
+	0xfb, 0x59, 0x4c, 0x86, 0x0c, 0xde, 0x8f, 0x53, 0x28, 0x33, 0x2d, 0xe5, 0xaa, 0x5a, 0x7c, 0x01,
+	0x16, 0x2c, 0xed, 0x54, 0xf5, 0x30, 0x41, 0xea, 0xb1, 0xee, 0x78, 0xd2, 0x7c, 0x53, 0xe8, 0xdc,
+	0x96, 0xdf, 0x8b, 0x0e, 0xa7, 0xa5, 0x9d, 0xbe, 0xc4, 0x04, 0xed, 0xe9, 0x4e, 0xe4, 0x5a, 0xe7,
+	0xae, 0x39, 0xac, 0x75, 0xc9, 0xe0, 0x8c, 0x49, 0xa8, 0x92, 0x17, 0xa6, 0x86, 0x2e, 0x36, 0x06,
+	0xb1, 0xe1, 0x42, 0xc1, 0x50, 0xc1, 0xc6, 0xa0, 0x6c, 0x98, 0x62, 0x05, 0xc3, 0x14, 0x14, 0x09,
+	0xa8, 0x99, 0x3d, 0x62, 0xbb, 0x18, 0x65, 0xf5, 0x2f, 0x36, 0x67, 0x3a, 0x77, 0x37, 0x57, 0xbb,
+	0xf1, 0xf5, 0xd1, 0x7d, 0x91, 0xdc, 0x2c, 0x71, 0x4d, 0xf2, 0xe3, 0x68, 0x16, 0x03, 0x06, 0x17,
+	0x93, 0x6d, 0x93, 0xc6, 0x2c, 0xc5, 0x53, 0x95, 0x87, 0x5b, 0x4a, 0x49, 0x26, 0xfe, 0x28, 0x80,
+	0x9a, 0x83, 0x09, 0x32, 0x49, 0x2f, 0x0b, 0x58, 0xbb, 0x31, 0xe0, 0xb3, 0x28, 0xe0, 0x39, 0x83,
+	0xd2, 0x0e, 0x76, 0x5c, 0x6c, 0x68, 0x14, 0xa3, 0xfd, 0xd8, 0x20, 0xf1, 0x0c, 0x18, 0x14, 0x1e,
+	0x67, 0xdf, 0x20, 0x27, 0xcf, 0xe5, 0x46, 0x43, 0x12, 0x94, 0xc5, 0x02, 0xe7, 0x89, 0xbf, 0x08,
+	0xa0, 0x16, 0x77, 0xf3, 0x3b, 0x1f, 0x7b, 0x54, 0x3d, 0x36, 0x75, 0xe9, 0x1e, 0xef, 0xa7, 0x77,
+	0xce, 0xe0, 0xc2, 0x17, 0x51, 0x9b, 0x38, 0xb3, 0x67, 0xca, 0x01, 0x83, 0x0b, 0x56, 0x1e, 0xc8,
+	0x0a, 0x2e, 0xa0, 0x69, 0x93, 0x83, 0x51, 0xbb, 0x24, 0x2f, 0x03, 0xaf, 0xc6, 0xed, 0x62, 0x04,
+	0xa5, 0xc0, 0xeb, 0xe2, 0x27, 0xa0, 0xea, 0x13, 0xea, 0xfa, 0x1e, 0xc5, 0x48, 0xaa, 0xf3, 0x99,
+	0x6c, 0x46, 0xf7, 0x4c, 0x06, 0x86, 0x0c, 0xd6, 0x78, 0x06, 0x19, 0xd2, 0x52, 0x26, 0x2c, 0xaf,
+	0x2e, 0xfa, 0xc0, 0x51, 0xac, 0xf6, 0x7c, 0x53, 0x75, 0x6c, 0x97, 0x4a, 0xe2, 0xa4, 0x3a, 0x85,
+	0x53, 0x9f, 0x7f, 0xb5, 0xbb, 0x6f, 0xbb, 0x34, 0xaa, 0xce, 0xcd, 0x03, 0x59, 0x75, 0x05, 0x34,
+	0x5f, 0x5d, 0x51, 0x5e, 0x06, 0xa2, 0xea, 0x0a, 0x11, 0x94, 0x94, 0xf7, 0xcd, 0x68, 0x29, 0xfe,
+	0x2e, 0x00, 0x31, 0xfb, 0x31, 0x51, 0xd3, 0x3f, 0x13, 0x69, 0x89, 0x8f, 0x42, 0x23, 0x1d, 0x05,
+	0x39, 0x55, 0xbc, 0x4c, 0x04, 0x9f, 0x12, 0xea, 0x0e, 0xe5, 0x6f, 0x92, 0x19, 0xac, 0xeb, 0x65,
+	0x3e, 0x64, 0xf0, 0x1d, 0x9e, 0xf7, 0x15, 0xa6, 0xf8, 0x95, 0xf8, 0xff, 0x0d, 0xbc, 0x72, 0xd5,
+	0x56, 0xfc, 0x43, 0x00, 0x2b, 0xb9, 0x2b, 0x51, 0xd5, 0xfa, 0x3d, 0xdb, 0x35, 0xe9, 0x91, 0x25,
+	0x2d, 0xf3, 0x5b, 0xb8, 0x71, 0xed, 0x2d, 0xfc, 0x34, 0x55, 0xc9, 0x5f, 0x07, 0x0c, 0x2e, 0x1b,
+	0xd7, 0x30, 0x21, 0x83, 0xb0, 0x7c, 0x2f, 0x67, 0x64, 0x76, 0xa5, 0xac, 0x4d, 0x65, 0x95, 0x6b,
+	0x5d, 0xc5, 0x63, 0x50, 0x23, 0xbe, 0xa5, 0x1a, 0x36, 0x21, 0x98, 0x5f, 0x32, 0x9e, 0xb4, 0xc2,
+	0xdf, 0x7d, 0x34, 0xc8, 0x8b, 0xc4, 0xb7, 0xb6, 0x27, 0x4c, 0xc8, 0x60, 0x33, 0xfe, 0x17, 0x2a,
+	0xc0, 0xb9, 0x9f, 0x95, 0x27, 0xe9, 0x8b, 0xbf, 0x1c, 0xb5, 0x85, 0x27, 0x4a, 0x69, 0xbf, 0xbc,
+	0x77, 0xf6, 0xb6, 0x51, 0x19, 0xbf, 0x6d, 0x54, 0xce, 0xce, 0x1b, 0xc2, 0xf8, 0xbc, 0x21, 0xfc,
+	0x74, 0xd1, 0xa8, 0xbc, 0xbe, 0x68, 0x08, 0xe3, 0x8b, 0x46, 0xe5, 0xaf, 0x8b, 0x46, 0xe5, 0xe0,
+	0xe1, 0xbf, 0xb8, 0xb8, 0xe2, 0x57, 0xae, 0xcf, 0xf2, 0x46, 0x7e, 0xf0, 0xcf, 0x00, 0x90, 0x5c,
+	0xb1, 0x63, 0x23, 0x0b, 0x00, 0x00,
 }
 
 func (m *DeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -176,6 +189,36 @@ func (m *DeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NumConnections != 0 {
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.NumConnections))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.CompressionAlgorithm != 0 {
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.CompressionAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.BandwidthSchedule) > 0 {
+		for iNdEx := len(m.BandwidthSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BandwidthSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDeviceconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if m.RemoteGUIPort != 0 {
 		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.RemoteGUIPort))
 		i--
@@ -423,6 +466,18 @@ func (m *DeviceConfiguration) ProtoSize() (n int) {
 	if m.RemoteGUIPort != 0 {
 		n += 2 + sovDeviceconfiguration(uint64(m.RemoteGUIPort))
 	}
+	if len(m.BandwidthSchedule) > 0 {
+		for _, e := range m.BandwidthSchedule {
+			l = e.ProtoSize()
+			n += 2 + l + sovDeviceconfiguration(uint64(l))
+		}
+	}
+	if m.CompressionAlgorithm != 0 {
+		n += 2 + sovDeviceconfiguration(uint64(m.CompressionAlgorithm))
+	}
+	if m.NumConnections != 0 {
+		n += 2 + sovDeviceconfiguration(uint64(m.NumConnections))
+	}
 	return n
 }
 
@@ -918,6 +973,78 @@ func (m *DeviceConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandwidthSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandwidthSchedule = append(m.BandwidthSchedule, BandwidthScheduleEntry{})
+			if err := m.BandwidthSchedule[len(m.BandwidthSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionAlgorithm", wireType)
+			}
+			m.CompressionAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompressionAlgorithm |= protocol.CompressionAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumConnections", wireType)
+			}
+			m.NumConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumConnections |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeviceconfiguration(dAtA[iNdEx:])
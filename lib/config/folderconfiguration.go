@@ -9,6 +9,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"path"
 	"runtime"
 	"sort"
 	"strings"
@@ -32,6 +33,9 @@ const (
 	EncryptionTokenName        = "syncthing-encryption_password_token"
 	maxConcurrentWritesDefault = 2
 	maxConcurrentWritesLimit   = 64
+
+	xattrMaxSingleEntrySizeDefault = 1024
+	xattrMaxTotalSizeDefault       = 4096
 )
 
 func (f FolderConfiguration) Copy() FolderConfiguration {
@@ -39,6 +43,13 @@ func (f FolderConfiguration) Copy() FolderConfiguration {
 	c.Devices = make([]FolderDeviceConfiguration, len(f.Devices))
 	copy(c.Devices, f.Devices)
 	c.Versioning = f.Versioning.Copy()
+	c.XattrFilter = f.XattrFilter.Copy()
+	c.SyncWindows = make([]SyncWindow, len(f.SyncWindows))
+	for i, w := range f.SyncWindows {
+		c.SyncWindows[i] = w.Copy()
+	}
+	c.SelectedPaths = make([]string, len(f.SelectedPaths))
+	copy(c.SelectedPaths, f.SelectedPaths)
 	return c
 }
 
@@ -193,12 +204,18 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.FSWatcherDelayS = 10
 	}
 
+	f.SyncWindows = prepareSyncWindows(f.ID, f.SyncWindows)
+
 	if f.Versioning.CleanupIntervalS > MaxRescanIntervalS {
 		f.Versioning.CleanupIntervalS = MaxRescanIntervalS
 	} else if f.Versioning.CleanupIntervalS < 0 {
 		f.Versioning.CleanupIntervalS = 0
 	}
 
+	if f.ScrubIntervalS < 0 {
+		f.ScrubIntervalS = 0
+	}
+
 	if f.WeakHashThresholdPct == 0 {
 		f.WeakHashThresholdPct = 25
 	}
@@ -207,15 +224,38 @@ func (f *FolderConfiguration) prepare(myID protocol.DeviceID, existingDevices ma
 		f.MarkerName = DefaultMarkerName
 	}
 
+	if f.ConflictResolution == ConflictResolutionPreferDevice && f.ConflictPreferredDevice == protocol.EmptyDeviceID {
+		l.Warnf("Folder %q prefers no particular device in conflicts; keeping both versions instead", f.ID)
+		f.ConflictResolution = ConflictResolutionKeepBoth
+	}
+
+	if f.MaxRemoteDeletes < 0 {
+		f.MaxRemoteDeletes = 0
+	}
+	if f.MaxRemoteDeletesPct < 0 {
+		f.MaxRemoteDeletesPct = 0
+	} else if f.MaxRemoteDeletesPct > 100 {
+		f.MaxRemoteDeletesPct = 100
+	}
+
 	if f.MaxConcurrentWrites <= 0 {
 		f.MaxConcurrentWrites = maxConcurrentWritesDefault
 	} else if f.MaxConcurrentWrites > maxConcurrentWritesLimit {
 		f.MaxConcurrentWrites = maxConcurrentWritesLimit
 	}
 
+	if f.XattrFilter.MaxSingleEntrySize <= 0 {
+		f.XattrFilter.MaxSingleEntrySize = xattrMaxSingleEntrySizeDefault
+	}
+	if f.XattrFilter.MaxTotalSize <= 0 {
+		f.XattrFilter.MaxTotalSize = xattrMaxTotalSizeDefault
+	}
+
 	if f.Type == FolderTypeReceiveEncrypted {
 		f.DisableTempIndexes = true
 		f.IgnorePerms = true
+		f.SyncOwnership = false
+		f.SyncXattrs = false
 	}
 }
 
@@ -266,3 +306,101 @@ func (f *FolderConfiguration) CheckAvailableSpace(req uint64) error {
 	}
 	return nil
 }
+
+// ExceedsRemoteDeletes returns whether the given number of pending remote
+// deletions, out of the given total number of global items, is above the
+// configured mass deletion threshold.
+func (f FolderConfiguration) ExceedsRemoteDeletes(deletes, total int) bool {
+	if f.MaxRemoteDeletes > 0 && deletes > f.MaxRemoteDeletes {
+		return true
+	}
+	return f.MaxRemoteDeletesPct > 0 && total > 0 && deletes*100 > f.MaxRemoteDeletesPct*total
+}
+
+// InSyncWindow returns whether the folder may pull at the given time, i.e.
+// whether it has no sync windows or one of them is open.
+func (f FolderConfiguration) InSyncWindow(t time.Time) bool {
+	if len(f.SyncWindows) == 0 {
+		return true
+	}
+	for _, w := range f.SyncWindows {
+		if w.Active(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextSyncWindow returns the first time at or after the given time when the
+// folder may pull, or the zero time if it never may.
+func (f FolderConfiguration) NextSyncWindow(t time.Time) time.Time {
+	if len(f.SyncWindows) == 0 {
+		return t
+	}
+	var next time.Time
+	for _, w := range f.SyncWindows {
+		if n := w.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+	}
+	return next
+}
+
+// SyncWindowEnd returns when the folder stops being allowed to pull, given
+// a time within its sync windows. Adjoining and overlapping windows count
+// as one. The zero time means the folder has no sync windows, or they
+// don't close within a week.
+func (f FolderConfiguration) SyncWindowEnd(t time.Time) time.Time {
+	if len(f.SyncWindows) == 0 {
+		return time.Time{}
+	}
+	end := t
+	for limit := t.AddDate(0, 0, 7); end.Before(limit); {
+		next := end
+		for _, w := range f.SyncWindows {
+			if w.Active(end) {
+				if closes := w.Closes(end); closes.After(next) {
+					next = closes
+				}
+			}
+		}
+		if !next.After(end) {
+			return end
+		}
+		end = next
+	}
+	return time.Time{}
+}
+
+func (f XattrFilter) Copy() XattrFilter {
+	c := f
+	if f.Entries != nil {
+		c.Entries = make([]XattrFilterEntry, len(f.Entries))
+		copy(c.Entries, f.Entries)
+	}
+	return c
+}
+
+// Permit returns whether the given extended attribute name is permitted by
+// the filter. The first matching entry decides; an empty filter permits
+// everything, while a name not matched by a non-empty filter is denied.
+func (f XattrFilter) Permit(s string) bool {
+	if len(f.Entries) == 0 {
+		return true
+	}
+
+	for _, entry := range f.Entries {
+		if ok, _ := path.Match(entry.Match, s); ok {
+			return entry.Permit
+		}
+	}
+	return false
+}
+
+func (f XattrFilter) GetMaxSingleEntrySize() int {
+	return f.MaxSingleEntrySize
+}
+
+func (f XattrFilter) GetMaxTotalSize() int {
+	return f.MaxTotalSize
+}
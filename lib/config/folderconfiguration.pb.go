@@ -66,40 +66,54 @@ func (m *FolderDeviceConfiguration) XXX_DiscardUnknown() {
 var xxx_messageInfo_FolderDeviceConfiguration proto.InternalMessageInfo
 
 type FolderConfiguration struct {
-	ID                      string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr" nodefault:"true"`
-	Label                   string                      `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label,attr" restart:"false"`
-	FilesystemType          fs.FilesystemType           `protobuf:"varint,3,opt,name=filesystem_type,json=filesystemType,proto3,enum=fs.FilesystemType" json:"filesystemType" xml:"filesystemType"`
-	Path                    string                      `protobuf:"bytes,4,opt,name=path,proto3" json:"path" xml:"path,attr" default:"~"`
-	Type                    FolderType                  `protobuf:"varint,5,opt,name=type,proto3,enum=config.FolderType" json:"type" xml:"type,attr"`
-	Devices                 []FolderDeviceConfiguration `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device"`
-	RescanIntervalS         int                         `protobuf:"varint,7,opt,name=rescan_interval_s,json=rescanIntervalS,proto3,casttype=int" json:"rescanIntervalS" xml:"rescanIntervalS,attr" default:"3600"`
-	FSWatcherEnabled        bool                        `protobuf:"varint,8,opt,name=fs_watcher_enabled,json=fsWatcherEnabled,proto3" json:"fsWatcherEnabled" xml:"fsWatcherEnabled,attr" default:"true"`
-	FSWatcherDelayS         int                         `protobuf:"varint,9,opt,name=fs_watcher_delay_s,json=fsWatcherDelayS,proto3,casttype=int" json:"fsWatcherDelayS" xml:"fsWatcherDelayS,attr" default:"10"`
-	IgnorePerms             bool                        `protobuf:"varint,10,opt,name=ignore_perms,json=ignorePerms,proto3" json:"ignorePerms" xml:"ignorePerms,attr"`
-	AutoNormalize           bool                        `protobuf:"varint,11,opt,name=auto_normalize,json=autoNormalize,proto3" json:"autoNormalize" xml:"autoNormalize,attr" default:"true"`
-	MinDiskFree             Size                        `protobuf:"bytes,12,opt,name=min_disk_free,json=minDiskFree,proto3" json:"minDiskFree" xml:"minDiskFree" default:"1 %"`
-	Versioning              VersioningConfiguration     `protobuf:"bytes,13,opt,name=versioning,proto3" json:"versioning" xml:"versioning"`
-	Copiers                 int                         `protobuf:"varint,14,opt,name=copiers,proto3,casttype=int" json:"copiers" xml:"copiers"`
-	PullerMaxPendingKiB     int                         `protobuf:"varint,15,opt,name=puller_max_pending_kib,json=pullerMaxPendingKib,proto3,casttype=int" json:"pullerMaxPendingKiB" xml:"pullerMaxPendingKiB"`
-	Hashers                 int                         `protobuf:"varint,16,opt,name=hashers,proto3,casttype=int" json:"hashers" xml:"hashers"`
-	Order                   PullOrder                   `protobuf:"varint,17,opt,name=order,proto3,enum=config.PullOrder" json:"order" xml:"order"`
-	IgnoreDelete            bool                        `protobuf:"varint,18,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
-	ScanProgressIntervalS   int                         `protobuf:"varint,19,opt,name=scan_progress_interval_s,json=scanProgressIntervalS,proto3,casttype=int" json:"scanProgressIntervalS" xml:"scanProgressIntervalS"`
-	PullerPauseS            int                         `protobuf:"varint,20,opt,name=puller_pause_s,json=pullerPauseS,proto3,casttype=int" json:"pullerPauseS" xml:"pullerPauseS"`
-	MaxConflicts            int                         `protobuf:"varint,21,opt,name=max_conflicts,json=maxConflicts,proto3,casttype=int" json:"maxConflicts" xml:"maxConflicts" default:"10"`
-	DisableSparseFiles      bool                        `protobuf:"varint,22,opt,name=disable_sparse_files,json=disableSparseFiles,proto3" json:"disableSparseFiles" xml:"disableSparseFiles"`
-	DisableTempIndexes      bool                        `protobuf:"varint,23,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
-	Paused                  bool                        `protobuf:"varint,24,opt,name=paused,proto3" json:"paused" xml:"paused"`
-	WeakHashThresholdPct    int                         `protobuf:"varint,25,opt,name=weak_hash_threshold_pct,json=weakHashThresholdPct,proto3,casttype=int" json:"weakHashThresholdPct" xml:"weakHashThresholdPct"`
-	MarkerName              string                      `protobuf:"bytes,26,opt,name=marker_name,json=markerName,proto3" json:"markerName" xml:"markerName"`
-	CopyOwnershipFromParent bool                        `protobuf:"varint,27,opt,name=copy_ownership_from_parent,json=copyOwnershipFromParent,proto3" json:"copyOwnershipFromParent" xml:"copyOwnershipFromParent"`
-	RawModTimeWindowS       int                         `protobuf:"varint,28,opt,name=mod_time_window_s,json=modTimeWindowS,proto3,casttype=int" json:"modTimeWindowS" xml:"modTimeWindowS"`
-	MaxConcurrentWrites     int                         `protobuf:"varint,29,opt,name=max_concurrent_writes,json=maxConcurrentWrites,proto3,casttype=int" json:"maxConcurrentWrites" xml:"maxConcurrentWrites" default:"2"`
-	DisableFsync            bool                        `protobuf:"varint,30,opt,name=disable_fsync,json=disableFsync,proto3" json:"disableFsync" xml:"disableFsync"`
-	BlockPullOrder          BlockPullOrder              `protobuf:"varint,31,opt,name=block_pull_order,json=blockPullOrder,proto3,enum=config.BlockPullOrder" json:"blockPullOrder" xml:"blockPullOrder"`
-	CopyRangeMethod         fs.CopyRangeMethod          `protobuf:"varint,32,opt,name=copy_range_method,json=copyRangeMethod,proto3,enum=fs.CopyRangeMethod" json:"copyRangeMethod" xml:"copyRangeMethod" default:"standard"`
-	CaseSensitiveFS         bool                        `protobuf:"varint,33,opt,name=case_sensitive_fs,json=caseSensitiveFs,proto3" json:"caseSensitiveFS" xml:"caseSensitiveFS"`
-	JunctionsAsDirs         bool                        `protobuf:"varint,34,opt,name=follow_junctions,json=followJunctions,proto3" json:"junctionsAsDirs" xml:"junctionsAsDirs"`
+	ID                      string                                               `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr" nodefault:"true"`
+	Label                   string                                               `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label,attr" restart:"false"`
+	FilesystemType          fs.FilesystemType                                    `protobuf:"varint,3,opt,name=filesystem_type,json=filesystemType,proto3,enum=fs.FilesystemType" json:"filesystemType" xml:"filesystemType"`
+	Path                    string                                               `protobuf:"bytes,4,opt,name=path,proto3" json:"path" xml:"path,attr" default:"~"`
+	Type                    FolderType                                           `protobuf:"varint,5,opt,name=type,proto3,enum=config.FolderType" json:"type" xml:"type,attr"`
+	Devices                 []FolderDeviceConfiguration                          `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device"`
+	RescanIntervalS         int                                                  `protobuf:"varint,7,opt,name=rescan_interval_s,json=rescanIntervalS,proto3,casttype=int" json:"rescanIntervalS" xml:"rescanIntervalS,attr" default:"3600"`
+	FSWatcherEnabled        bool                                                 `protobuf:"varint,8,opt,name=fs_watcher_enabled,json=fsWatcherEnabled,proto3" json:"fsWatcherEnabled" xml:"fsWatcherEnabled,attr" default:"true"`
+	FSWatcherDelayS         int                                                  `protobuf:"varint,9,opt,name=fs_watcher_delay_s,json=fsWatcherDelayS,proto3,casttype=int" json:"fsWatcherDelayS" xml:"fsWatcherDelayS,attr" default:"10"`
+	IgnorePerms             bool                                                 `protobuf:"varint,10,opt,name=ignore_perms,json=ignorePerms,proto3" json:"ignorePerms" xml:"ignorePerms,attr"`
+	AutoNormalize           bool                                                 `protobuf:"varint,11,opt,name=auto_normalize,json=autoNormalize,proto3" json:"autoNormalize" xml:"autoNormalize,attr" default:"true"`
+	MinDiskFree             Size                                                 `protobuf:"bytes,12,opt,name=min_disk_free,json=minDiskFree,proto3" json:"minDiskFree" xml:"minDiskFree" default:"1 %"`
+	Versioning              VersioningConfiguration                              `protobuf:"bytes,13,opt,name=versioning,proto3" json:"versioning" xml:"versioning"`
+	Copiers                 int                                                  `protobuf:"varint,14,opt,name=copiers,proto3,casttype=int" json:"copiers" xml:"copiers"`
+	PullerMaxPendingKiB     int                                                  `protobuf:"varint,15,opt,name=puller_max_pending_kib,json=pullerMaxPendingKib,proto3,casttype=int" json:"pullerMaxPendingKiB" xml:"pullerMaxPendingKiB"`
+	Hashers                 int                                                  `protobuf:"varint,16,opt,name=hashers,proto3,casttype=int" json:"hashers" xml:"hashers"`
+	Order                   PullOrder                                            `protobuf:"varint,17,opt,name=order,proto3,enum=config.PullOrder" json:"order" xml:"order"`
+	IgnoreDelete            bool                                                 `protobuf:"varint,18,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
+	ScanProgressIntervalS   int                                                  `protobuf:"varint,19,opt,name=scan_progress_interval_s,json=scanProgressIntervalS,proto3,casttype=int" json:"scanProgressIntervalS" xml:"scanProgressIntervalS"`
+	PullerPauseS            int                                                  `protobuf:"varint,20,opt,name=puller_pause_s,json=pullerPauseS,proto3,casttype=int" json:"pullerPauseS" xml:"pullerPauseS"`
+	MaxConflicts            int                                                  `protobuf:"varint,21,opt,name=max_conflicts,json=maxConflicts,proto3,casttype=int" json:"maxConflicts" xml:"maxConflicts" default:"10"`
+	DisableSparseFiles      bool                                                 `protobuf:"varint,22,opt,name=disable_sparse_files,json=disableSparseFiles,proto3" json:"disableSparseFiles" xml:"disableSparseFiles"`
+	DisableTempIndexes      bool                                                 `protobuf:"varint,23,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
+	Paused                  bool                                                 `protobuf:"varint,24,opt,name=paused,proto3" json:"paused" xml:"paused"`
+	WeakHashThresholdPct    int                                                  `protobuf:"varint,25,opt,name=weak_hash_threshold_pct,json=weakHashThresholdPct,proto3,casttype=int" json:"weakHashThresholdPct" xml:"weakHashThresholdPct"`
+	MarkerName              string                                               `protobuf:"bytes,26,opt,name=marker_name,json=markerName,proto3" json:"markerName" xml:"markerName"`
+	CopyOwnershipFromParent bool                                                 `protobuf:"varint,27,opt,name=copy_ownership_from_parent,json=copyOwnershipFromParent,proto3" json:"copyOwnershipFromParent" xml:"copyOwnershipFromParent"`
+	RawModTimeWindowS       int                                                  `protobuf:"varint,28,opt,name=mod_time_window_s,json=modTimeWindowS,proto3,casttype=int" json:"modTimeWindowS" xml:"modTimeWindowS"`
+	MaxConcurrentWrites     int                                                  `protobuf:"varint,29,opt,name=max_concurrent_writes,json=maxConcurrentWrites,proto3,casttype=int" json:"maxConcurrentWrites" xml:"maxConcurrentWrites" default:"2"`
+	DisableFsync            bool                                                 `protobuf:"varint,30,opt,name=disable_fsync,json=disableFsync,proto3" json:"disableFsync" xml:"disableFsync"`
+	BlockPullOrder          BlockPullOrder                                       `protobuf:"varint,31,opt,name=block_pull_order,json=blockPullOrder,proto3,enum=config.BlockPullOrder" json:"blockPullOrder" xml:"blockPullOrder"`
+	CopyRangeMethod         fs.CopyRangeMethod                                   `protobuf:"varint,32,opt,name=copy_range_method,json=copyRangeMethod,proto3,enum=fs.CopyRangeMethod" json:"copyRangeMethod" xml:"copyRangeMethod" default:"standard"`
+	CaseSensitiveFS         bool                                                 `protobuf:"varint,33,opt,name=case_sensitive_fs,json=caseSensitiveFs,proto3" json:"caseSensitiveFS" xml:"caseSensitiveFS"`
+	JunctionsAsDirs         bool                                                 `protobuf:"varint,34,opt,name=follow_junctions,json=followJunctions,proto3" json:"junctionsAsDirs" xml:"junctionsAsDirs"`
+	SyncXattrs              bool                                                 `protobuf:"varint,35,opt,name=sync_xattrs,json=syncXattrs,proto3" json:"syncXattrs" xml:"syncXattrs"`
+	XattrFilter             XattrFilter                                          `protobuf:"bytes,36,opt,name=xattr_filter,json=xattrFilter,proto3" json:"xattrFilter" xml:"xattrFilter"`
+	SyncOwnership           bool                                                 `protobuf:"varint,37,opt,name=sync_ownership,json=syncOwnership,proto3" json:"syncOwnership" xml:"syncOwnership"`
+	SyncWindows             []SyncWindow                                         `protobuf:"bytes,38,rep,name=sync_windows,json=syncWindows,proto3" json:"syncWindows" xml:"syncWindow,omitempty"`
+	ScanInSyncWindowsOnly   bool                                                 `protobuf:"varint,39,opt,name=scan_in_sync_windows_only,json=scanInSyncWindowsOnly,proto3" json:"scanInSyncWindowsOnly" xml:"scanInSyncWindowsOnly"`
+	SelectedPaths           []string                                             `protobuf:"bytes,40,rep,name=selected_paths,json=selectedPaths,proto3" json:"selectedPaths" xml:"selectedPath,omitempty"`
+	OnDemandCacheSize       Size                                                 `protobuf:"bytes,41,opt,name=on_demand_cache_size,json=onDemandCacheSize,proto3" json:"onDemandCacheSize" xml:"onDemandCacheSize" default:"10 GB"`
+	MaxRemoteDeletes        int                                                  `protobuf:"varint,42,opt,name=max_remote_deletes,json=maxRemoteDeletes,proto3,casttype=int" json:"maxRemoteDeletes" xml:"maxRemoteDeletes"`
+	MaxRemoteDeletesPct     int                                                  `protobuf:"varint,43,opt,name=max_remote_deletes_pct,json=maxRemoteDeletesPct,proto3,casttype=int" json:"maxRemoteDeletesPct" xml:"maxRemoteDeletesPct"`
+	ConflictResolution      ConflictResolution                                   `protobuf:"varint,44,opt,name=conflict_resolution,json=conflictResolution,proto3,enum=config.ConflictResolution" json:"conflictResolution" xml:"conflictResolution"`
+	ConflictPreferredDevice github_com_syncthing_syncthing_lib_protocol.DeviceID `protobuf:"bytes,45,opt,name=conflict_preferred_device,json=conflictPreferredDevice,proto3,customtype=github.com/syncthing/syncthing/lib/protocol.DeviceID" json:"conflictPreferredDevice" xml:"conflictPreferredDevice" nodefault:"true"`
+	ContentDefinedChunking  bool                                                 `protobuf:"varint,46,opt,name=content_defined_chunking,json=contentDefinedChunking,proto3" json:"contentDefinedChunking" xml:"contentDefinedChunking"`
+	ScrubIntervalS          int                                                  `protobuf:"varint,47,opt,name=scrub_interval_s,json=scrubIntervalS,proto3,casttype=int" json:"scrubIntervalS" xml:"scrubIntervalS"`
+	ScrubRepair             bool                                                 `protobuf:"varint,48,opt,name=scrub_repair,json=scrubRepair,proto3" json:"scrubRepair" xml:"scrubRepair"`
 	// Legacy deprecated
 	DeprecatedReadOnly       bool    `protobuf:"varint,9000,opt,name=read_only,json=readOnly,proto3" json:"-" xml:"ro,attr,omitempty"`                       // Deprecated: Do not use.
 	DeprecatedMinDiskFreePct float64 `protobuf:"fixed64,9001,opt,name=min_disk_free_pct,json=minDiskFreePct,proto3" json:"-" xml:"minDiskFreePct,omitempty"` // Deprecated: Do not use.
@@ -139,9 +153,93 @@ func (m *FolderConfiguration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FolderConfiguration proto.InternalMessageInfo
 
+// Extended attribute filter. This is a list of patterns to match (glob
+// style), each with an action (permit or deny). First match is used. If
+// the filter is empty, all strings are permitted. If the filter is
+// non-empty, the default action becomes deny. To counter this, you can use
+// the "*" pattern to match all strings at the end of the filter.
+type XattrFilter struct {
+	Entries            []XattrFilterEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" xml:"entry"`
+	MaxSingleEntrySize int                `protobuf:"varint,2,opt,name=max_single_entry_size,json=maxSingleEntrySize,proto3,casttype=int" json:"maxSingleEntrySize" xml:"maxSingleEntrySize" default:"1024"`
+	MaxTotalSize       int                `protobuf:"varint,3,opt,name=max_total_size,json=maxTotalSize,proto3,casttype=int" json:"maxTotalSize" xml:"maxTotalSize" default:"4096"`
+}
+
+func (m *XattrFilter) Reset()         { *m = XattrFilter{} }
+func (m *XattrFilter) String() string { return proto.CompactTextString(m) }
+func (*XattrFilter) ProtoMessage()    {}
+func (*XattrFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{2}
+}
+func (m *XattrFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XattrFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XattrFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XattrFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XattrFilter.Merge(m, src)
+}
+func (m *XattrFilter) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *XattrFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_XattrFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XattrFilter proto.InternalMessageInfo
+
+type XattrFilterEntry struct {
+	Match  string `protobuf:"bytes,1,opt,name=match,proto3" json:"match" xml:"match,attr"`
+	Permit bool   `protobuf:"varint,2,opt,name=permit,proto3" json:"permit" xml:"permit,attr"`
+}
+
+func (m *XattrFilterEntry) Reset()         { *m = XattrFilterEntry{} }
+func (m *XattrFilterEntry) String() string { return proto.CompactTextString(m) }
+func (*XattrFilterEntry) ProtoMessage()    {}
+func (*XattrFilterEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44a9785876ed3afa, []int{3}
+}
+func (m *XattrFilterEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XattrFilterEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XattrFilterEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XattrFilterEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XattrFilterEntry.Merge(m, src)
+}
+func (m *XattrFilterEntry) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *XattrFilterEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_XattrFilterEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XattrFilterEntry proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*FolderDeviceConfiguration)(nil), "config.FolderDeviceConfiguration")
 	proto.RegisterType((*FolderConfiguration)(nil), "config.FolderConfiguration")
+	proto.RegisterType((*XattrFilter)(nil), "config.XattrFilter")
+	proto.RegisterType((*XattrFilterEntry)(nil), "config.XattrFilterEntry")
 }
 
 func init() {
@@ -149,135 +247,180 @@ func init() {
 }
 
 var fileDescriptor_44a9785876ed3afa = []byte{
-	// 2043 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6f, 0x24, 0x47,
-	0x15, 0x77, 0x7b, 0xbf, 0xec, 0xf2, 0x77, 0x79, 0xbd, 0xdb, 0xf1, 0x26, 0x53, 0x93, 0x66, 0x36,
-	0x38, 0x51, 0xe2, 0xdd, 0x75, 0x10, 0x12, 0x2b, 0x16, 0xc8, 0xd8, 0xb1, 0x58, 0x16, 0x67, 0x47,
-	0xed, 0x85, 0x15, 0x01, 0xa9, 0xe9, 0xe9, 0xae, 0x99, 0xa9, 0xb8, 0xbf, 0xa8, 0xea, 0x59, 0x7b,
-	0xf6, 0x10, 0x2d, 0x17, 0x04, 0x22, 0x07, 0x64, 0x0e, 0xdc, 0x50, 0x24, 0x10, 0x82, 0xfc, 0x03,
-	0x48, 0xfc, 0x05, 0x7b, 0x41, 0x9e, 0x13, 0x42, 0x1c, 0x4a, 0x8a, 0xf7, 0x36, 0xc7, 0x3e, 0xfa,
-	0x84, 0xaa, 0xaa, 0xbb, 0xa7, 0xbb, 0x67, 0x22, 0x21, 0x71, 0x9b, 0xfa, 0xfd, 0x5e, 0xbd, 0xf7,
-	0xeb, 0x57, 0xaf, 0x5e, 0xbf, 0x1e, 0xd0, 0xf0, 0x48, 0xfb, 0x8e, 0x13, 0x06, 0x1d, 0xd2, 0xbd,
-	0xd3, 0x09, 0x3d, 0x17, 0x53, 0xb5, 0xe8, 0x53, 0x3b, 0x26, 0x61, 0xb0, 0x1d, 0xd1, 0x30, 0x0e,
-	0xe1, 0x55, 0x05, 0x6e, 0xde, 0x9a, 0xb0, 0x8e, 0x07, 0x11, 0x56, 0x46, 0x9b, 0x1b, 0x05, 0x92,
-	0x91, 0xe7, 0x19, 0xbc, 0x59, 0x80, 0xa3, 0xbe, 0xe7, 0x85, 0xd4, 0xc5, 0x34, 0xe5, 0xb6, 0x0a,
-	0xdc, 0x33, 0x4c, 0x19, 0x09, 0x03, 0x12, 0x74, 0xa7, 0x28, 0xd8, 0x44, 0x05, 0xcb, 0xb6, 0x17,
-	0x3a, 0x47, 0x55, 0x57, 0x50, 0x18, 0x74, 0xd8, 0x1d, 0x21, 0x88, 0xa5, 0xd8, 0xeb, 0x29, 0xe6,
-	0x84, 0xd1, 0x80, 0xda, 0x41, 0x17, 0xfb, 0x38, 0xee, 0x85, 0x6e, 0xca, 0xce, 0xe3, 0x93, 0x58,
-	0xfd, 0x34, 0xfe, 0x75, 0x09, 0xbc, 0xb6, 0x2f, 0x9f, 0x67, 0x0f, 0x3f, 0x23, 0x0e, 0xde, 0x2d,
-	0x2a, 0x80, 0x5f, 0x68, 0x60, 0xde, 0x95, 0xb8, 0x45, 0x5c, 0x5d, 0xab, 0x6b, 0x5b, 0x8b, 0xcd,
-	0xcf, 0xb4, 0x97, 0x1c, 0xcd, 0xfc, 0x87, 0xa3, 0x6f, 0x74, 0x49, 0xdc, 0xeb, 0xb7, 0xb7, 0x9d,
-	0xd0, 0xbf, 0xc3, 0x06, 0x81, 0x13, 0xf7, 0x48, 0xd0, 0x2d, 0xfc, 0x12, 0x12, 0x64, 0x10, 0x27,
-	0xf4, 0xb6, 0x95, 0xf7, 0x87, 0x7b, 0xe7, 0x1c, 0xcd, 0x65, 0xbf, 0x47, 0x1c, 0xcd, 0xb9, 0xe9,
-	0xef, 0x84, 0xa3, 0xa5, 0x13, 0xdf, 0xbb, 0x6f, 0x10, 0xf7, 0x5d, 0x3b, 0x8e, 0xa9, 0x31, 0x3a,
-	0x6b, 0x5c, 0x4b, 0x7f, 0x27, 0x67, 0x8d, 0xdc, 0xee, 0xd7, 0xc3, 0x86, 0x76, 0x3a, 0x6c, 0xe4,
-	0x3e, 0xcc, 0x8c, 0x71, 0xe1, 0x5f, 0x34, 0xb0, 0x44, 0x82, 0x98, 0x86, 0x6e, 0xdf, 0xc1, 0xae,
-	0xd5, 0x1e, 0xe8, 0xb3, 0x52, 0xf0, 0x8b, 0xff, 0x4b, 0xf0, 0x88, 0xa3, 0xc5, 0xb1, 0xd7, 0xe6,
-	0x20, 0xe1, 0xe8, 0xa6, 0x12, 0x5a, 0x00, 0x73, 0xc9, 0x6b, 0x13, 0xa8, 0x10, 0x6c, 0x96, 0x3c,
-	0x40, 0x07, 0xac, 0xe3, 0xc0, 0xa1, 0x83, 0x48, 0xe4, 0xd8, 0x8a, 0x6c, 0xc6, 0x8e, 0x43, 0xea,
-	0xea, 0x97, 0xea, 0xda, 0xd6, 0x7c, 0x73, 0x67, 0xc4, 0x11, 0x1c, 0xd3, 0xad, 0x94, 0x4d, 0x38,
-	0xd2, 0x65, 0xd8, 0x49, 0xca, 0x30, 0xa7, 0xd8, 0x1b, 0x7f, 0xac, 0x83, 0x75, 0x75, 0xb0, 0xe5,
-	0x23, 0x3d, 0x04, 0xb3, 0xe9, 0x51, 0xce, 0x37, 0x77, 0xcf, 0x39, 0x9a, 0x95, 0x8f, 0x38, 0x4b,
-	0x44, 0x84, 0x5a, 0xe9, 0x04, 0xea, 0x41, 0xe8, 0xe2, 0x8e, 0xdd, 0xf7, 0xe2, 0xfb, 0x46, 0x4c,
-	0xfb, 0xb8, 0x78, 0x24, 0xa7, 0xc3, 0xc6, 0xec, 0xc3, 0xbd, 0xcf, 0xc5, 0xb3, 0xcd, 0x12, 0x17,
-	0xfe, 0x08, 0x5c, 0xf1, 0xec, 0x36, 0xf6, 0x64, 0xc6, 0xe7, 0x9b, 0xdf, 0x1d, 0x71, 0xa4, 0x80,
-	0x84, 0xa3, 0xba, 0x74, 0x2a, 0x57, 0xa9, 0x5f, 0x8a, 0x59, 0x6c, 0xd3, 0xf8, 0xbe, 0xd1, 0xb1,
-	0x3d, 0x26, 0xdd, 0x82, 0x31, 0xfd, 0x62, 0xd8, 0x98, 0x31, 0xd5, 0x66, 0xd8, 0x05, 0x2b, 0x1d,
-	0xe2, 0x61, 0x36, 0x60, 0x31, 0xf6, 0x2d, 0x51, 0xdf, 0x32, 0x49, 0xcb, 0x3b, 0x70, 0xbb, 0xc3,
-	0xb6, 0xf7, 0x73, 0xea, 0xc9, 0x20, 0xc2, 0xcd, 0x77, 0x46, 0x1c, 0x2d, 0x77, 0x4a, 0x58, 0xc2,
-	0xd1, 0x75, 0x19, 0xbd, 0x0c, 0x1b, 0x66, 0xc5, 0x0e, 0x1e, 0x80, 0xcb, 0x91, 0x1d, 0xf7, 0xf4,
-	0xcb, 0x52, 0xfe, 0xb7, 0x46, 0x1c, 0xc9, 0x75, 0xc2, 0xd1, 0x2d, 0xb9, 0x5f, 0x2c, 0x52, 0xf1,
-	0x79, 0x4a, 0x3e, 0x15, 0xc2, 0xe7, 0x73, 0xe6, 0xe2, 0xac, 0xa1, 0x7d, 0x6a, 0xca, 0x6d, 0xb0,
-	0x05, 0x2e, 0x4b, 0xb1, 0x57, 0x52, 0xb1, 0xea, 0xf6, 0x6e, 0xab, 0xe3, 0x90, 0x62, 0xb7, 0x44,
-	0x88, 0x58, 0x49, 0x5c, 0x91, 0x21, 0xc4, 0x22, 0x2f, 0xa3, 0xf9, 0x7c, 0x65, 0x4a, 0x2b, 0xf8,
-	0x33, 0x70, 0x4d, 0xd5, 0x39, 0xd3, 0xaf, 0xd6, 0x2f, 0x6d, 0x2d, 0xec, 0xbc, 0x59, 0x76, 0x3a,
-	0xe5, 0xf2, 0x36, 0x91, 0x28, 0xfb, 0x11, 0x47, 0xd9, 0xce, 0x84, 0xa3, 0x45, 0x19, 0x4a, 0xad,
-	0x0d, 0x33, 0x23, 0xe0, 0xef, 0x35, 0xb0, 0x46, 0x31, 0x73, 0xec, 0xc0, 0x22, 0x41, 0x8c, 0xe9,
-	0x33, 0xdb, 0xb3, 0x98, 0x7e, 0xad, 0xae, 0x6d, 0x5d, 0x69, 0x76, 0x47, 0x1c, 0xad, 0x28, 0xf2,
-	0x61, 0xca, 0x1d, 0x26, 0x1c, 0xbd, 0x2d, 0x3d, 0x55, 0xf0, 0x6a, 0x8a, 0xde, 0xff, 0xe6, 0xdd,
-	0xbb, 0xc6, 0x05, 0x47, 0x97, 0x48, 0x10, 0x8f, 0xce, 0x1a, 0xd7, 0xa7, 0x99, 0x5f, 0x9c, 0x35,
-	0x2e, 0x0b, 0x3b, 0xb3, 0x1a, 0x04, 0xfe, 0x43, 0x03, 0xb0, 0xc3, 0xac, 0x63, 0x3b, 0x76, 0x7a,
-	0x98, 0x5a, 0x38, 0xb0, 0xdb, 0x1e, 0x76, 0xf5, 0xb9, 0xba, 0xb6, 0x35, 0xd7, 0xfc, 0xad, 0x76,
-	0xce, 0xd1, 0xea, 0xfe, 0xe1, 0x53, 0xc5, 0x7e, 0xa8, 0xc8, 0x11, 0x47, 0xab, 0x1d, 0x56, 0xc6,
-	0x12, 0x8e, 0xde, 0x51, 0x45, 0x50, 0x21, 0xaa, 0x6a, 0xb3, 0x1a, 0xdf, 0x98, 0x6a, 0x28, 0x74,
-	0x0a, 0x8b, 0xd3, 0x61, 0x63, 0x22, 0xac, 0x39, 0x11, 0x14, 0xfe, 0xbd, 0x2c, 0xde, 0xc5, 0x9e,
-	0x3d, 0xb0, 0x98, 0x3e, 0x2f, 0x73, 0xfa, 0x1b, 0x21, 0x7e, 0x25, 0xf7, 0xb2, 0x27, 0xc8, 0x43,
-	0x91, 0xe7, 0xdc, 0x8d, 0x82, 0x12, 0x8e, 0xbe, 0x5e, 0x96, 0xae, 0xf0, 0xaa, 0xf2, 0x7b, 0xa5,
-	0x2c, 0x4f, 0x33, 0xbe, 0x38, 0x6b, 0xcc, 0xde, 0xbb, 0x7b, 0x3a, 0x6c, 0x54, 0xa3, 0x9a, 0xd5,
-	0x98, 0xf0, 0xe7, 0x60, 0x91, 0x74, 0x83, 0x90, 0x62, 0x2b, 0xc2, 0xd4, 0x67, 0x3a, 0x90, 0xf9,
-	0x7e, 0x30, 0xe2, 0x68, 0x41, 0xe1, 0x2d, 0x01, 0x27, 0x1c, 0xdd, 0x50, 0xdd, 0x62, 0x8c, 0xe5,
-	0xe5, 0xbb, 0x5a, 0x05, 0xcd, 0xe2, 0x56, 0xf8, 0x4b, 0x0d, 0x2c, 0xdb, 0xfd, 0x38, 0xb4, 0x82,
-	0x90, 0xfa, 0xb6, 0x47, 0x9e, 0x63, 0x7d, 0x41, 0x06, 0xf9, 0x78, 0xc4, 0xd1, 0x92, 0x60, 0x3e,
-	0xca, 0x88, 0x3c, 0x03, 0x25, 0xf4, 0xab, 0x4e, 0x0e, 0x4e, 0x5a, 0x65, 0xc7, 0x66, 0x96, 0xfd,
-	0xc2, 0x10, 0x2c, 0xf9, 0x24, 0xb0, 0x5c, 0xc2, 0x8e, 0xac, 0x0e, 0xc5, 0x58, 0x5f, 0xac, 0x6b,
-	0x5b, 0x0b, 0x3b, 0x8b, 0xd9, 0xb5, 0x3a, 0x24, 0xcf, 0x71, 0xf3, 0x41, 0x7a, 0x83, 0x16, 0x7c,
-	0x12, 0xec, 0x11, 0x76, 0xb4, 0x4f, 0xb1, 0x50, 0x84, 0xa4, 0xa2, 0x02, 0x56, 0x3c, 0x8a, 0xfa,
-	0x6d, 0xe3, 0xe2, 0xac, 0x71, 0xe9, 0x5e, 0xfd, 0xb6, 0x59, 0xdc, 0x06, 0xbb, 0x00, 0x8c, 0xdf,
-	0xf3, 0xfa, 0x92, 0x8c, 0x86, 0xb2, 0x68, 0x3f, 0xce, 0x99, 0xf2, 0x15, 0x7e, 0x2b, 0x15, 0x50,
-	0xd8, 0x9a, 0x70, 0xb4, 0x2a, 0xe3, 0x8f, 0x21, 0xc3, 0x2c, 0xf0, 0xf0, 0x01, 0xb8, 0xe6, 0x84,
-	0x11, 0xc1, 0x94, 0xe9, 0xcb, 0xb2, 0xda, 0xbe, 0x26, 0x7a, 0x40, 0x0a, 0xe5, 0xaf, 0xd9, 0x74,
-	0x9d, 0xd5, 0x8d, 0x99, 0x19, 0xc0, 0x7f, 0x6a, 0xe0, 0x86, 0x98, 0x30, 0x30, 0xb5, 0x7c, 0xfb,
-	0xc4, 0x8a, 0x70, 0xe0, 0x92, 0xa0, 0x6b, 0x1d, 0x91, 0xb6, 0xbe, 0x22, 0xdd, 0xfd, 0x41, 0x14,
-	0xef, 0x7a, 0x4b, 0x9a, 0x1c, 0xd8, 0x27, 0x2d, 0x65, 0xf0, 0x88, 0x34, 0x47, 0x1c, 0xad, 0x47,
-	0x93, 0x70, 0xc2, 0xd1, 0x6b, 0xaa, 0x89, 0x4e, 0x72, 0x85, 0xb2, 0x9d, 0xba, 0x75, 0x3a, 0x7c,
-	0x3a, 0x6c, 0x4c, 0x8b, 0x6f, 0x4e, 0xb1, 0x6d, 0x8b, 0x74, 0xf4, 0x6c, 0xd6, 0x13, 0xe9, 0x58,
-	0x1d, 0xa7, 0x23, 0x85, 0xf2, 0x74, 0xa4, 0xeb, 0x71, 0x3a, 0x52, 0x00, 0x7e, 0x00, 0xae, 0xc8,
-	0x59, 0x4b, 0x5f, 0x93, 0xbd, 0x7c, 0x2d, 0x3b, 0x31, 0x11, 0xff, 0xb1, 0x20, 0x9a, 0xba, 0x78,
-	0xd9, 0x49, 0x9b, 0x84, 0xa3, 0x05, 0xe9, 0x4d, 0xae, 0x0c, 0x53, 0xa1, 0xf0, 0x11, 0x58, 0x4a,
-	0x2f, 0x94, 0x8b, 0x3d, 0x1c, 0x63, 0x1d, 0xca, 0x62, 0x7f, 0x4b, 0x4e, 0x16, 0x92, 0xd8, 0x93,
-	0x78, 0xc2, 0x11, 0x2c, 0x5c, 0x29, 0x05, 0x1a, 0x66, 0xc9, 0x06, 0x9e, 0x00, 0x5d, 0xf6, 0xe9,
-	0x88, 0x86, 0x5d, 0x8a, 0x19, 0x2b, 0x36, 0xec, 0x75, 0xf9, 0x7c, 0xe2, 0xe5, 0xbb, 0x21, 0x6c,
-	0x5a, 0xa9, 0x49, 0xb1, 0x6d, 0xab, 0xd7, 0xd9, 0x54, 0x36, 0x7f, 0xf6, 0xe9, 0x9b, 0xe1, 0x21,
-	0x58, 0x4e, 0xeb, 0x22, 0xb2, 0xfb, 0x0c, 0x5b, 0x4c, 0xbf, 0x2e, 0xe3, 0xbd, 0x27, 0x9e, 0x43,
-	0x31, 0x2d, 0x41, 0x1c, 0xe6, 0xcf, 0x51, 0x04, 0x73, 0xef, 0x25, 0x53, 0x88, 0xc1, 0x92, 0xa8,
-	0x32, 0x91, 0x54, 0x8f, 0x38, 0x31, 0xd3, 0x37, 0xa4, 0xcf, 0xef, 0x09, 0x9f, 0xbe, 0x7d, 0xb2,
-	0x9b, 0xe1, 0xe3, 0x5b, 0x57, 0x00, 0xa7, 0x76, 0x40, 0xd5, 0xe9, 0xcc, 0xd2, 0x6e, 0xe8, 0x82,
-	0xeb, 0x2e, 0x61, 0xa2, 0x33, 0x5b, 0x2c, 0xb2, 0x29, 0xc3, 0x96, 0x1c, 0x00, 0xf4, 0x1b, 0xf2,
-	0x24, 0xe4, 0xc8, 0x95, 0xf2, 0x87, 0x92, 0x96, 0xa3, 0x45, 0x3e, 0x72, 0x4d, 0x52, 0x86, 0x39,
-	0xc5, 0xbe, 0x18, 0x25, 0xc6, 0x7e, 0x64, 0x91, 0xc0, 0xc5, 0x27, 0x98, 0xe9, 0x37, 0x27, 0xa2,
-	0x3c, 0xc1, 0x7e, 0xf4, 0x50, 0xb1, 0xd5, 0x28, 0x05, 0x6a, 0x1c, 0xa5, 0x00, 0xc2, 0x1d, 0x70,
-	0x55, 0x1e, 0x80, 0xab, 0xeb, 0xd2, 0xef, 0xe6, 0x88, 0xa3, 0x14, 0xc9, 0xdf, 0xf0, 0x6a, 0x69,
-	0x98, 0x29, 0x0e, 0x63, 0x70, 0xf3, 0x18, 0xdb, 0x47, 0x96, 0xa8, 0x6a, 0x2b, 0xee, 0x51, 0xcc,
-	0x7a, 0xa1, 0xe7, 0x5a, 0x91, 0x13, 0xeb, 0xaf, 0xc9, 0x84, 0x8b, 0xf6, 0x7e, 0x5d, 0x98, 0x7c,
-	0xdf, 0x66, 0xbd, 0x27, 0x99, 0x41, 0xcb, 0x89, 0x13, 0x8e, 0x36, 0xa5, 0xcb, 0x69, 0x64, 0x7e,
-	0xa8, 0x53, 0xb7, 0xc2, 0x5d, 0xb0, 0xe0, 0xdb, 0xf4, 0x08, 0x53, 0x2b, 0xb0, 0x7d, 0xac, 0x6f,
-	0xca, 0xe1, 0xca, 0x10, 0xed, 0x4c, 0xc1, 0x1f, 0xd9, 0x3e, 0xce, 0xdb, 0xd9, 0x18, 0x32, 0xcc,
-	0x02, 0x0f, 0x07, 0x60, 0x53, 0x7c, 0xc4, 0x58, 0xe1, 0x71, 0x80, 0x29, 0xeb, 0x91, 0xc8, 0xea,
-	0xd0, 0xd0, 0xb7, 0x22, 0x9b, 0xe2, 0x20, 0xd6, 0x6f, 0xc9, 0x14, 0x7c, 0x7b, 0xc4, 0xd1, 0x4d,
-	0x61, 0xf5, 0x38, 0x33, 0xda, 0xa7, 0xa1, 0xdf, 0x92, 0x26, 0x09, 0x47, 0x6f, 0x64, 0x1d, 0x6f,
-	0x1a, 0x6f, 0x98, 0x5f, 0xb5, 0x13, 0xfe, 0x4a, 0x03, 0x6b, 0x7e, 0xe8, 0x5a, 0x31, 0xf1, 0xb1,
-	0x75, 0x4c, 0x02, 0x37, 0x3c, 0xb6, 0x98, 0xfe, 0xba, 0x4c, 0xd8, 0x4f, 0xcf, 0x39, 0x5a, 0x33,
-	0xed, 0xe3, 0x83, 0xd0, 0x7d, 0x42, 0x7c, 0xfc, 0x54, 0xb2, 0xe2, 0x1d, 0xbe, 0xec, 0x97, 0x90,
-	0x7c, 0x04, 0x2d, 0xc3, 0x59, 0xe6, 0x4e, 0x87, 0x8d, 0x49, 0x2f, 0x66, 0xc5, 0x07, 0x7c, 0xa1,
-	0x81, 0x8d, 0xf4, 0x9a, 0x38, 0x7d, 0x2a, 0xb4, 0x59, 0xc7, 0x94, 0xc4, 0x98, 0xe9, 0x6f, 0x48,
-	0x31, 0x3f, 0x14, 0xad, 0x57, 0x15, 0x7c, 0xca, 0x3f, 0x95, 0x74, 0xc2, 0xd1, 0xed, 0xc2, 0xad,
-	0x29, 0x71, 0x85, 0xcb, 0xb3, 0x53, 0xb8, 0x3b, 0xda, 0x8e, 0x39, 0xcd, 0x93, 0x68, 0x62, 0x59,
-	0x6d, 0x77, 0xc4, 0x17, 0x93, 0x5e, 0x1b, 0x37, 0xb1, 0x94, 0xd8, 0x17, 0x78, 0x7e, 0xf9, 0x8b,
-	0xa0, 0x61, 0x96, 0x6c, 0xa0, 0x07, 0x56, 0xe5, 0x97, 0xac, 0x25, 0x7a, 0x81, 0xa5, 0xfa, 0x2b,
-	0x92, 0xfd, 0xf5, 0x46, 0xd6, 0x5f, 0x9b, 0x82, 0x1f, 0x37, 0x59, 0x39, 0xdc, 0xb7, 0x4b, 0x58,
-	0x9e, 0xd9, 0x32, 0x6c, 0x98, 0x15, 0x3b, 0xf8, 0x99, 0x06, 0xd6, 0x64, 0x09, 0xc9, 0x0f, 0x61,
-	0x4b, 0x7d, 0x09, 0xeb, 0x75, 0x19, 0x6f, 0x5d, 0x7c, 0x48, 0xec, 0x86, 0xd1, 0xc0, 0x14, 0xdc,
-	0x81, 0xa4, 0x9a, 0x8f, 0xc4, 0x28, 0xe6, 0x94, 0xc1, 0x84, 0xa3, 0xad, 0xbc, 0x8c, 0x0a, 0x78,
-	0x21, 0x8d, 0x2c, 0xb6, 0x03, 0xd7, 0xa6, 0xae, 0x78, 0xff, 0xcf, 0x65, 0x0b, 0xb3, 0xea, 0x08,
-	0xfe, 0x59, 0xc8, 0xb1, 0x45, 0x03, 0xc5, 0x01, 0x23, 0x31, 0x79, 0x26, 0x32, 0xaa, 0xbf, 0x29,
-	0xd3, 0x79, 0x22, 0xe6, 0xc2, 0x5d, 0x9b, 0xe1, 0xc3, 0x8c, 0xdb, 0x97, 0x73, 0xa1, 0x53, 0x86,
-	0x12, 0x8e, 0x36, 0x94, 0x98, 0x32, 0x2e, 0x66, 0xa0, 0x09, 0xdb, 0x49, 0x48, 0x8c, 0x81, 0x95,
-	0x20, 0x66, 0xc5, 0x86, 0xc1, 0x3f, 0x69, 0x60, 0xb5, 0x13, 0x7a, 0x5e, 0x78, 0x6c, 0x7d, 0xd2,
-	0x0f, 0x1c, 0x31, 0x8e, 0x30, 0xdd, 0x18, 0xab, 0xfc, 0x41, 0x06, 0x7e, 0xc0, 0xf6, 0x08, 0x65,
-	0x42, 0xe5, 0x27, 0x65, 0x28, 0x57, 0x59, 0xc1, 0xa5, 0xca, 0xaa, 0xed, 0x24, 0x24, 0x54, 0x56,
-	0x82, 0x98, 0x2b, 0x4a, 0x51, 0x0e, 0xc3, 0x23, 0x30, 0x4f, 0xb1, 0xed, 0x5a, 0x61, 0xe0, 0x0d,
-	0xf4, 0xbf, 0xee, 0x4b, 0x79, 0x07, 0xe7, 0x1c, 0xc1, 0x3d, 0x1c, 0x51, 0xec, 0xd8, 0x31, 0x76,
-	0x4d, 0x6c, 0xbb, 0x8f, 0x03, 0x6f, 0x30, 0xe2, 0x48, 0x7b, 0x2f, 0xff, 0x7a, 0xa7, 0xa1, 0x1c,
-	0x0f, 0xdf, 0x0d, 0x7d, 0x22, 0x7a, 0x75, 0x3c, 0x90, 0x5f, 0xef, 0x13, 0xa8, 0xae, 0x99, 0x73,
-	0x34, 0x75, 0x00, 0x7f, 0x01, 0xd6, 0x4a, 0x33, 0xa3, 0xec, 0x9f, 0x7f, 0x13, 0x41, 0xb5, 0xe6,
-	0x87, 0xe7, 0x1c, 0xe9, 0xe3, 0xa0, 0x07, 0xe3, 0xc9, 0xaf, 0xe5, 0xc4, 0x59, 0xe8, 0x5a, 0x75,
-	0x70, 0x6c, 0x39, 0x71, 0x41, 0x81, 0xae, 0x99, 0xcb, 0x65, 0x12, 0xfe, 0x04, 0x5c, 0x53, 0xef,
-	0x4b, 0xa6, 0x7f, 0xb1, 0x2f, 0xef, 0xfa, 0x77, 0x44, 0xe3, 0x19, 0x07, 0x52, 0x73, 0x10, 0x2b,
-	0x3f, 0x5c, 0xba, 0xa5, 0xe0, 0x3a, 0xbd, 0xe0, 0xba, 0x66, 0x66, 0xfe, 0x9a, 0x8f, 0x5e, 0x7e,
-	0x59, 0x9b, 0x19, 0x7e, 0x59, 0x9b, 0x79, 0x79, 0x5e, 0xd3, 0x86, 0xe7, 0x35, 0xed, 0x77, 0xaf,
-	0x6a, 0x33, 0x9f, 0xbf, 0xaa, 0x69, 0xc3, 0x57, 0xb5, 0x99, 0x7f, 0xbf, 0xaa, 0xcd, 0x7c, 0xfc,
-	0xf6, 0xff, 0xf0, 0x7f, 0x89, 0xba, 0xae, 0xed, 0xab, 0xf2, 0x7f, 0x93, 0xf7, 0xff, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0x3e, 0xb6, 0x85, 0xe6, 0x55, 0x13, 0x00, 0x00,
+	// 2768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0x24, 0x47,
+	0xf5, 0xdf, 0xb6, 0xf7, 0x87, 0x5d, 0xfe, 0xb1, 0x76, 0xd9, 0xeb, 0xad, 0x75, 0x12, 0x97, 0xd3,
+	0x99, 0x4d, 0x9c, 0x64, 0xe3, 0xdd, 0x75, 0xf2, 0x8d, 0xbe, 0x59, 0x08, 0x90, 0xb1, 0xe3, 0xb0,
+	0x2c, 0xce, 0x5a, 0xe5, 0x85, 0x40, 0x82, 0xd4, 0xb4, 0xbb, 0x6b, 0x3c, 0x1d, 0xf7, 0x74, 0x0f,
+	0x55, 0xed, 0xb5, 0x67, 0x0f, 0x21, 0x70, 0x40, 0x20, 0x72, 0x80, 0xe5, 0x80, 0x38, 0x20, 0x45,
+	0x02, 0x21, 0xc8, 0x3f, 0x80, 0xc4, 0x5f, 0x90, 0x0b, 0xd8, 0x17, 0x10, 0xe2, 0xd0, 0x52, 0xbc,
+	0xb7, 0x39, 0xce, 0x71, 0x4f, 0xa8, 0x5e, 0x75, 0xf7, 0x54, 0xcf, 0xf4, 0x4a, 0x48, 0x39, 0xcd,
+	0xd4, 0xe7, 0xf3, 0xea, 0xbd, 0xd7, 0xaf, 0xaa, 0x5e, 0xbd, 0x57, 0xa8, 0x16, 0x06, 0xbb, 0xd7,
+	0xbd, 0x38, 0x6a, 0x04, 0x7b, 0xd7, 0x1b, 0x71, 0xe8, 0x73, 0xa1, 0x07, 0x07, 0xc2, 0x4d, 0x82,
+	0x38, 0x5a, 0x6d, 0x8b, 0x38, 0x89, 0xf1, 0x79, 0x0d, 0x2e, 0x3e, 0x35, 0x24, 0x9d, 0x74, 0xda,
+	0x5c, 0x0b, 0x2d, 0x5e, 0x32, 0x48, 0x19, 0x3c, 0xc8, 0xe1, 0x45, 0x03, 0x6e, 0x1f, 0x84, 0x61,
+	0x2c, 0x7c, 0x2e, 0x32, 0x6e, 0xc5, 0xe0, 0xee, 0x73, 0x21, 0x83, 0x38, 0x0a, 0xa2, 0xbd, 0x0a,
+	0x0f, 0x16, 0xa9, 0x21, 0xb9, 0x1b, 0xc6, 0xde, 0xfe, 0xa0, 0x2a, 0xd3, 0x35, 0xd9, 0x89, 0xbc,
+	0xc3, 0x20, 0xf2, 0xe3, 0xc3, 0x8c, 0x7c, 0xce, 0x20, 0xd5, 0x4f, 0x18, 0x78, 0x89, 0xe0, 0x32,
+	0x0e, 0x0f, 0x0c, 0x13, 0x58, 0x09, 0x35, 0xe4, 0x75, 0xf5, 0x49, 0x32, 0xc3, 0x9e, 0xce, 0x30,
+	0x2f, 0x6e, 0x77, 0x84, 0x1b, 0xed, 0xf1, 0x16, 0x4f, 0x9a, 0xb1, 0x9f, 0xb1, 0xe3, 0xfc, 0x28,
+	0xd1, 0x7f, 0xed, 0x7f, 0x8d, 0xa2, 0x2b, 0x9b, 0x10, 0x91, 0x0d, 0x7e, 0x3f, 0xf0, 0xf8, 0xba,
+	0xf9, 0x0d, 0xf8, 0x33, 0x0b, 0x8d, 0xfb, 0x80, 0x3b, 0x81, 0x4f, 0xac, 0x65, 0x6b, 0x65, 0xb2,
+	0xfe, 0x89, 0xf5, 0x79, 0x4a, 0xcf, 0xfc, 0x27, 0xa5, 0xaf, 0xed, 0x05, 0x49, 0xf3, 0x60, 0x77,
+	0xd5, 0x8b, 0x5b, 0xe0, 0x79, 0xd2, 0x0c, 0xa2, 0x3d, 0xe3, 0x9f, 0x72, 0x01, 0x8c, 0x78, 0x71,
+	0xb8, 0xaa, 0xb5, 0xdf, 0xde, 0x38, 0x4d, 0xe9, 0x58, 0xfe, 0xbf, 0x9b, 0xd2, 0x31, 0x3f, 0xfb,
+	0xdf, 0x4b, 0xe9, 0xd4, 0x51, 0x2b, 0xbc, 0x65, 0x07, 0xfe, 0x35, 0x37, 0x49, 0x84, 0xdd, 0x3d,
+	0xae, 0x5d, 0xc8, 0xfe, 0xf7, 0x8e, 0x6b, 0x85, 0xdc, 0xcf, 0x4f, 0x6a, 0xd6, 0xc3, 0x93, 0x5a,
+	0xa1, 0x83, 0xe5, 0x8c, 0x8f, 0xff, 0x64, 0xa1, 0xa9, 0x20, 0x4a, 0x44, 0xec, 0x1f, 0x78, 0xdc,
+	0x77, 0x76, 0x3b, 0x64, 0x04, 0x1c, 0xfe, 0xf8, 0x4b, 0x39, 0xdc, 0x4d, 0xe9, 0x64, 0x5f, 0x6b,
+	0xbd, 0xd3, 0x4b, 0xe9, 0x65, 0xed, 0xa8, 0x01, 0x16, 0x2e, 0xcf, 0x0e, 0xa1, 0xca, 0x61, 0x56,
+	0xd2, 0x80, 0x3d, 0x34, 0xc7, 0x23, 0x4f, 0x74, 0xda, 0x2a, 0xc6, 0x4e, 0xdb, 0x95, 0xf2, 0x30,
+	0x16, 0x3e, 0x19, 0x5d, 0xb6, 0x56, 0xc6, 0xeb, 0x6b, 0xdd, 0x94, 0xe2, 0x3e, 0xbd, 0x9d, 0xb1,
+	0xbd, 0x94, 0x12, 0x30, 0x3b, 0x4c, 0xd9, 0xac, 0x42, 0xde, 0xfe, 0xe7, 0x35, 0x34, 0xa7, 0x17,
+	0xb6, 0xbc, 0xa4, 0x3b, 0x68, 0x24, 0x5b, 0xca, 0xf1, 0xfa, 0xfa, 0x69, 0x4a, 0x47, 0xe0, 0x13,
+	0x47, 0x02, 0x65, 0x61, 0xa9, 0xb4, 0x02, 0xcb, 0x51, 0xec, 0xf3, 0x86, 0x7b, 0x10, 0x26, 0xb7,
+	0xec, 0x44, 0x1c, 0x70, 0x73, 0x49, 0x1e, 0x9e, 0xd4, 0x46, 0x6e, 0x6f, 0x7c, 0xaa, 0xbe, 0x6d,
+	0x24, 0xf0, 0xf1, 0x77, 0xd0, 0xb9, 0xd0, 0xdd, 0xe5, 0x21, 0x44, 0x7c, 0xbc, 0xfe, 0xf5, 0x6e,
+	0x4a, 0x35, 0xd0, 0x4b, 0xe9, 0x32, 0x28, 0x85, 0x51, 0xa6, 0x57, 0x70, 0x99, 0xb8, 0x22, 0xb9,
+	0x65, 0x37, 0xdc, 0x50, 0x82, 0x5a, 0xd4, 0xa7, 0x3f, 0x3e, 0xa9, 0x9d, 0x61, 0x7a, 0x32, 0xde,
+	0x43, 0x17, 0x1b, 0x41, 0xc8, 0x65, 0x47, 0x26, 0xbc, 0xe5, 0xa8, 0xfd, 0x0d, 0x41, 0x9a, 0x5e,
+	0xc3, 0xab, 0x0d, 0xb9, 0xba, 0x59, 0x50, 0xf7, 0x3a, 0x6d, 0x5e, 0x7f, 0xa9, 0x9b, 0xd2, 0xe9,
+	0x46, 0x09, 0xeb, 0xa5, 0x74, 0x1e, 0xac, 0x97, 0x61, 0x9b, 0x0d, 0xc8, 0xe1, 0x2d, 0x74, 0xb6,
+	0xed, 0x26, 0x4d, 0x72, 0x16, 0xdc, 0x7f, 0xa3, 0x9b, 0x52, 0x18, 0xf7, 0x52, 0xfa, 0x14, 0xcc,
+	0x57, 0x83, 0xcc, 0xf9, 0x22, 0x24, 0x1f, 0x29, 0xc7, 0xc7, 0x0b, 0xe6, 0xf1, 0x71, 0xcd, 0xfa,
+	0x88, 0xc1, 0x34, 0xbc, 0x8d, 0xce, 0x82, 0xb3, 0xe7, 0x32, 0x67, 0xf5, 0x09, 0x5e, 0xd5, 0xcb,
+	0x01, 0xce, 0xae, 0x28, 0x13, 0x89, 0x76, 0xf1, 0x22, 0x98, 0x50, 0x83, 0x62, 0x1b, 0x8d, 0x17,
+	0x23, 0x06, 0x52, 0xf8, 0x07, 0xe8, 0x82, 0xde, 0xe7, 0x92, 0x9c, 0x5f, 0x1e, 0x5d, 0x99, 0x58,
+	0x7b, 0xb6, 0xac, 0xb4, 0xe2, 0xf0, 0xd6, 0xa9, 0xda, 0xf6, 0xdd, 0x94, 0xe6, 0x33, 0x7b, 0x29,
+	0x9d, 0x04, 0x53, 0x7a, 0x6c, 0xb3, 0x9c, 0xc0, 0xbf, 0xb1, 0xd0, 0xac, 0xe0, 0xd2, 0x73, 0x23,
+	0x27, 0x88, 0x12, 0x2e, 0xee, 0xbb, 0xa1, 0x23, 0xc9, 0x85, 0x65, 0x6b, 0xe5, 0x5c, 0x7d, 0xaf,
+	0x9b, 0xd2, 0x8b, 0x9a, 0xbc, 0x9d, 0x71, 0x3b, 0xbd, 0x94, 0xbe, 0x08, 0x9a, 0x06, 0xf0, 0xc1,
+	0x10, 0xbd, 0xfa, 0xfa, 0x8d, 0x1b, 0xf6, 0xe3, 0x94, 0x8e, 0x06, 0x51, 0xd2, 0x3d, 0xae, 0xcd,
+	0x57, 0x89, 0x3f, 0x3e, 0xae, 0x9d, 0x55, 0x72, 0x6c, 0xd0, 0x08, 0xfe, 0x9b, 0x85, 0x70, 0x43,
+	0x3a, 0x87, 0x6e, 0xe2, 0x35, 0xb9, 0x70, 0x78, 0xe4, 0xee, 0x86, 0xdc, 0x27, 0x63, 0xcb, 0xd6,
+	0xca, 0x58, 0xfd, 0x97, 0xd6, 0x69, 0x4a, 0x67, 0x36, 0x77, 0xde, 0xd3, 0xec, 0xdb, 0x9a, 0xec,
+	0xa6, 0x74, 0xa6, 0x21, 0xcb, 0x58, 0x2f, 0xa5, 0x2f, 0xe9, 0x4d, 0x30, 0x40, 0x0c, 0x7a, 0x9b,
+	0xef, 0xf1, 0x4b, 0x95, 0x82, 0xca, 0x4f, 0x25, 0xf1, 0xf0, 0xa4, 0x36, 0x64, 0x96, 0x0d, 0x19,
+	0xc5, 0x7f, 0x2d, 0x3b, 0xef, 0xf3, 0xd0, 0xed, 0x38, 0x92, 0x8c, 0x43, 0x4c, 0x7f, 0xa1, 0x9c,
+	0xbf, 0x58, 0x68, 0xd9, 0x50, 0xe4, 0x8e, 0x8a, 0x73, 0x43, 0x96, 0xa0, 0x5e, 0x4a, 0x5f, 0x28,
+	0xbb, 0xae, 0xf1, 0x41, 0xcf, 0x6f, 0x96, 0xa2, 0x5c, 0x25, 0xfc, 0xf8, 0xb8, 0x36, 0x72, 0xf3,
+	0xc6, 0xc3, 0x93, 0xda, 0xa0, 0x55, 0x36, 0x68, 0x13, 0xff, 0x10, 0x4d, 0x06, 0x7b, 0x51, 0x2c,
+	0xb8, 0xd3, 0xe6, 0xa2, 0x25, 0x09, 0x82, 0x78, 0xbf, 0xd9, 0x4d, 0xe9, 0x84, 0xc6, 0xb7, 0x15,
+	0xdc, 0x4b, 0xe9, 0x82, 0xce, 0x16, 0x7d, 0xac, 0xd8, 0xbe, 0x33, 0x83, 0x20, 0x33, 0xa7, 0xe2,
+	0x9f, 0x58, 0x68, 0xda, 0x3d, 0x48, 0x62, 0x27, 0x8a, 0x45, 0xcb, 0x0d, 0x83, 0x07, 0x9c, 0x4c,
+	0x80, 0x91, 0xf7, 0xbb, 0x29, 0x9d, 0x52, 0xcc, 0xbb, 0x39, 0x51, 0x44, 0xa0, 0x84, 0x3e, 0x69,
+	0xe5, 0xf0, 0xb0, 0x54, 0xbe, 0x6c, 0xac, 0xac, 0x17, 0xc7, 0x68, 0xaa, 0x15, 0x44, 0x8e, 0x1f,
+	0xc8, 0x7d, 0xa7, 0x21, 0x38, 0x27, 0x93, 0xcb, 0xd6, 0xca, 0xc4, 0xda, 0x64, 0x7e, 0xac, 0x76,
+	0x82, 0x07, 0xbc, 0xfe, 0x66, 0x76, 0x82, 0x26, 0x5a, 0x41, 0xb4, 0x11, 0xc8, 0xfd, 0x4d, 0xc1,
+	0x95, 0x47, 0x14, 0x3c, 0x32, 0x30, 0x73, 0x29, 0x96, 0xaf, 0xda, 0x8f, 0x8f, 0x6b, 0xa3, 0x37,
+	0x97, 0xaf, 0x32, 0x73, 0x1a, 0xde, 0x43, 0xa8, 0x5f, 0x29, 0x90, 0x29, 0xb0, 0x46, 0x73, 0x6b,
+	0xdf, 0x2d, 0x98, 0xf2, 0x11, 0x7e, 0x3e, 0x73, 0xc0, 0x98, 0xda, 0x4b, 0xe9, 0x0c, 0xd8, 0xef,
+	0x43, 0x36, 0x33, 0x78, 0xfc, 0x26, 0xba, 0xe0, 0xc5, 0xed, 0x80, 0x0b, 0x49, 0xa6, 0x61, 0xb7,
+	0x3d, 0xa7, 0x72, 0x40, 0x06, 0x15, 0xd7, 0x6c, 0x36, 0xce, 0xf7, 0x0d, 0xcb, 0x05, 0xf0, 0xdf,
+	0x2d, 0xb4, 0xa0, 0x6a, 0x14, 0x2e, 0x9c, 0x96, 0x7b, 0xe4, 0xb4, 0x79, 0xe4, 0x07, 0xd1, 0x9e,
+	0xb3, 0x1f, 0xec, 0x92, 0x8b, 0xa0, 0xee, 0xb7, 0x6a, 0xf3, 0xce, 0x6d, 0x83, 0xc8, 0x96, 0x7b,
+	0xb4, 0xad, 0x05, 0xee, 0x04, 0xf5, 0x6e, 0x4a, 0xe7, 0xda, 0xc3, 0x70, 0x2f, 0xa5, 0x57, 0x74,
+	0x12, 0x1d, 0xe6, 0x8c, 0x6d, 0x5b, 0x39, 0xb5, 0x1a, 0x7e, 0x78, 0x52, 0xab, 0xb2, 0xcf, 0x2a,
+	0x64, 0x77, 0x55, 0x38, 0x9a, 0xae, 0x6c, 0xaa, 0x70, 0xcc, 0xf4, 0xc3, 0x91, 0x41, 0x45, 0x38,
+	0xb2, 0x71, 0x3f, 0x1c, 0x19, 0x80, 0xdf, 0x42, 0xe7, 0xa0, 0x5a, 0x23, 0xb3, 0x90, 0xcb, 0x67,
+	0xf3, 0x15, 0x53, 0xf6, 0xef, 0x2a, 0xa2, 0x4e, 0xd4, 0x65, 0x07, 0x32, 0xbd, 0x94, 0x4e, 0x80,
+	0x36, 0x18, 0xd9, 0x4c, 0xa3, 0xf8, 0x0e, 0x9a, 0xca, 0x0e, 0x94, 0xcf, 0x43, 0x9e, 0x70, 0x82,
+	0x61, 0xb3, 0x3f, 0x0f, 0x95, 0x05, 0x10, 0x1b, 0x80, 0xf7, 0x52, 0x8a, 0x8d, 0x23, 0xa5, 0x41,
+	0x9b, 0x95, 0x64, 0xf0, 0x11, 0x22, 0x90, 0xa7, 0xdb, 0x22, 0xde, 0x13, 0x5c, 0x4a, 0x33, 0x61,
+	0xcf, 0xc1, 0xf7, 0xa9, 0xcb, 0xf7, 0x92, 0x92, 0xd9, 0xce, 0x44, 0xcc, 0xb4, 0xad, 0xaf, 0xb3,
+	0x4a, 0xb6, 0xf8, 0xf6, 0xea, 0xc9, 0x78, 0x07, 0x4d, 0x67, 0xfb, 0xa2, 0xed, 0x1e, 0x48, 0xee,
+	0x48, 0x32, 0x0f, 0xf6, 0x5e, 0x51, 0xdf, 0xa1, 0x99, 0x6d, 0x45, 0xec, 0x14, 0xdf, 0x61, 0x82,
+	0x85, 0xf6, 0x92, 0x28, 0xe6, 0x68, 0x4a, 0xed, 0xb2, 0xbc, 0xb6, 0x95, 0xe4, 0x12, 0xe8, 0xfc,
+	0x86, 0xd2, 0xd9, 0x72, 0x8f, 0xd6, 0x73, 0xbc, 0x7f, 0xea, 0x0c, 0xb0, 0x32, 0x03, 0xea, 0x4c,
+	0xc7, 0x4a, 0xb3, 0xb1, 0x8f, 0xe6, 0xfd, 0x40, 0xaa, 0xcc, 0xec, 0xc8, 0xb6, 0x2b, 0x24, 0x77,
+	0xa0, 0x00, 0x20, 0x0b, 0xb0, 0x12, 0x50, 0x72, 0x65, 0xfc, 0x0e, 0xd0, 0x50, 0x5a, 0x14, 0x25,
+	0xd7, 0x30, 0x65, 0xb3, 0x0a, 0x79, 0xd3, 0x4a, 0xc2, 0x5b, 0x6d, 0x27, 0x88, 0x7c, 0x7e, 0xc4,
+	0x25, 0xb9, 0x3c, 0x64, 0xe5, 0x1e, 0x6f, 0xb5, 0x6f, 0x6b, 0x76, 0xd0, 0x8a, 0x41, 0xf5, 0xad,
+	0x18, 0x20, 0x5e, 0x43, 0xe7, 0x61, 0x01, 0x7c, 0x42, 0x40, 0xef, 0x62, 0x37, 0xa5, 0x19, 0x52,
+	0xdc, 0xf0, 0x7a, 0x68, 0xb3, 0x0c, 0xc7, 0x09, 0xba, 0x7c, 0xc8, 0xdd, 0x7d, 0x47, 0xed, 0x6a,
+	0x27, 0x69, 0x0a, 0x2e, 0x9b, 0x71, 0xe8, 0x3b, 0x6d, 0x2f, 0x21, 0x57, 0x20, 0xe0, 0x2a, 0xbd,
+	0xcf, 0x2b, 0x91, 0x6f, 0xba, 0xb2, 0x79, 0x2f, 0x17, 0xd8, 0xf6, 0x92, 0x5e, 0x4a, 0x17, 0x41,
+	0x65, 0x15, 0x59, 0x2c, 0x6a, 0xe5, 0x54, 0xbc, 0x8e, 0x26, 0x5a, 0xae, 0xd8, 0xe7, 0xc2, 0x89,
+	0xdc, 0x16, 0x27, 0x8b, 0x50, 0x5c, 0xd9, 0x2a, 0x9d, 0x69, 0xf8, 0x5d, 0xb7, 0xc5, 0x8b, 0x74,
+	0xd6, 0x87, 0x6c, 0x66, 0xf0, 0xb8, 0x83, 0x16, 0x55, 0x13, 0xe3, 0xc4, 0x87, 0x11, 0x17, 0xb2,
+	0x19, 0xb4, 0x9d, 0x86, 0x88, 0x5b, 0x4e, 0xdb, 0x15, 0x3c, 0x4a, 0xc8, 0x53, 0x10, 0x82, 0xaf,
+	0x76, 0x53, 0x7a, 0x59, 0x49, 0xdd, 0xcd, 0x85, 0x36, 0x45, 0xdc, 0xda, 0x06, 0x91, 0x5e, 0x4a,
+	0x9f, 0xc9, 0x33, 0x5e, 0x15, 0x6f, 0xb3, 0x27, 0xcd, 0xc4, 0x3f, 0xb3, 0xd0, 0x6c, 0x2b, 0xf6,
+	0x9d, 0x24, 0x68, 0x71, 0x47, 0xf7, 0x65, 0x8e, 0x24, 0x4f, 0x43, 0xc0, 0x3e, 0x38, 0x4d, 0xe9,
+	0x2c, 0x73, 0x0f, 0xb7, 0x62, 0xff, 0x5e, 0xd0, 0xe2, 0xef, 0x01, 0xab, 0xee, 0xf0, 0xe9, 0x56,
+	0x09, 0x29, 0x4a, 0xd0, 0x32, 0x9c, 0x47, 0xee, 0xe1, 0x49, 0x6d, 0x58, 0x0b, 0x1b, 0xd0, 0x81,
+	0x3f, 0xb6, 0xd0, 0xa5, 0xec, 0x98, 0x78, 0x07, 0x42, 0xf9, 0xe6, 0x1c, 0x8a, 0x20, 0xe1, 0x92,
+	0x3c, 0x03, 0xce, 0x7c, 0x5b, 0xa5, 0x5e, 0xbd, 0xe1, 0x33, 0xfe, 0x3d, 0xa0, 0x7b, 0x29, 0xbd,
+	0x6a, 0x9c, 0x9a, 0x12, 0x67, 0x1c, 0x9e, 0x35, 0xe3, 0xec, 0x58, 0x6b, 0xac, 0x4a, 0x93, 0x4a,
+	0x62, 0xf9, 0xde, 0x6e, 0xa8, 0x8e, 0x89, 0x2c, 0xf5, 0x93, 0x58, 0x46, 0x6c, 0x2a, 0xbc, 0x38,
+	0xfc, 0x26, 0x68, 0xb3, 0x92, 0x0c, 0x0e, 0xd1, 0x0c, 0xf4, 0xc2, 0x8e, 0xca, 0x05, 0x8e, 0xce,
+	0xaf, 0x14, 0xf2, 0xeb, 0x42, 0x9e, 0x5f, 0xeb, 0x8a, 0xef, 0x27, 0x59, 0x28, 0xee, 0x77, 0x4b,
+	0x58, 0x11, 0xd9, 0x32, 0x6c, 0xb3, 0x01, 0x39, 0xfc, 0x89, 0x85, 0x66, 0x61, 0x0b, 0x41, 0x23,
+	0xec, 0xe8, 0x4e, 0x98, 0x2c, 0x83, 0xbd, 0x39, 0xd5, 0x48, 0xac, 0xc7, 0xed, 0x0e, 0x53, 0xdc,
+	0x16, 0x50, 0xf5, 0x3b, 0xaa, 0x14, 0xf3, 0xca, 0x60, 0x2f, 0xa5, 0x2b, 0xc5, 0x36, 0x32, 0x70,
+	0x23, 0x8c, 0x32, 0x71, 0x23, 0xdf, 0x15, 0xbe, 0xba, 0xff, 0xc7, 0xf2, 0x01, 0x1b, 0x54, 0x84,
+	0xff, 0xa8, 0xdc, 0x71, 0x55, 0x02, 0xe5, 0x91, 0x0c, 0x92, 0xe0, 0xbe, 0x8a, 0x28, 0x79, 0x16,
+	0xc2, 0x79, 0xa4, 0xea, 0xc2, 0x75, 0x57, 0xf2, 0x9d, 0x9c, 0xdb, 0x84, 0xba, 0xd0, 0x2b, 0x43,
+	0xbd, 0x94, 0x5e, 0xd2, 0xce, 0x94, 0x71, 0x55, 0x03, 0x0d, 0xc9, 0x0e, 0x43, 0xaa, 0x0c, 0x1c,
+	0x30, 0xc2, 0x06, 0x64, 0x24, 0xfe, 0x83, 0x85, 0x66, 0x1a, 0x71, 0x18, 0xc6, 0x87, 0xce, 0x87,
+	0x07, 0x91, 0x97, 0x04, 0x71, 0x24, 0x89, 0xdd, 0xf7, 0xf2, 0x5b, 0x39, 0xf8, 0x96, 0xdc, 0x08,
+	0x84, 0x54, 0x5e, 0x7e, 0x58, 0x86, 0x0a, 0x2f, 0x07, 0x70, 0xf0, 0x72, 0x50, 0x76, 0x18, 0x52,
+	0x5e, 0x0e, 0x18, 0x61, 0x17, 0xb5, 0x47, 0x05, 0xac, 0x52, 0x8c, 0xda, 0x51, 0xce, 0x91, 0xaa,
+	0xf5, 0x24, 0x79, 0x0e, 0xfc, 0x83, 0x14, 0xa3, 0xe0, 0xef, 0x01, 0x5a, 0xa4, 0x98, 0x3e, 0x64,
+	0x33, 0x83, 0x57, 0x15, 0x2f, 0xcc, 0x57, 0x97, 0x42, 0xc2, 0x05, 0xa9, 0x41, 0x71, 0x36, 0x97,
+	0x6f, 0x45, 0x90, 0xda, 0x04, 0xaa, 0xbe, 0x92, 0x57, 0x84, 0x47, 0x7d, 0xb0, 0x97, 0xd2, 0x59,
+	0xd0, 0x6f, 0x60, 0x36, 0x33, 0x25, 0xf0, 0x5d, 0x34, 0x0d, 0x6e, 0x16, 0x49, 0x8c, 0x5c, 0x05,
+	0x4f, 0x55, 0x1b, 0x38, 0xa5, 0x98, 0x22, 0xfd, 0xf4, 0x52, 0x3a, 0x57, 0x38, 0x5b, 0xa0, 0x36,
+	0x2b, 0x4b, 0xa9, 0x8c, 0x30, 0x09, 0x1a, 0x75, 0x5a, 0x92, 0xe4, 0x79, 0xe8, 0x0a, 0x8b, 0x56,
+	0x73, 0xa7, 0x13, 0x79, 0x3a, 0x7b, 0xd4, 0x6f, 0xe7, 0x2e, 0xcb, 0x02, 0x93, 0x45, 0x56, 0xef,
+	0x63, 0xd7, 0xe2, 0x56, 0xa0, 0x6e, 0xad, 0xa4, 0xa3, 0xd6, 0x67, 0xbe, 0x8a, 0x60, 0xa6, 0x0a,
+	0x2c, 0xd0, 0x95, 0xac, 0x63, 0x74, 0x4c, 0x4f, 0x9c, 0x38, 0x0a, 0x3b, 0xe4, 0x05, 0xf8, 0xbc,
+	0xff, 0xcf, 0x4b, 0x91, 0xdb, 0x51, 0xdf, 0x21, 0x79, 0x37, 0x0a, 0x3b, 0xa5, 0x52, 0x64, 0x88,
+	0xb5, 0x59, 0xf5, 0x2c, 0x2c, 0xd0, 0xb4, 0xe4, 0x21, 0xf7, 0x12, 0xee, 0x3b, 0xaa, 0xd3, 0x96,
+	0x64, 0x65, 0x79, 0x74, 0x65, 0x1c, 0x4e, 0xec, 0x54, 0xce, 0x6c, 0x2b, 0xa2, 0x97, 0xd2, 0xa7,
+	0xb5, 0x01, 0x03, 0x2d, 0x7f, 0xe3, 0x42, 0x35, 0xc5, 0xca, 0x8a, 0x54, 0xfa, 0x98, 0x8f, 0x23,
+	0xc7, 0xe7, 0x2d, 0x37, 0xf2, 0x1d, 0xcf, 0xf5, 0x9a, 0xdc, 0x51, 0xcf, 0x84, 0xe4, 0xc5, 0x8a,
+	0x8e, 0x61, 0x2b, 0x0b, 0xf6, 0x6c, 0x1c, 0x6d, 0xc0, 0x84, 0x75, 0x25, 0xbf, 0x63, 0x76, 0x32,
+	0x43, 0x4c, 0xa9, 0x8c, 0x59, 0x7e, 0xa7, 0xae, 0xf2, 0xc7, 0x39, 0xf8, 0xc7, 0x86, 0xd5, 0x60,
+	0x0f, 0x61, 0x75, 0x15, 0x08, 0xde, 0x8a, 0x93, 0xbc, 0xa2, 0x94, 0xe4, 0x25, 0xb8, 0x07, 0xfe,
+	0x4f, 0xf5, 0xbf, 0x2d, 0xf7, 0x88, 0x01, 0xa9, 0x2b, 0xc6, 0x7e, 0xa7, 0x36, 0x48, 0x14, 0xb7,
+	0xf7, 0xd0, 0x14, 0xdc, 0x46, 0x0b, 0xc3, 0x46, 0xa0, 0x5c, 0x78, 0x19, 0x0c, 0x7d, 0x25, 0xbb,
+	0x70, 0x4a, 0xb3, 0x74, 0xb5, 0x70, 0xa5, 0xd2, 0x96, 0x59, 0x2c, 0x54, 0x4d, 0xc4, 0x3f, 0x46,
+	0x73, 0x79, 0x11, 0xe8, 0xf4, 0x5f, 0x38, 0xc9, 0x35, 0xc8, 0xd2, 0x8b, 0x79, 0x8c, 0xf3, 0x8a,
+	0x8e, 0x15, 0x12, 0xba, 0xac, 0xf2, 0x86, 0xf0, 0xa2, 0xac, 0x1a, 0xa6, 0x6c, 0x56, 0x21, 0x8f,
+	0xff, 0x61, 0xa1, 0x2b, 0x85, 0x07, 0x6d, 0xc1, 0x1b, 0x5c, 0x08, 0xee, 0x3b, 0xfa, 0x89, 0x84,
+	0xbc, 0x02, 0x2f, 0x89, 0xbf, 0xfe, 0xb2, 0x2f, 0x89, 0x97, 0x73, 0x0b, 0xdb, 0xb9, 0x01, 0x4d,
+	0xf6, 0x52, 0xba, 0x5a, 0xf2, 0x76, 0x80, 0x1f, 0x7e, 0x8b, 0x53, 0xcf, 0x8a, 0xf0, 0xfc, 0xf6,
+	0x24, 0x95, 0x38, 0x41, 0xc4, 0x8b, 0xa3, 0x44, 0x15, 0x0b, 0x3e, 0x6f, 0x04, 0x11, 0xf7, 0x1d,
+	0xaf, 0x79, 0x10, 0xed, 0xab, 0xf6, 0x73, 0x15, 0x8e, 0xe7, 0xad, 0x6e, 0x4a, 0x17, 0x32, 0x99,
+	0x0d, 0x2d, 0xb2, 0x9e, 0x49, 0x14, 0xc7, 0xa7, 0x9a, 0xb6, 0xd9, 0x13, 0xe6, 0xe1, 0x0f, 0xd0,
+	0x8c, 0xf4, 0xc4, 0xc1, 0xae, 0xd9, 0x97, 0x5c, 0x87, 0x3d, 0x73, 0x53, 0x5d, 0xe1, 0xc0, 0x99,
+	0x0d, 0xc9, 0x7c, 0x96, 0x05, 0x4c, 0xb8, 0xd8, 0x29, 0x03, 0xe2, 0xf8, 0x1d, 0x34, 0xa9, 0x95,
+	0x0b, 0xde, 0x76, 0x03, 0x41, 0x6e, 0xc0, 0x67, 0xd4, 0x20, 0xb9, 0x29, 0x9c, 0x01, 0x5c, 0xe4,
+	0x63, 0x03, 0xb3, 0x99, 0x29, 0x81, 0xf7, 0xd1, 0xb8, 0xe0, 0xae, 0xaf, 0x73, 0xd5, 0x9f, 0x37,
+	0x41, 0xcd, 0xd6, 0x69, 0x4a, 0xf1, 0x06, 0x6f, 0x0b, 0xee, 0xb9, 0x09, 0xf7, 0x19, 0x77, 0x7d,
+	0x95, 0x73, 0xba, 0x29, 0xb5, 0x5e, 0x29, 0x1e, 0x7d, 0x45, 0x0c, 0xaf, 0x0a, 0xe5, 0x44, 0x32,
+	0x3b, 0x84, 0x12, 0x8b, 0x8d, 0x89, 0x4c, 0x01, 0xfe, 0x11, 0x9a, 0x2d, 0x3d, 0x35, 0xc0, 0x39,
+	0xfa, 0x8b, 0x32, 0x6a, 0xd5, 0xdf, 0x3e, 0x4d, 0x29, 0xe9, 0x1b, 0xdd, 0xea, 0x3f, 0x18, 0x6c,
+	0x7b, 0x49, 0x6e, 0x7a, 0x69, 0xf0, 0xbd, 0x61, 0xdb, 0x4b, 0x0c, 0x0f, 0x88, 0xc5, 0xa6, 0xcb,
+	0x24, 0xfe, 0x3e, 0xba, 0xa0, 0xdb, 0x2c, 0x49, 0x3e, 0xdb, 0x84, 0xe8, 0x7f, 0x4d, 0xd5, 0xab,
+	0x7d, 0x43, 0xba, 0x7d, 0x96, 0xe5, 0x8f, 0xcb, 0xa6, 0x18, 0xaa, 0xb3, 0x85, 0x20, 0x16, 0xcb,
+	0xf5, 0xd9, 0x3f, 0x1d, 0x45, 0x13, 0xc6, 0x8d, 0x88, 0x3f, 0x40, 0x17, 0x78, 0x94, 0x88, 0x80,
+	0x4b, 0x62, 0xc1, 0x1d, 0x44, 0x2a, 0xee, 0xcd, 0xb7, 0xa3, 0x44, 0x74, 0xea, 0x2f, 0xe4, 0x0f,
+	0x92, 0xd9, 0x84, 0xa2, 0x5f, 0x56, 0x63, 0x88, 0xe4, 0x39, 0xf8, 0xc7, 0x72, 0x01, 0xfc, 0xbb,
+	0xac, 0xf0, 0x95, 0x41, 0xb4, 0x17, 0x72, 0x07, 0x58, 0x9d, 0x7c, 0x47, 0xe0, 0xab, 0x1a, 0xea,
+	0xf0, 0xb7, 0xdc, 0xa3, 0x1d, 0xe0, 0xc1, 0x4a, 0x29, 0xd7, 0x0e, 0x53, 0xa5, 0x64, 0xbb, 0xf6,
+	0x9a, 0xf1, 0x00, 0x51, 0xa1, 0x47, 0x3d, 0x1e, 0x29, 0x29, 0x56, 0xc1, 0xe1, 0x07, 0x68, 0x5a,
+	0xb9, 0x96, 0xc4, 0x89, 0x1b, 0x6a, 0x9f, 0x46, 0xc1, 0xa7, 0x7b, 0x59, 0xef, 0x7a, 0x4f, 0x11,
+	0x99, 0x37, 0xcf, 0xe6, 0xde, 0x14, 0xa0, 0xe1, 0xc7, 0x6b, 0x37, 0xde, 0x78, 0xdd, 0xf0, 0xa3,
+	0x34, 0x57, 0x79, 0xa0, 0x78, 0x56, 0x42, 0xed, 0xdf, 0x5b, 0x68, 0x66, 0x30, 0xbc, 0xea, 0xa9,
+	0xa2, 0xa5, 0x5e, 0xf2, 0xb2, 0xc7, 0xfd, 0x97, 0xd5, 0xbb, 0x04, 0x00, 0x46, 0x8f, 0x95, 0x78,
+	0xcd, 0xe2, 0x95, 0x0e, 0xf5, 0x87, 0x4c, 0x0b, 0xe2, 0x4d, 0x74, 0x5e, 0x3d, 0xfa, 0x05, 0x09,
+	0xc4, 0x77, 0xac, 0xbe, 0x0a, 0xbd, 0x25, 0x20, 0xc5, 0xa9, 0xd2, 0xc3, 0x42, 0xcb, 0x84, 0x31,
+	0x66, 0x99, 0x6c, 0xfd, 0xce, 0xe7, 0x5f, 0x2c, 0x9d, 0x39, 0xf9, 0x62, 0xe9, 0xcc, 0xe7, 0xa7,
+	0x4b, 0xd6, 0xc9, 0xe9, 0x92, 0xf5, 0xab, 0x47, 0x4b, 0x67, 0x3e, 0x7d, 0xb4, 0x64, 0x9d, 0x3c,
+	0x5a, 0x3a, 0xf3, 0xef, 0x47, 0x4b, 0x67, 0xde, 0x7f, 0xf1, 0x7f, 0xc8, 0xa0, 0x7a, 0x1f, 0xed,
+	0x9e, 0x87, 0x4c, 0xfa, 0xea, 0x7f, 0x07, 0x00, 0x43, 0xb2, 0x2e, 0x1e, 0xf3, 0x1b, 0x00, 0x00,
 }
 
 func (m *FolderDeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -383,6 +526,157 @@ func (m *FolderConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.ScrubRepair {
+		i--
+		if m.ScrubRepair {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ScrubIntervalS != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ScrubIntervalS))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.ContentDefinedChunking {
+		i--
+		if m.ContentDefinedChunking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size := m.ConflictPreferredDevice.ProtoSize()
+		i -= size
+		if _, err := m.ConflictPreferredDevice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xea
+	if m.ConflictResolution != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.ConflictResolution))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MaxRemoteDeletesPct != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxRemoteDeletesPct))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxRemoteDeletes != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxRemoteDeletes))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	{
+		size, err := m.OnDemandCacheSize.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xca
+	if len(m.SelectedPaths) > 0 {
+		for iNdEx := len(m.SelectedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SelectedPaths[iNdEx])
+			copy(dAtA[i:], m.SelectedPaths[iNdEx])
+			i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.SelectedPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.ScanInSyncWindowsOnly {
+		i--
+		if m.ScanInSyncWindowsOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.SyncWindows) > 0 {
+		for iNdEx := len(m.SyncWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.SyncOwnership {
+		i--
+		if m.SyncOwnership {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size, err := m.XattrFilter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xa2
+	if m.SyncXattrs {
+		i--
+		if m.SyncXattrs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.JunctionsAsDirs {
 		i--
 		if m.JunctionsAsDirs {
@@ -676,6 +970,93 @@ func (m *FolderConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *XattrFilter) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XattrFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XattrFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTotalSize != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxTotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSingleEntrySize != 0 {
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(m.MaxSingleEntrySize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFolderconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *XattrFilterEntry) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XattrFilterEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *XattrFilterEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permit {
+		i--
+		if m.Permit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Match) > 0 {
+		i -= len(m.Match)
+		copy(dAtA[i:], m.Match)
+		i = encodeVarintFolderconfiguration(dAtA, i, uint64(len(m.Match)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFolderconfiguration(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFolderconfiguration(v)
 	base := offset
@@ -817,6 +1198,51 @@ func (m *FolderConfiguration) ProtoSize() (n int) {
 	if m.JunctionsAsDirs {
 		n += 3
 	}
+	if m.SyncXattrs {
+		n += 3
+	}
+	l = m.XattrFilter.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.SyncOwnership {
+		n += 3
+	}
+	if len(m.SyncWindows) > 0 {
+		for _, e := range m.SyncWindows {
+			l = e.ProtoSize()
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.ScanInSyncWindowsOnly {
+		n += 3
+	}
+	if len(m.SelectedPaths) > 0 {
+		for _, s := range m.SelectedPaths {
+			l = len(s)
+			n += 2 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	l = m.OnDemandCacheSize.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.MaxRemoteDeletes != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxRemoteDeletes))
+	}
+	if m.MaxRemoteDeletesPct != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.MaxRemoteDeletesPct))
+	}
+	if m.ConflictResolution != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ConflictResolution))
+	}
+	l = m.ConflictPreferredDevice.ProtoSize()
+	n += 2 + l + sovFolderconfiguration(uint64(l))
+	if m.ContentDefinedChunking {
+		n += 3
+	}
+	if m.ScrubIntervalS != 0 {
+		n += 2 + sovFolderconfiguration(uint64(m.ScrubIntervalS))
+	}
+	if m.ScrubRepair {
+		n += 3
+	}
 	if m.DeprecatedReadOnly {
 		n += 4
 	}
@@ -829,15 +1255,52 @@ func (m *FolderConfiguration) ProtoSize() (n int) {
 	return n
 }
 
-func sovFolderconfiguration(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFolderconfiguration(x uint64) (n int) {
-	return sovFolderconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *XattrFilter) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.ProtoSize()
+			n += 1 + l + sovFolderconfiguration(uint64(l))
+		}
+	}
+	if m.MaxSingleEntrySize != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.MaxSingleEntrySize))
+	}
+	if m.MaxTotalSize != 0 {
+		n += 1 + sovFolderconfiguration(uint64(m.MaxTotalSize))
+	}
+	return n
 }
-func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *XattrFilterEntry) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Match)
+	if l > 0 {
+		n += 1 + l + sovFolderconfiguration(uint64(l))
+	}
+	if m.Permit {
+		n += 2
+	}
+	return n
+}
+
+func sovFolderconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFolderconfiguration(x uint64) (n int) {
+	return sovFolderconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FolderDeviceConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1764,9 +2227,9 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.JunctionsAsDirs = bool(v != 0)
-		case 9000:
+		case 35:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncXattrs", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1783,23 +2246,45 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DeprecatedReadOnly = bool(v != 0)
-		case 9001:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinDiskFreePct", wireType)
+			m.SyncXattrs = bool(v != 0)
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XattrFilter", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.DeprecatedMinDiskFreePct = float64(math.Float64frombits(v))
-		case 9002:
+			if err := m.XattrFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedPullers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOwnership", wireType)
 			}
-			m.DeprecatedPullers = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFolderconfiguration
@@ -1809,11 +2294,554 @@ func (m *FolderConfiguration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeprecatedPullers |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SyncOwnership = bool(v != 0)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncWindows = append(m.SyncWindows, SyncWindow{})
+			if err := m.SyncWindows[len(m.SyncWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanInSyncWindowsOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScanInSyncWindowsOnly = bool(v != 0)
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectedPaths = append(m.SelectedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDemandCacheSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OnDemandCacheSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRemoteDeletes", wireType)
+			}
+			m.MaxRemoteDeletes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRemoteDeletes |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRemoteDeletesPct", wireType)
+			}
+			m.MaxRemoteDeletesPct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRemoteDeletesPct |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictResolution", wireType)
+			}
+			m.ConflictResolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictResolution |= ConflictResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictPreferredDevice", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConflictPreferredDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDefinedChunking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContentDefinedChunking = bool(v != 0)
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrubIntervalS", wireType)
+			}
+			m.ScrubIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScrubIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrubRepair", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScrubRepair = bool(v != 0)
+		case 9000:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedReadOnly = bool(v != 0)
+		case 9001:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedMinDiskFreePct", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DeprecatedMinDiskFreePct = float64(math.Float64frombits(v))
+		case 9002:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedPullers", wireType)
+			}
+			m.DeprecatedPullers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeprecatedPullers |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XattrFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XattrFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XattrFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, XattrFilterEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSingleEntrySize", wireType)
+			}
+			m.MaxSingleEntrySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSingleEntrySize |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalSize", wireType)
+			}
+			m.MaxTotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalSize |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XattrFilterEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFolderconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XattrFilterEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XattrFilterEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFolderconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Match = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFolderconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Permit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFolderconfiguration(dAtA[iNdEx:])
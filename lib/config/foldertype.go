@@ -16,6 +16,8 @@ func (t FolderType) String() string {
 		return "receiveonly"
 	case FolderTypeReceiveEncrypted:
 		return "receiveencrypted"
+	case FolderTypeOnDemand:
+		return "ondemand"
 	default:
 		return "unknown"
 	}
@@ -35,6 +37,8 @@ func (t *FolderType) UnmarshalText(bs []byte) error {
 		*t = FolderTypeReceiveOnly
 	case "receiveencrypted":
 		*t = FolderTypeReceiveEncrypted
+	case "ondemand":
+		*t = FolderTypeOnDemand
 	default:
 		*t = FolderTypeSendReceive
 	}
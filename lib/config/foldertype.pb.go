@@ -28,6 +28,7 @@ const (
 	FolderTypeSendOnly         FolderType = 1
 	FolderTypeReceiveOnly      FolderType = 2
 	FolderTypeReceiveEncrypted FolderType = 3
+	FolderTypeOnDemand         FolderType = 4
 )
 
 var FolderType_name = map[int32]string{
@@ -35,6 +36,7 @@ var FolderType_name = map[int32]string{
 	1: "FOLDER_TYPE_SEND_ONLY",
 	2: "FOLDER_TYPE_RECEIVE_ONLY",
 	3: "FOLDER_TYPE_RECEIVE_ENCRYPTED",
+	4: "FOLDER_TYPE_ON_DEMAND",
 }
 
 var FolderType_value = map[string]int32{
@@ -42,6 +44,7 @@ var FolderType_value = map[string]int32{
 	"FOLDER_TYPE_SEND_ONLY":         1,
 	"FOLDER_TYPE_RECEIVE_ONLY":      2,
 	"FOLDER_TYPE_RECEIVE_ENCRYPTED": 3,
+	"FOLDER_TYPE_ON_DEMAND":         4,
 }
 
 func (FolderType) EnumDescriptor() ([]byte, []int) {
@@ -55,23 +58,25 @@ func init() {
 func init() { proto.RegisterFile("lib/config/foldertype.proto", fileDescriptor_ea6ddb20c0633575) }
 
 var fileDescriptor_ea6ddb20c0633575 = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xc9, 0x4c, 0xd2,
-	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x4f, 0xcb, 0xcf, 0x49, 0x49, 0x2d, 0x2a, 0xa9, 0x2c,
-	0x48, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0x48, 0x48, 0x29, 0x17, 0xa5, 0x16,
-	0xe4, 0x17, 0xeb, 0x83, 0x05, 0x93, 0x4a, 0xd3, 0xf4, 0xd3, 0xf3, 0xd3, 0xf3, 0xc1, 0x1c, 0x30,
-	0x0b, 0xa2, 0x58, 0xeb, 0x17, 0x23, 0x17, 0x97, 0x1b, 0xd8, 0x84, 0x90, 0xca, 0x82, 0x54, 0x21,
-	0x73, 0x2e, 0x09, 0x37, 0x7f, 0x1f, 0x17, 0xd7, 0xa0, 0xf8, 0x90, 0xc8, 0x00, 0xd7, 0xf8, 0x60,
-	0x57, 0x3f, 0x97, 0xf8, 0x20, 0x57, 0x67, 0x57, 0xcf, 0x30, 0x57, 0x01, 0x06, 0x29, 0xc9, 0xae,
-	0xb9, 0x0a, 0xa2, 0x08, 0xd5, 0xc1, 0xa9, 0x79, 0x29, 0x41, 0xa9, 0xc9, 0xa9, 0x99, 0x65, 0xa9,
-	0x42, 0x86, 0x5c, 0xa2, 0x18, 0x1a, 0xfd, 0xfd, 0x7c, 0x22, 0x05, 0x18, 0xa5, 0xc4, 0xba, 0xe6,
-	0x2a, 0x08, 0xa1, 0xea, 0xf2, 0xcf, 0xcb, 0xa9, 0x44, 0xb7, 0x0b, 0x6a, 0x0d, 0x44, 0x17, 0x13,
-	0xba, 0x5d, 0x50, 0x7b, 0xc0, 0x1a, 0x1d, 0xb9, 0x64, 0xb1, 0x69, 0x74, 0xf5, 0x73, 0x0e, 0x8a,
-	0x0c, 0x08, 0x71, 0x75, 0x11, 0x60, 0x96, 0x92, 0xeb, 0x9a, 0xab, 0x20, 0x85, 0xa1, 0xdb, 0x35,
-	0x2f, 0xb9, 0xa8, 0xb2, 0xa0, 0x24, 0x35, 0x45, 0x8a, 0x65, 0xc5, 0x12, 0x39, 0x06, 0x27, 0xef,
-	0x13, 0x0f, 0xe5, 0x18, 0x2e, 0x3c, 0x94, 0x63, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x16, 0x3c, 0x96, 0x63, 0xbc, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xcd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2,
-	0xca, 0xbc, 0xe4, 0x92, 0x8c, 0xcc, 0xbc, 0x74, 0x24, 0x16, 0x22, 0x1e, 0x92, 0xd8, 0xc0, 0x01,
-	0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x87, 0xbe, 0x2d, 0x9c, 0x01, 0x00, 0x00,
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4a, 0xc3, 0x40,
+	0x18, 0xc7, 0x2f, 0xb5, 0x74, 0xb8, 0x29, 0x04, 0x2a, 0x7a, 0xe2, 0x51, 0x70, 0xd2, 0xa1, 0x41,
+	0x1c, 0x9c, 0x6b, 0xef, 0x0a, 0x62, 0x4d, 0x4a, 0x5a, 0x84, 0xba, 0x04, 0x93, 0x5c, 0xd3, 0x40,
+	0x7a, 0x17, 0xd2, 0x54, 0xc8, 0x2b, 0x64, 0xf2, 0x05, 0x02, 0x0e, 0x0e, 0x2e, 0xbe, 0x47, 0xc7,
+	0x8c, 0xae, 0x6d, 0x5e, 0x44, 0xb8, 0x14, 0xaa, 0xa9, 0xdb, 0x77, 0x77, 0xdf, 0xef, 0x7e, 0x7f,
+	0xf8, 0xc3, 0xb3, 0x30, 0x70, 0x74, 0x57, 0xf0, 0x59, 0xe0, 0xeb, 0x33, 0x11, 0x7a, 0x2c, 0x4e,
+	0xd2, 0x88, 0x75, 0xa3, 0x58, 0x24, 0x42, 0x6b, 0x55, 0x0f, 0xe8, 0x22, 0x66, 0x91, 0x58, 0xea,
+	0xf2, 0xd2, 0x59, 0xcd, 0x74, 0x5f, 0xf8, 0x42, 0x1e, 0xe4, 0x54, 0x2d, 0x5f, 0x7d, 0x35, 0x20,
+	0x1c, 0xc8, 0x1f, 0x26, 0x69, 0xc4, 0xb4, 0x5b, 0x78, 0x32, 0x30, 0x87, 0x84, 0x5a, 0xf6, 0x64,
+	0x3a, 0xa2, 0xf6, 0x98, 0x1a, 0xc4, 0xb6, 0x68, 0x9f, 0xde, 0x3f, 0x51, 0x15, 0xa0, 0xd3, 0x2c,
+	0xef, 0xb4, 0xf7, 0xdb, 0x63, 0xc6, 0x3d, 0x8b, 0xb9, 0x2c, 0x78, 0x65, 0xda, 0x35, 0x6c, 0x1f,
+	0x80, 0xa6, 0x31, 0x9c, 0xaa, 0x0a, 0x3a, 0xce, 0xf2, 0x8e, 0xf6, 0x97, 0x32, 0x79, 0x98, 0xd6,
+	0x5d, 0x3b, 0x4d, 0x45, 0x35, 0xea, 0xae, 0x9d, 0x47, 0x82, 0x3d, 0x78, 0xfe, 0x1f, 0x48, 0x8d,
+	0xbe, 0x35, 0x1d, 0x4d, 0x28, 0x51, 0x8f, 0x10, 0xce, 0xf2, 0x0e, 0x3a, 0xa0, 0x29, 0x77, 0xe3,
+	0x34, 0x4a, 0x98, 0x57, 0x8f, 0x6b, 0x1a, 0x36, 0xa1, 0x8f, 0x3d, 0x83, 0xa8, 0xcd, 0x7a, 0x5c,
+	0x93, 0x13, 0xb6, 0x78, 0xe1, 0x1e, 0x6a, 0x7e, 0x7e, 0x60, 0x70, 0xf7, 0xb0, 0xde, 0x60, 0x50,
+	0x6c, 0x30, 0x58, 0x6f, 0xb1, 0x52, 0x6c, 0xb1, 0xf2, 0x56, 0x62, 0xf0, 0x5e, 0x62, 0xa5, 0x28,
+	0x31, 0xf8, 0x2e, 0x31, 0x78, 0xbe, 0xf4, 0x83, 0x64, 0xbe, 0x72, 0xba, 0xae, 0x58, 0xe8, 0xcb,
+	0x94, 0xbb, 0xc9, 0x3c, 0xe0, 0xfe, 0xaf, 0x69, 0x5f, 0x9d, 0xd3, 0x92, 0x1d, 0xdc, 0xfc, 0x0c,
+	0x00, 0xc1, 0xf4, 0xd0, 0x5d, 0xcf, 0x01, 0x00, 0x00,
 }
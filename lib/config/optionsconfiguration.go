@@ -9,6 +9,7 @@ package config
 import (
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/rand"
@@ -25,6 +26,7 @@ func (opts OptionsConfiguration) Copy() OptionsConfiguration {
 	copy(optsCopy.AlwaysLocalNets, opts.AlwaysLocalNets)
 	optsCopy.UnackedNotificationIDs = make([]string, len(opts.UnackedNotificationIDs))
 	copy(optsCopy.UnackedNotificationIDs, opts.UnackedNotificationIDs)
+	optsCopy.BandwidthSchedule = copyBandwidthSchedule(opts.BandwidthSchedule)
 	return optsCopy
 }
 
@@ -34,6 +36,8 @@ func (opts *OptionsConfiguration) prepare(guiPWIsSet bool) {
 	opts.RawListenAddresses = util.UniqueTrimmedStrings(opts.RawListenAddresses)
 	opts.RawGlobalAnnServers = util.UniqueTrimmedStrings(opts.RawGlobalAnnServers)
 
+	opts.BandwidthSchedule = prepareBandwidthSchedule(opts.BandwidthSchedule)
+
 	// Very short reconnection intervals are annoying
 	if opts.ReconnectIntervalS < 5 {
 		opts.ReconnectIntervalS = 5
@@ -200,3 +204,13 @@ func (opts OptionsConfiguration) LowestConnectionLimit() int {
 	}
 	return limit
 }
+
+// BandwidthLimits returns the overall send and receive rate limits, in
+// KiB/s, in effect at the given time, and the name of the bandwidth
+// schedule entry they come from or the empty string if none is active.
+func (opts OptionsConfiguration) BandwidthLimits(t time.Time) (sendKbps, recvKbps int, profile string) {
+	if e, ok := activeBandwidthEntry(opts.BandwidthSchedule, t); ok {
+		return e.MaxSendKbps, e.MaxRecvKbps, e.Name
+	}
+	return opts.MaxSendKbps, opts.MaxRecvKbps, ""
+}
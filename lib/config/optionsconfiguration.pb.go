@@ -84,6 +84,19 @@ type OptionsConfiguration struct {
 	// When set, this allows TLS 1.2 on sync connections, where we otherwise
 	// default to TLS 1.3+ only.
 	InsecureAllowOldTLSVersions bool `protobuf:"varint,53,opt,name=insecure_allow_old_tls_versions,json=insecureAllowOldTlsVersions,proto3" json:"insecureAllowOldTLSVersions" xml:"insecureAllowOldTLSVersions"`
+	// The database implementation to use. Changing it does not move any
+	// data; use "syncthing cli debug index migrate" for that.
+	DatabaseBackend DatabaseBackend `protobuf:"varint,54,opt,name=database_backend,json=databaseBackend,proto3,enum=config.DatabaseBackend" json:"databaseBackend" xml:"databaseBackend" restart:"true"`
+	// Rate limits that apply instead of max_send_kbps and max_recv_kbps
+	// during the given periods. The first matching entry is used.
+	BandwidthSchedule []BandwidthScheduleEntry `protobuf:"bytes,55,rep,name=bandwidth_schedule,json=bandwidthSchedule,proto3" json:"bandwidthSchedule" xml:"bandwidthSchedule,omitempty"`
+	// Announce and look up devices on the local network using mDNS/DNS-SD,
+	// in addition to local discovery, for networks that block the latter.
+	MDNSEnabled bool `protobuf:"varint,56,opt,name=mdns_enabled,json=mdnsEnabled,proto3" json:"mdnsEnabled" xml:"mdnsEnabled"`
+	// Share the addresses we reach connected devices at with our other
+	// trusted peers, and use the addresses they share with us to find
+	// devices.
+	PeerExchangeEnabled bool `protobuf:"varint,57,opt,name=peer_exchange_enabled,json=peerExchangeEnabled,proto3" json:"peerExchangeEnabled" xml:"peerExchangeEnabled"`
 	// Legacy deprecated
 	DeprecatedUPnPEnabled        bool     `protobuf:"varint,9000,opt,name=upnp_enabled,json=upnpEnabled,proto3" json:"-" xml:"upnpEnabled,omitempty"`                                    // Deprecated: Do not use.
 	DeprecatedUPnPLeaseM         int      `protobuf:"varint,9001,opt,name=upnp_lease_m,json=upnpLeaseM,proto3,casttype=int" json:"-" xml:"upnpLeaseMinutes,omitempty"`                   // Deprecated: Do not use.
@@ -136,213 +149,225 @@ func init() {
 }
 
 var fileDescriptor_d09882599506ca03 = []byte{
-	// 3295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0x1d, 0x47,
-	0xd9, 0xce, 0x26, 0x4d, 0xda, 0x6c, 0x1c, 0x27, 0x1e, 0x3b, 0xf6, 0x36, 0x49, 0xbd, 0xee, 0xc9,
-	0x49, 0xeb, 0xfe, 0x25, 0xb6, 0x93, 0xe6, 0x4b, 0x23, 0x7d, 0xea, 0xe7, 0x9f, 0xfa, 0xab, 0x1b,
-	0x3b, 0xb1, 0xc6, 0xf6, 0xd7, 0x4f, 0x45, 0x68, 0x35, 0xde, 0x9d, 0x63, 0x2f, 0xde, 0x33, 0x7b,
-	0xba, 0x33, 0xeb, 0x9f, 0x16, 0x41, 0x55, 0x04, 0xe5, 0x0e, 0xb0, 0xf8, 0x91, 0x40, 0x42, 0x45,
-	0x80, 0x44, 0x29, 0x45, 0x48, 0x48, 0x48, 0x70, 0x43, 0x85, 0x84, 0x54, 0xc1, 0x85, 0x7d, 0x89,
-	0x44, 0x59, 0x54, 0xa7, 0x57, 0xe7, 0x82, 0x8b, 0x73, 0x69, 0x6e, 0xd0, 0xcc, 0xfe, 0xcd, 0xee,
-	0xce, 0x49, 0x72, 0x77, 0xf6, 0x7d, 0xde, 0x79, 0xe7, 0x79, 0xe7, 0xe7, 0x9d, 0xf7, 0x9d, 0x39,
-	0xfa, 0x65, 0xcf, 0x5d, 0xbd, 0x6a, 0xfb, 0xa4, 0xe1, 0xae, 0x5d, 0xf5, 0x5b, 0xcc, 0xf5, 0x09,
-	0x8d, 0xbf, 0xc2, 0x00, 0xf1, 0xaf, 0x2b, 0xad, 0xc0, 0x67, 0x3e, 0x38, 0x11, 0x0b, 0xcf, 0x0f,
-	0x49, 0xea, 0x2c, 0x24, 0x2e, 0x59, 0x8b, 0x15, 0xce, 0x9f, 0x93, 0x00, 0xea, 0xbe, 0x85, 0x13,
-	0xf1, 0x49, 0xbc, 0xcd, 0xe2, 0x9f, 0xb5, 0x83, 0xd7, 0xf4, 0x81, 0xbb, 0x71, 0x0f, 0xd3, 0x72,
-	0x0f, 0xe0, 0xc7, 0x9a, 0x7e, 0xd6, 0x73, 0x29, 0xc3, 0xc4, 0x42, 0x8e, 0x13, 0x60, 0x4a, 0x31,
-	0x35, 0xb4, 0x91, 0x63, 0xa3, 0x27, 0xa7, 0xe8, 0x41, 0x64, 0x02, 0x88, 0xb6, 0xe6, 0x05, 0x3c,
-	0x99, 0xa2, 0xed, 0xc8, 0x3c, 0xe3, 0x15, 0x45, 0x9d, 0xc8, 0xbc, 0xbc, 0xdd, 0xf4, 0x6e, 0xd5,
-	0x0a, 0xf2, 0xda, 0x88, 0x83, 0x1b, 0x28, 0xf4, 0xd8, 0xad, 0x5a, 0xf2, 0xa3, 0x76, 0xb8, 0x57,
-	0x7f, 0x34, 0xf9, 0xbd, 0xbb, 0x5f, 0x57, 0x18, 0x87, 0x65, 0xd3, 0xe0, 0x5f, 0x9a, 0x6e, 0xac,
-	0x79, 0xfe, 0x2a, 0xf2, 0x2c, 0xc7, 0xa5, 0xb6, 0xbf, 0x89, 0x83, 0x1d, 0x8b, 0xe2, 0x60, 0x13,
-	0x07, 0xd4, 0x38, 0x2a, 0x88, 0xfe, 0x56, 0x3b, 0x88, 0xcc, 0x7e, 0x88, 0xb6, 0xfe, 0x57, 0xe8,
-	0x4d, 0x12, 0xb2, 0x14, 0xe3, 0xed, 0xc8, 0x3c, 0xb7, 0x96, 0xca, 0xfc, 0x90, 0xd8, 0x38, 0x01,
-	0x3a, 0x91, 0xf9, 0xbc, 0x20, 0xac, 0x42, 0x15, 0xbc, 0xdb, 0x7b, 0xf5, 0x01, 0x95, 0x6a, 0x67,
-	0xaf, 0xae, 0xee, 0xa0, 0xe8, 0xa8, 0x8a, 0x1b, 0x1c, 0x8c, 0x1b, 0xce, 0xa4, 0x4e, 0x25, 0x72,
-	0xf0, 0xb9, 0xca, 0x61, 0x4c, 0xd0, 0xaa, 0x87, 0x1d, 0xe3, 0xd8, 0x88, 0x36, 0xfa, 0xd8, 0xd4,
-	0x07, 0xdc, 0xe1, 0xb3, 0x99, 0xc5, 0x57, 0x62, 0xb0, 0xea, 0x6d, 0x02, 0x74, 0x22, 0xf3, 0x59,
-	0x85, 0xb7, 0x09, 0x2a, 0xb9, 0xcb, 0x82, 0x10, 0x73, 0x5f, 0xbb, 0x98, 0xe9, 0x06, 0x1c, 0xee,
-	0xd5, 0x1f, 0xe1, 0x4d, 0x77, 0xf7, 0xeb, 0x15, 0x52, 0x15, 0x37, 0x13, 0x39, 0xf8, 0x54, 0xd3,
-	0x87, 0x3c, 0xdf, 0x56, 0x7a, 0xf9, 0x88, 0xf0, 0xf2, 0xa7, 0xdc, 0xcb, 0x33, 0xf3, 0x5c, 0xa7,
-	0xe0, 0xe4, 0x80, 0x97, 0x88, 0x4a, 0x3e, 0x3e, 0x13, 0x2f, 0x41, 0x05, 0xa8, 0x70, 0x51, 0x6d,
-	0xa4, 0x8b, 0x5c, 0x72, 0xb0, 0xcc, 0x07, 0x9e, 0x13, 0x0d, 0x2a, 0xee, 0xfd, 0x55, 0xd3, 0xfb,
-	0x63, 0xf7, 0x50, 0x62, 0xcb, 0x6a, 0xf9, 0x01, 0x33, 0x8e, 0x8f, 0x68, 0xa3, 0xc7, 0xa7, 0x7e,
-	0xc8, 0x5d, 0xeb, 0x49, 0x4d, 0x2d, 0xfa, 0x01, 0x6b, 0x47, 0x66, 0x5f, 0xa1, 0x6b, 0x2e, 0xec,
-	0x44, 0xe6, 0xd3, 0x55, 0xa7, 0x38, 0x22, 0x79, 0x34, 0x31, 0x3e, 0x36, 0xf1, 0x5f, 0xb5, 0xc3,
-	0xc8, 0x3c, 0xe6, 0x12, 0xd6, 0xde, 0xab, 0x2b, 0xcc, 0xa8, 0x84, 0x87, 0x7b, 0xf5, 0xe3, 0xa2,
-	0xe9, 0xee, 0x7e, 0xbd, 0xc0, 0x04, 0x56, 0x75, 0xc1, 0xd7, 0x8e, 0xea, 0x23, 0x25, 0x6f, 0x9a,
-	0xa1, 0xc7, 0x5c, 0x1b, 0x51, 0x96, 0xc6, 0x0d, 0xe3, 0xc4, 0x88, 0x36, 0x7a, 0x72, 0xea, 0xf7,
-	0xdc, 0xb5, 0xde, 0xd4, 0xe0, 0xc2, 0x34, 0xdf, 0xc9, 0xed, 0xc8, 0xec, 0x2f, 0x18, 0x8d, 0xc5,
-	0x9d, 0xc8, 0xbc, 0x51, 0x75, 0x2f, 0xc6, 0x24, 0x07, 0xbf, 0xd0, 0x68, 0x8c, 0x4f, 0xdc, 0xba,
-	0x75, 0xf3, 0xda, 0xcd, 0xeb, 0x5f, 0xbc, 0x15, 0x7b, 0xdb, 0xde, 0xab, 0x2b, 0x0d, 0xaa, 0xc5,
-	0x87, 0x7b, 0x75, 0x50, 0x35, 0xb2, 0xbb, 0x5f, 0x2f, 0xd1, 0x84, 0x4f, 0x14, 0x1b, 0xa7, 0x1e,
-	0x26, 0xc1, 0x08, 0xdc, 0xd5, 0x4f, 0x37, 0xd1, 0xb6, 0x45, 0x31, 0x71, 0xac, 0x8d, 0xd5, 0x16,
-	0x35, 0x1e, 0x15, 0x93, 0xf9, 0x5c, 0x3b, 0x32, 0x4f, 0x35, 0xd1, 0xf6, 0x12, 0x26, 0xce, 0xed,
-	0xd5, 0x16, 0x0f, 0x2e, 0x7d, 0xc2, 0x2d, 0x49, 0x96, 0xce, 0x0f, 0x94, 0x15, 0x53, 0x83, 0x01,
-	0xb6, 0x37, 0x63, 0x83, 0x8f, 0x15, 0x0c, 0x42, 0x6c, 0x6f, 0x96, 0x0d, 0xa6, 0xb2, 0x82, 0xc1,
-	0x54, 0x08, 0x7e, 0xa7, 0xe9, 0x43, 0x01, 0xb6, 0x7d, 0x42, 0xb0, 0xcd, 0xc3, 0xbb, 0xe5, 0x12,
-	0x86, 0x83, 0x4d, 0xe4, 0x59, 0xd4, 0x38, 0x29, 0x6c, 0x7f, 0x45, 0x04, 0xf5, 0x54, 0x65, 0x2e,
-	0x81, 0x97, 0x78, 0xec, 0x90, 0x1b, 0x66, 0x40, 0x27, 0x32, 0x47, 0x45, 0xdf, 0x4a, 0x54, 0x9a,
-	0xa5, 0x1b, 0x63, 0x29, 0xa5, 0xc3, 0xbd, 0xfa, 0xd1, 0x1b, 0x63, 0x22, 0xbe, 0x57, 0xfa, 0x81,
-	0xea, 0x5e, 0x40, 0x43, 0xef, 0x0d, 0xb0, 0x87, 0x76, 0x68, 0x16, 0x03, 0x74, 0x11, 0x03, 0x5e,
-	0x6e, 0x47, 0xe6, 0xe9, 0x18, 0xc9, 0x37, 0x7a, 0x2d, 0x21, 0x24, 0x49, 0xcb, 0x3b, 0x3c, 0xdd,
-	0xb1, 0xb0, 0xd8, 0x18, 0xbc, 0x7b, 0x54, 0xbf, 0x90, 0x74, 0x94, 0x11, 0xc9, 0x07, 0xa9, 0x69,
-	0x9c, 0x12, 0x83, 0xf4, 0x27, 0xbe, 0x86, 0x87, 0x20, 0xd7, 0xab, 0xb8, 0xb0, 0xd0, 0x8e, 0xcc,
-	0xa1, 0x40, 0x0d, 0x65, 0x81, 0xb6, 0x0b, 0x2e, 0xb1, 0x1c, 0x1f, 0x93, 0xb6, 0x6c, 0x57, 0x7b,
-	0xdd, 0x21, 0x3e, 0xc8, 0xe3, 0x7c, 0x90, 0xbb, 0xd1, 0x84, 0x46, 0xec, 0x67, 0x15, 0x01, 0xab,
-	0xfa, 0x69, 0xca, 0x50, 0xc0, 0xac, 0xd5, 0xc0, 0xdf, 0xa2, 0x38, 0x30, 0x7a, 0xc4, 0x58, 0xff,
-	0x77, 0x3b, 0x32, 0x7b, 0x04, 0x30, 0x15, 0xcb, 0x3b, 0x91, 0xf9, 0xa4, 0x70, 0x47, 0x16, 0x76,
-	0x1d, 0xe9, 0x42, 0x53, 0xf0, 0x73, 0x4d, 0x3f, 0x47, 0x10, 0xb3, 0x58, 0x80, 0xf8, 0xa9, 0x86,
-	0xbc, 0x6c, 0x62, 0x7b, 0x45, 0x67, 0x6f, 0x1e, 0x44, 0xa6, 0x7e, 0x67, 0x72, 0x39, 0x0f, 0xeb,
-	0x3a, 0x41, 0x2c, 0x9f, 0x63, 0x53, 0x74, 0x9c, 0x8b, 0x14, 0x21, 0x5c, 0x6e, 0x50, 0xf8, 0x92,
-	0xc2, 0xb5, 0xd4, 0x05, 0xec, 0x27, 0x88, 0x2d, 0xa7, 0x74, 0xd2, 0x05, 0xf1, 0x87, 0x0a, 0x4f,
-	0x0f, 0x23, 0x8a, 0xad, 0xa6, 0x71, 0x46, 0x2c, 0x85, 0x6f, 0xf0, 0xa5, 0x70, 0xf2, 0xce, 0xe4,
-	0xf2, 0x3c, 0x17, 0xf3, 0xc9, 0x3f, 0x43, 0x10, 0x8b, 0x3f, 0x5c, 0x12, 0x32, 0x91, 0xfc, 0xd4,
-	0x52, 0xb2, 0xb2, 0x5c, 0xb9, 0x37, 0xda, 0x7b, 0xf5, 0x4a, 0xfb, 0xaa, 0x28, 0xdb, 0x41, 0x79,
-	0xc7, 0x10, 0xc8, 0xec, 0x63, 0x19, 0xf8, 0x8b, 0xa6, 0x0f, 0x15, 0xc9, 0x07, 0x98, 0xe0, 0x2d,
-	0xb1, 0x92, 0xcf, 0x0a, 0xfa, 0xbb, 0x9c, 0xfe, 0xa9, 0x3b, 0x93, 0xcb, 0x30, 0x06, 0xb8, 0x03,
-	0x7d, 0x04, 0xb1, 0xf4, 0x33, 0x73, 0xa1, 0x9e, 0xba, 0x50, 0x44, 0x24, 0x27, 0xae, 0xc9, 0x4e,
-	0x28, 0x6c, 0xa8, 0x84, 0xdc, 0x91, 0x6b, 0xdc, 0x11, 0x99, 0x02, 0x1c, 0x90, 0x5d, 0x49, 0xa5,
-	0x0a, 0x67, 0x98, 0xdb, 0xc4, 0x7e, 0xc8, 0x2c, 0x6a, 0xf4, 0x15, 0x9d, 0x59, 0x8e, 0x81, 0xa5,
-	0xc4, 0x99, 0xf4, 0x93, 0xaf, 0x74, 0xa7, 0xe0, 0x4c, 0x11, 0xe9, 0xb6, 0xfd, 0x14, 0x36, 0x54,
-	0xc2, 0x6c, 0xcb, 0xc9, 0x14, 0x8a, 0xce, 0xa4, 0x52, 0xf0, 0x23, 0x4d, 0x37, 0x42, 0x8a, 0xd6,
-	0xb0, 0x15, 0x60, 0x7e, 0xee, 0xbb, 0x64, 0xcd, 0x42, 0xb6, 0x8d, 0x5b, 0x0c, 0x3b, 0x06, 0x10,
-	0xde, 0x20, 0xbe, 0x03, 0x56, 0xe0, 0x64, 0x22, 0xe5, 0x3b, 0x20, 0x0c, 0xd2, 0xaf, 0x4e, 0x64,
-	0x9e, 0x15, 0x4e, 0xe4, 0x22, 0x89, 0xb0, 0xac, 0x58, 0xf8, 0xe2, 0x2b, 0x3e, 0x37, 0x09, 0x07,
-	0x05, 0x05, 0x98, 0x32, 0x48, 0xe5, 0xe0, 0x6d, 0x7d, 0xa0, 0x4c, 0x8e, 0x62, 0x4c, 0x8c, 0x7e,
-	0x41, 0x6c, 0xee, 0x20, 0x32, 0x4f, 0xac, 0xc0, 0x25, 0x8c, 0x49, 0x3b, 0x32, 0x4f, 0x84, 0x01,
-	0xff, 0xd5, 0x89, 0xcc, 0x9e, 0x84, 0x10, 0xff, 0x94, 0xc8, 0xa4, 0x0a, 0xd9, 0xaf, 0xdd, 0xfd,
-	0x7a, 0xd2, 0x1c, 0x82, 0x22, 0x01, 0x2e, 0x03, 0xdf, 0xd3, 0xf4, 0xc7, 0xcb, 0xbd, 0x87, 0xc4,
-	0x7d, 0x33, 0xc4, 0x96, 0xeb, 0x18, 0x03, 0x22, 0x89, 0x78, 0x23, 0x1e, 0x9b, 0x15, 0x21, 0x9e,
-	0x9b, 0x89, 0xc7, 0x26, 0xf9, 0x92, 0xc7, 0x26, 0x55, 0xa8, 0xc5, 0x83, 0x92, 0x7e, 0x76, 0xe4,
-	0xaf, 0x64, 0x50, 0x52, 0xac, 0x3c, 0x28, 0xa9, 0x16, 0xf8, 0x58, 0xd3, 0xfb, 0x2b, 0xbc, 0x02,
-	0xcf, 0x38, 0x27, 0x18, 0x7d, 0x8b, 0xaf, 0xbd, 0xe3, 0x2b, 0x70, 0x05, 0xce, 0xb7, 0x23, 0xf3,
-	0x78, 0x18, 0xac, 0xc0, 0xf9, 0x4e, 0x64, 0xde, 0x4c, 0x89, 0xc0, 0x79, 0x69, 0x75, 0xad, 0x33,
-	0xd6, 0xa2, 0xb7, 0xae, 0x5e, 0x75, 0x10, 0x43, 0x57, 0xe8, 0x0e, 0xb1, 0xd9, 0x3a, 0x2f, 0xd6,
-	0x08, 0x66, 0x57, 0x09, 0xde, 0xe2, 0x52, 0x4e, 0x38, 0x31, 0x92, 0xfe, 0x38, 0xdc, 0xab, 0x3f,
-	0x44, 0xc3, 0xdd, 0xfd, 0x7a, 0xcc, 0x02, 0xf6, 0x95, 0xfc, 0x08, 0x3c, 0xf0, 0x4f, 0x4d, 0x37,
-	0xcb, 0x2e, 0xb4, 0x7c, 0xca, 0x4f, 0x38, 0x8a, 0xed, 0x30, 0xc0, 0xde, 0x8e, 0x31, 0x28, 0xc2,
-	0xef, 0x0f, 0x44, 0x05, 0xb1, 0x02, 0x17, 0x7d, 0xca, 0xe6, 0x32, 0xb0, 0x1d, 0x99, 0x67, 0xc3,
-	0xa0, 0x28, 0xeb, 0x44, 0xe6, 0x53, 0x89, 0x93, 0x45, 0x40, 0xf2, 0xb7, 0x81, 0x3c, 0x2a, 0x42,
-	0x72, 0xb5, 0xb5, 0x42, 0xc6, 0x33, 0x4f, 0xd1, 0x82, 0xd7, 0x0b, 0x65, 0x0a, 0xf0, 0x62, 0xd1,
-	0xad, 0x22, 0x0a, 0xfe, 0xa1, 0xf0, 0xd0, 0x25, 0x2e, 0x73, 0x79, 0x1d, 0xc1, 0xcf, 0x3b, 0x8b,
-	0x1a, 0x43, 0x62, 0x15, 0x7f, 0x5f, 0x54, 0x0f, 0x2b, 0x70, 0x2e, 0x46, 0x67, 0x38, 0xc8, 0x03,
-	0xc6, 0x99, 0x30, 0x28, 0x88, 0xb2, 0x70, 0x51, 0x92, 0xcb, 0xc1, 0xe2, 0xe6, 0x58, 0x21, 0x80,
-	0x97, 0x2d, 0x54, 0x45, 0xfc, 0x04, 0xe2, 0xad, 0x78, 0xc1, 0x50, 0xa2, 0x00, 0x2f, 0x14, 0x1d,
-	0x2c, 0x80, 0xc0, 0xd7, 0xfb, 0x02, 0x1c, 0x1f, 0xce, 0x3e, 0xb1, 0xb6, 0xd0, 0x06, 0x0e, 0x5b,
-	0x86, 0x21, 0xa6, 0x6c, 0x9a, 0x93, 0x4f, 0xc0, 0xbb, 0xe4, 0x75, 0x01, 0x65, 0xe4, 0x4b, 0xf2,
-	0xae, 0x87, 0x74, 0xd9, 0x00, 0x78, 0x4f, 0xd3, 0x87, 0x50, 0xc8, 0x7c, 0x2b, 0x6c, 0xad, 0x05,
-	0xc8, 0xc1, 0x79, 0x32, 0xb4, 0x6e, 0x3c, 0x2e, 0x06, 0x72, 0x91, 0x97, 0x5c, 0x5c, 0x65, 0x25,
-	0xd6, 0x48, 0xf3, 0x88, 0x57, 0xb3, 0xea, 0x44, 0x05, 0xca, 0xc3, 0x37, 0x21, 0x67, 0x86, 0xe3,
-	0x13, 0x50, 0x69, 0x0d, 0x34, 0xf5, 0xa1, 0x94, 0x03, 0xf3, 0xad, 0x56, 0xc0, 0xa7, 0x58, 0x9c,
-	0xc5, 0xd4, 0x38, 0x2f, 0x06, 0xe0, 0x06, 0x27, 0x92, 0xa8, 0x2c, 0xfb, 0x8b, 0x01, 0x86, 0x09,
-	0xde, 0x89, 0xcc, 0xf3, 0xf1, 0x14, 0x2a, 0xc0, 0x1a, 0x54, 0xb6, 0x01, 0x9b, 0x3a, 0xd8, 0xc0,
-	0xb8, 0x65, 0x31, 0xdc, 0x6c, 0xf9, 0x01, 0x0a, 0x5c, 0x4c, 0xad, 0x75, 0xe3, 0x82, 0x70, 0xf9,
-	0x55, 0xbe, 0x11, 0x38, 0xba, 0x9c, 0x83, 0xdc, 0xdd, 0x4b, 0xa2, 0x97, 0x32, 0x20, 0xd7, 0x62,
-	0xd7, 0x65, 0x57, 0x27, 0xae, 0xc3, 0x8a, 0x15, 0xb0, 0xa3, 0xf7, 0xdb, 0xc8, 0x5e, 0xc7, 0x96,
-	0xbb, 0x46, 0xfc, 0x00, 0x3b, 0x56, 0xc3, 0xf5, 0x30, 0x35, 0x2e, 0x0a, 0x17, 0xe7, 0xf8, 0x89,
-	0x26, 0xe0, 0xb9, 0x18, 0x9d, 0xe5, 0x60, 0x36, 0xd0, 0x15, 0xa4, 0xb2, 0x07, 0xb3, 0xbd, 0x05,
-	0xab, 0x66, 0xc0, 0x77, 0x34, 0xfd, 0x7c, 0x2b, 0xf0, 0xd7, 0x78, 0x31, 0x63, 0x85, 0x2d, 0x07,
-	0x31, 0x2c, 0x17, 0x08, 0x4f, 0x08, 0xdf, 0x97, 0x79, 0x7e, 0x9b, 0x6a, 0xad, 0x08, 0x25, 0xb9,
-	0x18, 0x88, 0x8b, 0xec, 0x2e, 0xb8, 0x44, 0xe7, 0x45, 0x69, 0x20, 0xb4, 0x17, 0x61, 0x37, 0x8b,
-	0xe0, 0x5d, 0x4d, 0x1f, 0xf4, 0xdc, 0xa6, 0xcb, 0xac, 0x55, 0x44, 0x9c, 0x2d, 0xd7, 0x61, 0xeb,
-	0x96, 0x4b, 0x2c, 0x0f, 0x11, 0x63, 0x58, 0x0c, 0xc9, 0x82, 0x28, 0x1e, 0xb9, 0xc6, 0x54, 0xaa,
-	0x30, 0x47, 0xe6, 0x11, 0xc9, 0x0b, 0xfe, 0x2a, 0x76, 0x9f, 0x61, 0x51, 0x99, 0x02, 0xef, 0x68,
-	0x3a, 0x68, 0xba, 0xc4, 0x5a, 0xf7, 0x9b, 0xd8, 0x72, 0x5c, 0xba, 0x61, 0x35, 0x02, 0x8c, 0x0d,
-	0x73, 0x44, 0x1b, 0x3d, 0x35, 0xd1, 0x73, 0x25, 0xbe, 0x59, 0xbb, 0xb2, 0xe4, 0xbe, 0x85, 0xa7,
-	0x5e, 0xf9, 0x24, 0x32, 0x8f, 0xf0, 0x9d, 0xd8, 0x74, 0xc9, 0xab, 0x7e, 0x13, 0xcf, 0xb8, 0x74,
-	0x63, 0x36, 0xc0, 0x38, 0x5b, 0x1d, 0x25, 0xb9, 0xbc, 0x0f, 0x46, 0x2e, 0x73, 0x22, 0xc7, 0xc6,
-	0x47, 0x2e, 0xc3, 0x72, 0x73, 0x70, 0x4f, 0xd3, 0x7b, 0xd2, 0xf5, 0x2e, 0x8e, 0x9d, 0x11, 0x71,
-	0xec, 0xfc, 0x51, 0xa4, 0x3c, 0xe9, 0xa2, 0x8d, 0x0f, 0x9f, 0x53, 0x41, 0xfe, 0xd9, 0x89, 0xcc,
-	0x99, 0xb4, 0xe2, 0x48, 0x65, 0x8a, 0x83, 0x28, 0xd9, 0x01, 0xb4, 0x74, 0xa6, 0x34, 0x31, 0x43,
-	0x57, 0xbe, 0x44, 0x7d, 0xc2, 0x63, 0x77, 0xc1, 0x6c, 0xf1, 0xf3, 0x70, 0xaf, 0x3e, 0xfa, 0xb0,
-	0xa6, 0x78, 0x7e, 0x24, 0xf1, 0x85, 0xb9, 0x9d, 0xc0, 0x03, 0xaf, 0xeb, 0x7d, 0xc8, 0xdb, 0xe2,
-	0xd5, 0x57, 0x7c, 0x9b, 0x40, 0x30, 0xa3, 0xc6, 0x93, 0xe2, 0x12, 0x8f, 0x17, 0xbd, 0x67, 0x62,
-	0x50, 0x54, 0xe5, 0x77, 0x30, 0xe3, 0x0b, 0x7f, 0x20, 0x8e, 0x30, 0x05, 0x79, 0x0d, 0x96, 0x15,
-	0xc1, 0xbf, 0x35, 0x7d, 0xd4, 0xdf, 0xc4, 0xc1, 0x56, 0xe0, 0x32, 0x1e, 0x38, 0x9a, 0x3e, 0xc3,
-	0x96, 0x83, 0x37, 0x5d, 0x1b, 0x5b, 0x04, 0x35, 0x31, 0xe5, 0xe1, 0x34, 0x29, 0x84, 0x8c, 0x5a,
-	0x7e, 0xbd, 0x34, 0x74, 0x37, 0x6d, 0x04, 0x45, 0x9b, 0x19, 0xbc, 0x79, 0x87, 0xab, 0xb7, 0x23,
-	0xf3, 0x92, 0x5f, 0x81, 0x5c, 0x1b, 0x0b, 0xf4, 0x2e, 0x99, 0x8e, 0x4d, 0x75, 0x22, 0xf3, 0x25,
-	0x41, 0xf0, 0x21, 0x74, 0xbb, 0x2f, 0x4a, 0x5e, 0xc5, 0x75, 0xe1, 0x01, 0x1f, 0x86, 0x05, 0xf8,
-	0xaa, 0x7e, 0x8e, 0x87, 0x31, 0xcb, 0x25, 0x0e, 0xde, 0xb6, 0xf8, 0x4a, 0x5e, 0xf5, 0x7c, 0x7b,
-	0x83, 0x1a, 0x97, 0xc4, 0x96, 0xe6, 0x8b, 0x06, 0x70, 0x85, 0x39, 0x8e, 0x2f, 0xb8, 0x64, 0x4a,
-	0xa0, 0xd9, 0xad, 0x6d, 0x15, 0x52, 0x66, 0xca, 0x71, 0xfe, 0x0b, 0x15, 0x96, 0xc0, 0xdf, 0x79,
-	0xba, 0x4b, 0x90, 0xbd, 0x81, 0x1d, 0x8b, 0xf8, 0xcc, 0x6d, 0xb8, 0x36, 0x8a, 0xef, 0x1f, 0x1c,
-	0x6a, 0xd4, 0xc5, 0xfc, 0xbe, 0xcf, 0x87, 0x7b, 0x70, 0x25, 0x56, 0xba, 0x23, 0xe9, 0xcc, 0xcd,
-	0xf0, 0xd1, 0x1e, 0x0c, 0x95, 0x48, 0x27, 0x32, 0x2f, 0xc4, 0xa1, 0x5d, 0x05, 0x8b, 0xbb, 0x4a,
-	0x25, 0xd2, 0xd9, 0xab, 0x77, 0xb1, 0xb8, 0xbb, 0x5f, 0xef, 0xc2, 0x02, 0x2a, 0x5b, 0x38, 0x14,
-	0x40, 0xfd, 0x34, 0x0b, 0x50, 0xa3, 0xe1, 0xda, 0x96, 0xed, 0x21, 0x4a, 0x8d, 0xcb, 0x62, 0x58,
-	0x5f, 0xe0, 0xf5, 0x72, 0x02, 0x4c, 0x73, 0x79, 0x27, 0x32, 0x41, 0x3c, 0xa0, 0x92, 0x30, 0xbb,
-	0xa8, 0x29, 0xa8, 0x82, 0xb7, 0xf5, 0xfe, 0x64, 0x88, 0xad, 0x86, 0xef, 0x39, 0x38, 0xb0, 0x5a,
-	0x88, 0xad, 0x1b, 0x4f, 0x89, 0x5d, 0x7f, 0xfb, 0x20, 0x32, 0x2f, 0xcc, 0xe0, 0x56, 0x80, 0x6d,
-	0xc4, 0xb0, 0x33, 0x13, 0x2b, 0xce, 0x0a, 0xbd, 0x45, 0xc4, 0xd6, 0xdb, 0x91, 0xa9, 0xbd, 0x90,
-	0x55, 0xe7, 0x4e, 0x19, 0x7e, 0xde, 0x6f, 0xba, 0x7c, 0x92, 0xd8, 0x4e, 0xcd, 0xd0, 0x60, 0x5f,
-	0x05, 0x07, 0x1b, 0xfa, 0x59, 0x8a, 0x99, 0xe5, 0xf9, 0x5b, 0x56, 0x2b, 0x70, 0xfd, 0xc0, 0x65,
-	0x3b, 0xc6, 0xd3, 0x62, 0x53, 0x4c, 0xb6, 0x23, 0xb3, 0x97, 0x62, 0x36, 0xef, 0x6f, 0x2d, 0x26,
-	0x48, 0x16, 0xd9, 0x8a, 0xe2, 0xae, 0x29, 0x46, 0xa9, 0x39, 0xf8, 0x40, 0xd3, 0x07, 0x9b, 0x68,
-	0x3b, 0x75, 0xd3, 0xf6, 0x89, 0x1d, 0x06, 0x01, 0x26, 0xf6, 0x8e, 0x31, 0x2a, 0xc6, 0x91, 0x8a,
-	0xcb, 0x16, 0xb4, 0xb5, 0x80, 0xb6, 0x63, 0x8e, 0xd3, 0xb9, 0x0a, 0x3f, 0xf2, 0x9b, 0x0a, 0x79,
-	0x76, 0xe4, 0xab, 0xc0, 0x74, 0xc8, 0xc5, 0xed, 0x88, 0xda, 0x2e, 0x54, 0x5a, 0x05, 0x9f, 0x6a,
-	0x7a, 0xbf, 0x1d, 0x20, 0xba, 0x5e, 0xaa, 0x01, 0x9e, 0x11, 0xd3, 0xf2, 0xa1, 0xa8, 0x01, 0xa6,
-	0xd3, 0x1a, 0xc0, 0x4e, 0x6a, 0x80, 0xd9, 0xf8, 0x6c, 0xe6, 0xcd, 0xf2, 0x6c, 0x5c, 0x19, 0x86,
-	0x85, 0x4e, 0x35, 0xaf, 0x17, 0x62, 0xbe, 0x96, 0xfb, 0x2a, 0x46, 0x78, 0x75, 0x60, 0x27, 0xd5,
-	0x41, 0xfd, 0x61, 0xcc, 0xf0, 0xfa, 0x60, 0x3a, 0xae, 0x0f, 0x4a, 0xc6, 0x02, 0x0f, 0xfc, 0x44,
-	0xd3, 0x87, 0xca, 0xee, 0xa5, 0xd7, 0x32, 0xcf, 0x8a, 0xf9, 0x77, 0x0f, 0x22, 0xf3, 0xe4, 0x34,
-	0x94, 0x5e, 0x14, 0x8a, 0x56, 0xca, 0x2f, 0x0a, 0x4a, 0xb4, 0xdb, 0xd2, 0xd8, 0xdd, 0xaf, 0xe7,
-	0xb6, 0xa1, 0xda, 0x32, 0xf8, 0xba, 0xa6, 0x0f, 0x52, 0x16, 0x12, 0x8b, 0x67, 0x4e, 0xc8, 0x73,
-	0x37, 0xb1, 0x15, 0xe7, 0xc3, 0xd4, 0x78, 0x2e, 0xcb, 0x47, 0xfb, 0xb9, 0xc6, 0xed, 0x54, 0x61,
-	0x89, 0xe3, 0x4b, 0x59, 0x96, 0xa4, 0xc0, 0x8a, 0xc9, 0xbc, 0x14, 0xd0, 0x8e, 0x8d, 0xdf, 0x1c,
-	0x83, 0x2a, 0x6b, 0xbc, 0x46, 0x2e, 0xd1, 0xe0, 0x71, 0x95, 0x1a, 0xcf, 0x0b, 0x12, 0xaf, 0xf1,
-	0x44, 0xad, 0xd0, 0x6c, 0xc1, 0x25, 0x79, 0x2d, 0x51, 0x41, 0xe4, 0x1c, 0xb1, 0x10, 0x50, 0x27,
-	0xc6, 0x60, 0xd5, 0x0e, 0xcf, 0xca, 0x7b, 0x44, 0xef, 0xe9, 0x43, 0xd7, 0x0b, 0x22, 0x86, 0x3a,
-	0x07, 0x91, 0xd9, 0x0b, 0xd1, 0xd6, 0x12, 0x0b, 0xa5, 0x27, 0xae, 0x53, 0x34, 0xff, 0xcc, 0x2e,
-	0xa3, 0x72, 0xd9, 0x03, 0x9f, 0xe1, 0x4a, 0x16, 0xa1, 0x6c, 0x0f, 0x6c, 0xea, 0x67, 0x78, 0xd9,
-	0xb9, 0x8a, 0x28, 0xb6, 0xe2, 0x37, 0x47, 0xe3, 0xca, 0x88, 0x36, 0xda, 0x3b, 0xd1, 0x9b, 0xa6,
-	0x45, 0xcb, 0x42, 0x2a, 0x6e, 0x0f, 0x7b, 0x53, 0xd5, 0x58, 0x96, 0x45, 0x8e, 0xa2, 0xb8, 0x36,
-	0x92, 0x14, 0x21, 0xc9, 0xf2, 0x78, 0x67, 0xbf, 0xae, 0xc1, 0x52, 0x53, 0xf0, 0xdd, 0xa3, 0xfa,
-	0x25, 0x1e, 0x35, 0xb2, 0x70, 0xc1, 0x8b, 0x58, 0xdb, 0x6f, 0xf2, 0x25, 0x1b, 0xe0, 0x37, 0x43,
-	0x4c, 0x99, 0xb5, 0xe1, 0xae, 0x1a, 0x57, 0xc5, 0x74, 0xfc, 0x59, 0x4b, 0xde, 0x2a, 0x17, 0xd0,
-	0xf6, 0xf4, 0x1c, 0x8c, 0xf1, 0xdb, 0xee, 0x54, 0x3b, 0x32, 0xcd, 0x26, 0xda, 0xce, 0xb6, 0x38,
-	0x9b, 0x4b, 0x6c, 0xe4, 0x2a, 0xd9, 0x29, 0xf8, 0x00, 0x3d, 0xa9, 0x00, 0x7c, 0xa0, 0xc9, 0x07,
-	0xab, 0x24, 0xaf, 0x9f, 0x25, 0xba, 0xf0, 0x01, 0xcd, 0x56, 0xc1, 0xe7, 0x9a, 0x3e, 0x98, 0x3d,
-	0xc1, 0x78, 0x48, 0x7e, 0xb4, 0x1d, 0x13, 0x1b, 0xf8, 0x23, 0x3e, 0x12, 0x03, 0xe9, 0x13, 0xc6,
-	0xfc, 0xe4, 0x1d, 0xf9, 0xdd, 0x76, 0x00, 0x29, 0xe4, 0x59, 0x22, 0xad, 0x02, 0x55, 0x2f, 0x67,
-	0x4a, 0x23, 0x5d, 0xe4, 0xd2, 0xd6, 0x57, 0x92, 0x82, 0x79, 0x2b, 0x24, 0x3d, 0xfa, 0x6e, 0xea,
-	0xe7, 0xc5, 0x2b, 0x4b, 0x23, 0xf4, 0xbc, 0x24, 0xab, 0xf1, 0x49, 0x5a, 0xa2, 0x1a, 0xe3, 0xc2,
-	0xd3, 0x5b, 0x3c, 0x6b, 0xe0, 0x5a, 0xb3, 0xa1, 0xe7, 0x89, 0x7c, 0xe4, 0x2e, 0x49, 0x8a, 0xca,
-	0x4e, 0x64, 0x5e, 0x4c, 0x8e, 0x2c, 0x15, 0x5c, 0x83, 0x5d, 0xda, 0x81, 0xd7, 0xf4, 0xd3, 0x0d,
-	0x8c, 0x58, 0x18, 0x60, 0xab, 0xe1, 0xa1, 0x35, 0x6a, 0x4c, 0x88, 0x7d, 0x77, 0x99, 0x9f, 0xf4,
-	0x09, 0x30, 0xcb, 0xe5, 0xd9, 0x8b, 0x8c, 0x24, 0xac, 0xc1, 0x82, 0x0a, 0xd8, 0xd2, 0x87, 0xa4,
-	0x87, 0x98, 0xb8, 0xc6, 0xc1, 0xc4, 0x0f, 0xd7, 0xd6, 0x8d, 0x6b, 0x62, 0xd1, 0xbe, 0x2c, 0xc2,
-	0x6b, 0xa6, 0x32, 0xcf, 0x35, 0x5e, 0x11, 0x0a, 0x59, 0xd6, 0xa3, 0x44, 0xb3, 0x8c, 0x42, 0xdd,
-	0x18, 0x6c, 0xe8, 0x03, 0x95, 0x8e, 0x9b, 0x68, 0xdb, 0xb8, 0x2e, 0x7a, 0x7d, 0x89, 0x27, 0x83,
-	0xa5, 0x86, 0x0b, 0x68, 0xbb, 0x13, 0x99, 0x86, 0xaa, 0xcb, 0x05, 0xb4, 0x9d, 0xf5, 0xa7, 0x68,
-	0x06, 0xde, 0x3b, 0xaa, 0x9b, 0xe9, 0xed, 0x92, 0x85, 0x3c, 0x9e, 0x52, 0xf8, 0x9e, 0x63, 0x31,
-	0x8f, 0x5a, 0x3c, 0x7e, 0xb8, 0x3e, 0xa1, 0xc6, 0x8b, 0x62, 0xbe, 0x3e, 0xe6, 0x2b, 0xf3, 0x42,
-	0x7a, 0x97, 0x33, 0xc9, 0x55, 0xef, 0x7a, 0xce, 0xf2, 0xfc, 0xd2, 0xff, 0x25, 0x7a, 0xed, 0xc8,
-	0xbc, 0xe0, 0x76, 0x87, 0xb3, 0x7c, 0xe7, 0x3e, 0x3a, 0x7c, 0x7d, 0xde, 0xd7, 0xc6, 0xfd, 0xe1,
-	0xdd, 0xfd, 0xfa, 0xfd, 0x08, 0xc2, 0x6a, 0x5b, 0x8f, 0xa6, 0x20, 0xf8, 0xb2, 0xde, 0x13, 0xb6,
-	0x48, 0x2b, 0x3b, 0x50, 0x7f, 0x31, 0x2b, 0xdc, 0xfe, 0xff, 0x83, 0xc8, 0x3c, 0x97, 0xe7, 0x72,
-	0x2b, 0x8b, 0x64, 0x31, 0x3f, 0x5d, 0x45, 0x16, 0x97, 0x24, 0xb8, 0x2d, 0xd2, 0x4a, 0x00, 0x29,
-	0x7f, 0xdb, 0xdd, 0xaf, 0xab, 0x1b, 0x1b, 0x1a, 0x3c, 0x25, 0x35, 0x01, 0x3f, 0xd3, 0x92, 0xee,
-	0xd3, 0xe7, 0x8b, 0x0f, 0x66, 0xc5, 0x74, 0xbf, 0x23, 0xe2, 0x41, 0xd1, 0x44, 0xf6, 0x94, 0x21,
-	0xba, 0x1f, 0xc9, 0xba, 0x97, 0x9f, 0x20, 0x24, 0x0e, 0x79, 0xe0, 0x3b, 0xdf, 0x5d, 0x8b, 0x6f,
-	0x70, 0x55, 0x2f, 0x86, 0x06, 0xf5, 0xbc, 0x15, 0xf8, 0x8d, 0xa6, 0xf7, 0x0a, 0x9a, 0xf9, 0x43,
-	0xc5, 0x2f, 0x63, 0xa2, 0xdf, 0x14, 0xf5, 0x41, 0xd1, 0x84, 0xf4, 0x68, 0x21, 0xa8, 0xd6, 0x32,
-	0xaa, 0xc5, 0x67, 0x06, 0x25, 0xd9, 0x8b, 0xf7, 0xd3, 0xe3, 0x55, 0x80, 0xba, 0x2f, 0x43, 0x83,
-	0x3d, 0x72, 0xcb, 0x9c, 0x72, 0xfe, 0x1c, 0xf1, 0x61, 0x77, 0xca, 0xd2, 0xd3, 0x44, 0x89, 0x72,
-	0xf1, 0x31, 0xa1, 0x3b, 0xe5, 0x6e, 0x7a, 0x55, 0xca, 0xa9, 0x66, 0x4a, 0x39, 0x7b, 0x7d, 0x68,
-	0xe8, 0xf1, 0xb3, 0x67, 0x96, 0x3e, 0xfc, 0x6a, 0x56, 0xc4, 0xb1, 0xff, 0x29, 0xf2, 0x15, 0x2f,
-	0x87, 0x79, 0x1e, 0x21, 0x2d, 0xc6, 0x20, 0x47, 0x8a, 0xc5, 0x44, 0x8f, 0x84, 0x50, 0x71, 0x79,
-	0x53, 0xbd, 0x37, 0xb1, 0x5a, 0x36, 0x33, 0x3e, 0xe2, 0x43, 0xa4, 0x4d, 0x2d, 0x1c, 0x44, 0xe6,
-	0xc5, 0xbc, 0xc7, 0x85, 0xe2, 0xad, 0xc7, 0xa2, 0xcd, 0x8a, 0xe3, 0xd4, 0xac, 0xe0, 0xc5, 0xee,
-	0x41, 0x55, 0x81, 0xe7, 0x4a, 0x03, 0xa5, 0x4c, 0x81, 0xda, 0x88, 0x50, 0xe3, 0xd7, 0xf1, 0x2c,
-	0x2d, 0x97, 0x28, 0xc8, 0x27, 0xec, 0x12, 0x57, 0x2c, 0x51, 0xa8, 0xe0, 0xd5, 0xa9, 0x12, 0x4c,
-	0x2a, 0x7a, 0x53, 0xb7, 0x3f, 0xf9, 0x6c, 0xf8, 0xc8, 0xfe, 0x67, 0xc3, 0x47, 0x3e, 0x39, 0x18,
-	0xd6, 0xf6, 0x0f, 0x86, 0xb5, 0x6f, 0xdf, 0x1b, 0x3e, 0xf2, 0xfe, 0xbd, 0x61, 0x6d, 0xff, 0xde,
-	0xf0, 0x91, 0xbf, 0xdd, 0x1b, 0x3e, 0xf2, 0xc6, 0x33, 0x6b, 0x2e, 0x5b, 0x0f, 0x57, 0xaf, 0xd8,
-	0x7e, 0xf3, 0x6a, 0x96, 0xbf, 0x4b, 0xbf, 0xf2, 0xff, 0x71, 0xad, 0x9e, 0x10, 0x7f, 0xdc, 0xba,
-	0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x3d, 0xe9, 0x4b, 0x24, 0x26, 0x00, 0x00,
+	// 3486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5b, 0x6c, 0xdc, 0xc6,
+	0xd5, 0x36, 0xed, 0xd8, 0x89, 0x29, 0x59, 0xb6, 0x46, 0x37, 0xc6, 0x76, 0x44, 0x65, 0xbd, 0x4e,
+	0x94, 0x9b, 0x2d, 0xc9, 0x8e, 0xe3, 0x18, 0xf8, 0x91, 0x5f, 0x17, 0xeb, 0x8f, 0x62, 0xdd, 0x30,
+	0x92, 0xfe, 0x14, 0x29, 0x02, 0x76, 0x96, 0x9c, 0x95, 0x58, 0x71, 0x87, 0x1b, 0x5e, 0x74, 0x49,
+	0x8a, 0x36, 0x48, 0xd0, 0xa6, 0x6f, 0x6d, 0x85, 0x5e, 0x80, 0x16, 0x28, 0x52, 0xb4, 0x05, 0x9a,
+	0x26, 0x29, 0x0a, 0x14, 0x28, 0xd0, 0xbe, 0x34, 0x28, 0x50, 0x20, 0x68, 0x1f, 0xa4, 0xc7, 0x02,
+	0x4d, 0x59, 0x44, 0xce, 0xd3, 0x3e, 0xf4, 0x61, 0x1f, 0xd5, 0x97, 0x62, 0x86, 0x1c, 0x72, 0x48,
+	0xce, 0xda, 0x7e, 0x5b, 0x9e, 0xef, 0x9c, 0x33, 0xdf, 0x19, 0xce, 0x9c, 0x39, 0x87, 0xb3, 0xea,
+	0x65, 0xc7, 0xae, 0x5d, 0x35, 0x5d, 0x52, 0xb7, 0xd7, 0xaf, 0xba, 0xcd, 0xc0, 0x76, 0x89, 0x1f,
+	0x3f, 0x85, 0x1e, 0xa2, 0x4f, 0x57, 0x9a, 0x9e, 0x1b, 0xb8, 0xe0, 0x54, 0x2c, 0x3c, 0x3f, 0x24,
+	0xa8, 0x07, 0x21, 0xb1, 0xc9, 0x7a, 0xac, 0x70, 0x7e, 0x44, 0x00, 0x2c, 0x14, 0xa0, 0x1a, 0xf2,
+	0x71, 0x0d, 0x99, 0x9b, 0x98, 0x58, 0x89, 0x46, 0x45, 0xd0, 0xa8, 0x21, 0x62, 0x6d, 0xdb, 0x56,
+	0xb0, 0xe1, 0x9b, 0x1b, 0xd8, 0x0a, 0x1d, 0x9c, 0xe8, 0x0c, 0x08, 0x3a, 0xbe, 0xfd, 0x26, 0x17,
+	0x9f, 0xc6, 0x3b, 0x41, 0xfc, 0xb3, 0xf2, 0xde, 0x92, 0xda, 0xbf, 0x14, 0xf3, 0x9c, 0x16, 0x79,
+	0x82, 0x9f, 0x2a, 0xea, 0x39, 0xc7, 0xf6, 0x03, 0x4c, 0x0c, 0x64, 0x59, 0x1e, 0xf6, 0x7d, 0xec,
+	0x6b, 0xca, 0xc8, 0x89, 0xd1, 0xd3, 0x53, 0xfe, 0x61, 0xa4, 0x03, 0x88, 0xb6, 0xe7, 0x19, 0x3c,
+	0xc9, 0xd1, 0x56, 0xa4, 0x9f, 0x75, 0xf2, 0xa2, 0x76, 0xa4, 0x5f, 0xde, 0x69, 0x38, 0xb7, 0x2a,
+	0x39, 0x79, 0x65, 0xc4, 0xc2, 0x75, 0x14, 0x3a, 0xc1, 0xad, 0x4a, 0xf2, 0xa3, 0x72, 0xb4, 0x5f,
+	0x7d, 0x38, 0xf9, 0xbd, 0x77, 0x50, 0x95, 0x38, 0x87, 0x45, 0xd7, 0xe0, 0xdf, 0x8a, 0xaa, 0xad,
+	0x3b, 0x6e, 0x0d, 0x39, 0x86, 0x65, 0xfb, 0xa6, 0xbb, 0x85, 0xbd, 0x5d, 0xc3, 0xc7, 0xde, 0x16,
+	0xf6, 0x7c, 0xed, 0x38, 0x23, 0xfa, 0x3b, 0xe5, 0x30, 0xd2, 0xfb, 0x20, 0xda, 0xfe, 0x3f, 0xa6,
+	0x37, 0x49, 0xc8, 0x4a, 0x8c, 0xb7, 0x22, 0x7d, 0x60, 0x9d, 0xcb, 0xdc, 0x90, 0x98, 0x38, 0x01,
+	0xda, 0x91, 0xfe, 0x2c, 0x23, 0x2c, 0x43, 0x25, 0xbc, 0x5b, 0xfb, 0xd5, 0x7e, 0x99, 0x6a, 0x7b,
+	0xbf, 0x2a, 0x1f, 0x20, 0x1f, 0xa8, 0x8c, 0x1b, 0x1c, 0x8c, 0x0d, 0x67, 0x78, 0x50, 0x89, 0x1c,
+	0x7c, 0x21, 0x0b, 0x18, 0x13, 0x54, 0x73, 0xb0, 0xa5, 0x9d, 0x18, 0x51, 0x46, 0x1f, 0x99, 0xfa,
+	0x80, 0x06, 0x7c, 0x2e, 0xf5, 0x78, 0x3b, 0x06, 0xcb, 0xd1, 0x26, 0x40, 0x3b, 0xd2, 0x9f, 0x96,
+	0x44, 0x9b, 0xa0, 0x42, 0xb8, 0x81, 0x17, 0x62, 0x1a, 0x6b, 0x07, 0x37, 0x9d, 0x80, 0xa3, 0xfd,
+	0xea, 0x43, 0xd4, 0x74, 0xef, 0xa0, 0x5a, 0x22, 0x55, 0x0a, 0x33, 0x91, 0x83, 0xcf, 0x14, 0x75,
+	0xc8, 0x71, 0x4d, 0x69, 0x94, 0x0f, 0xb1, 0x28, 0x7f, 0x4e, 0xa3, 0x3c, 0x3b, 0x4f, 0x75, 0x72,
+	0x41, 0xf6, 0x3b, 0x89, 0xa8, 0x10, 0xe3, 0x53, 0xf1, 0x12, 0x94, 0x80, 0x92, 0x10, 0xe5, 0x4e,
+	0x3a, 0xc8, 0x85, 0x00, 0x8b, 0x7c, 0xe0, 0x00, 0x33, 0x28, 0x85, 0xf7, 0x37, 0x45, 0xed, 0x8b,
+	0xc3, 0x43, 0x89, 0x2f, 0xa3, 0xe9, 0x7a, 0x81, 0x76, 0x72, 0x44, 0x19, 0x3d, 0x39, 0xf5, 0x63,
+	0x1a, 0x5a, 0x37, 0x77, 0xb5, 0xec, 0x7a, 0x41, 0x2b, 0xd2, 0x7b, 0x73, 0x43, 0x53, 0x61, 0x3b,
+	0xd2, 0x9f, 0x2c, 0x07, 0x45, 0x11, 0x21, 0xa2, 0x89, 0xf1, 0xb1, 0x89, 0x17, 0x2a, 0x47, 0x91,
+	0x7e, 0xc2, 0x26, 0x41, 0x6b, 0xbf, 0x2a, 0x71, 0x23, 0x13, 0x1e, 0xed, 0x57, 0x4f, 0x32, 0xd3,
+	0xbd, 0x83, 0x6a, 0x8e, 0x09, 0x2c, 0xeb, 0x82, 0x77, 0x8f, 0xab, 0x23, 0x85, 0x68, 0x1a, 0xa1,
+	0x13, 0xd8, 0x26, 0xf2, 0x03, 0x9e, 0x37, 0xb4, 0x53, 0x23, 0xca, 0xe8, 0xe9, 0xa9, 0x3f, 0xd0,
+	0xd0, 0x7a, 0xb8, 0xc3, 0x85, 0x69, 0xba, 0x93, 0x5b, 0x91, 0xde, 0x97, 0x73, 0x1a, 0x8b, 0xdb,
+	0x91, 0x7e, 0xa3, 0x1c, 0x5e, 0x8c, 0x09, 0x01, 0x7e, 0xb9, 0x5e, 0x1f, 0x9f, 0xb8, 0x75, 0xeb,
+	0xe6, 0xb5, 0x9b, 0xd7, 0x5f, 0xbf, 0x15, 0x47, 0xdb, 0xda, 0xaf, 0x4a, 0x1d, 0xca, 0xc5, 0x47,
+	0xfb, 0x55, 0x50, 0x76, 0xb2, 0x77, 0x50, 0x2d, 0xd0, 0x84, 0x8f, 0xe5, 0x8d, 0x79, 0x84, 0x49,
+	0x32, 0x02, 0x4b, 0xea, 0x99, 0x06, 0xda, 0x31, 0x7c, 0x4c, 0x2c, 0x63, 0xb3, 0xd6, 0xf4, 0xb5,
+	0x87, 0xd9, 0xcb, 0x7c, 0xa6, 0x15, 0xe9, 0x5d, 0x0d, 0xb4, 0xb3, 0x82, 0x89, 0x75, 0xa7, 0xd6,
+	0xa4, 0xc9, 0xa5, 0x97, 0x85, 0x25, 0xc8, 0xf8, 0xfb, 0x81, 0xa2, 0x22, 0x77, 0xe8, 0x61, 0x73,
+	0x2b, 0x76, 0xf8, 0x48, 0xce, 0x21, 0xc4, 0xe6, 0x56, 0xd1, 0x21, 0x97, 0xe5, 0x1c, 0x72, 0x21,
+	0xf8, 0xbd, 0xa2, 0x0e, 0x79, 0xd8, 0x74, 0x09, 0xc1, 0x26, 0x4d, 0xef, 0x86, 0x4d, 0x02, 0xec,
+	0x6d, 0x21, 0xc7, 0xf0, 0xb5, 0xd3, 0xcc, 0xf7, 0xd7, 0x59, 0x52, 0xe7, 0x2a, 0x73, 0x09, 0xbc,
+	0x42, 0x73, 0x87, 0x68, 0x98, 0x02, 0xed, 0x48, 0x1f, 0x65, 0x63, 0x4b, 0x51, 0xe1, 0x2d, 0xdd,
+	0x18, 0xe3, 0x94, 0x8e, 0xf6, 0xab, 0xc7, 0x6f, 0x8c, 0xb1, 0xfc, 0x5e, 0x1a, 0x07, 0xca, 0x47,
+	0x01, 0x75, 0xb5, 0xc7, 0xc3, 0x0e, 0xda, 0xf5, 0xd3, 0x1c, 0xa0, 0xb2, 0x1c, 0xf0, 0x52, 0x2b,
+	0xd2, 0xcf, 0xc4, 0x48, 0xb6, 0xd1, 0x2b, 0x09, 0x21, 0x41, 0x5a, 0xdc, 0xe1, 0x7c, 0xc7, 0xc2,
+	0xbc, 0x31, 0x78, 0xe7, 0xb8, 0x7a, 0x21, 0x19, 0x28, 0x25, 0x92, 0x4d, 0x52, 0x43, 0xeb, 0x62,
+	0x93, 0xf4, 0x67, 0xba, 0x86, 0x87, 0x20, 0xd5, 0x2b, 0x85, 0xb0, 0xd0, 0x8a, 0xf4, 0x21, 0x4f,
+	0x0e, 0xa5, 0x89, 0xb6, 0x03, 0x2e, 0xb0, 0x1c, 0x1f, 0x13, 0xb6, 0x6c, 0x47, 0x7f, 0x9d, 0x21,
+	0x3a, 0xc9, 0xe3, 0x74, 0x92, 0x3b, 0xd1, 0x84, 0x5a, 0x1c, 0x67, 0x19, 0x01, 0x35, 0xf5, 0x8c,
+	0x1f, 0x20, 0x2f, 0x30, 0x6a, 0x9e, 0xbb, 0xed, 0x63, 0x4f, 0xeb, 0x66, 0x73, 0xfd, 0x3f, 0xad,
+	0x48, 0xef, 0x66, 0xc0, 0x54, 0x2c, 0x6f, 0x47, 0xfa, 0xe3, 0x2c, 0x1c, 0x51, 0xd8, 0x71, 0xa6,
+	0x73, 0xa6, 0xe0, 0x97, 0x8a, 0x3a, 0x40, 0x50, 0x60, 0x04, 0x1e, 0xa2, 0xa7, 0x1a, 0x72, 0xd2,
+	0x17, 0xdb, 0xc3, 0x06, 0x7b, 0xe3, 0x30, 0xd2, 0xd5, 0xc5, 0xc9, 0xd5, 0x2c, 0xad, 0xab, 0x04,
+	0x05, 0xd9, 0x3b, 0xd6, 0xd9, 0xc0, 0x99, 0x48, 0x92, 0xc2, 0x45, 0x83, 0xdc, 0x93, 0x90, 0xae,
+	0x85, 0x21, 0x60, 0x1f, 0x41, 0xc1, 0x2a, 0xa7, 0xc3, 0x17, 0xc4, 0x1f, 0x4b, 0x3c, 0x1d, 0x8c,
+	0x7c, 0x6c, 0x34, 0xb4, 0xb3, 0x6c, 0x29, 0x7c, 0x8b, 0x2e, 0x85, 0xd3, 0x8b, 0x93, 0xab, 0xf3,
+	0x54, 0x4c, 0x5f, 0xfe, 0x59, 0x82, 0x82, 0xf8, 0xc1, 0x26, 0x61, 0xc0, 0x8a, 0x9f, 0x0a, 0x27,
+	0x2b, 0xca, 0xa5, 0x7b, 0xa3, 0xb5, 0x5f, 0x2d, 0xd9, 0x97, 0x45, 0xe9, 0x0e, 0xca, 0x06, 0x86,
+	0x40, 0x64, 0x1f, 0xcb, 0xc0, 0x5f, 0x15, 0x75, 0x28, 0x4f, 0xde, 0xc3, 0x04, 0x6f, 0xb3, 0x95,
+	0x7c, 0x8e, 0xd1, 0xdf, 0xa3, 0xf4, 0xbb, 0x16, 0x27, 0x57, 0x61, 0x0c, 0xd0, 0x00, 0x7a, 0x09,
+	0x0a, 0xf8, 0x63, 0x1a, 0x42, 0x95, 0x87, 0x90, 0x47, 0x84, 0x20, 0xae, 0x89, 0x41, 0x48, 0x7c,
+	0xc8, 0x84, 0x34, 0x90, 0x6b, 0x34, 0x10, 0x91, 0x02, 0xec, 0x17, 0x43, 0xe1, 0x52, 0x49, 0x30,
+	0x81, 0xdd, 0xc0, 0x6e, 0x18, 0x18, 0xbe, 0xd6, 0x9b, 0x0f, 0x66, 0x35, 0x06, 0x56, 0x92, 0x60,
+	0xf8, 0x23, 0x5d, 0xe9, 0x56, 0x2e, 0x98, 0x3c, 0xd2, 0x69, 0xfb, 0x49, 0x7c, 0xc8, 0x84, 0xe9,
+	0x96, 0x13, 0x29, 0xe4, 0x83, 0xe1, 0x52, 0xf0, 0x13, 0x45, 0xd5, 0x42, 0x1f, 0xad, 0x63, 0xc3,
+	0xc3, 0xf4, 0xdc, 0xb7, 0xc9, 0xba, 0x81, 0x4c, 0x13, 0x37, 0x03, 0x6c, 0x69, 0x80, 0x45, 0x83,
+	0xe8, 0x0e, 0x58, 0x83, 0x93, 0x89, 0x94, 0xee, 0x80, 0xd0, 0xe3, 0x4f, 0xed, 0x48, 0x3f, 0xc7,
+	0x82, 0xc8, 0x44, 0x02, 0x61, 0x51, 0x31, 0xf7, 0x44, 0x57, 0x7c, 0xe6, 0x12, 0x0e, 0x32, 0x0a,
+	0x90, 0x33, 0xe0, 0x72, 0xf0, 0x96, 0xda, 0x5f, 0x24, 0xe7, 0x63, 0x4c, 0xb4, 0x3e, 0x46, 0x6c,
+	0xee, 0x30, 0xd2, 0x4f, 0xad, 0xc1, 0x15, 0x8c, 0x49, 0x2b, 0xd2, 0x4f, 0x85, 0x1e, 0xfd, 0xd5,
+	0x8e, 0xf4, 0xee, 0x84, 0x10, 0x7d, 0x14, 0xc8, 0x70, 0x85, 0xf4, 0xd7, 0xde, 0x41, 0x35, 0x31,
+	0x87, 0x20, 0x4f, 0x80, 0xca, 0xc0, 0x0f, 0x14, 0xf5, 0xd1, 0xe2, 0xe8, 0x21, 0xb1, 0xdf, 0x08,
+	0xb1, 0x61, 0x5b, 0x5a, 0x3f, 0x2b, 0x22, 0x5e, 0x8b, 0xe7, 0x66, 0x8d, 0x89, 0xe7, 0x66, 0xe2,
+	0xb9, 0x49, 0x9e, 0xc4, 0xb9, 0xe1, 0x0a, 0x95, 0x78, 0x52, 0xf8, 0x63, 0x5b, 0x7c, 0x4a, 0x26,
+	0x85, 0x63, 0xc5, 0x49, 0xe1, 0x5a, 0xe0, 0x13, 0x45, 0xed, 0x2b, 0xf1, 0xf2, 0x1c, 0x6d, 0x80,
+	0x31, 0xfa, 0x0e, 0x5d, 0x7b, 0x27, 0xd7, 0xe0, 0x1a, 0x9c, 0x6f, 0x45, 0xfa, 0xc9, 0xd0, 0x5b,
+	0x83, 0xf3, 0xed, 0x48, 0xbf, 0xc9, 0x89, 0xc0, 0x79, 0x61, 0x75, 0x6d, 0x04, 0x41, 0xd3, 0xbf,
+	0x75, 0x95, 0x75, 0x74, 0x57, 0xfc, 0x5d, 0x62, 0x06, 0x1b, 0xb4, 0xe5, 0x23, 0x38, 0xb8, 0x4a,
+	0xf0, 0x36, 0x95, 0x52, 0xc2, 0x89, 0x13, 0xfe, 0xe3, 0x68, 0xbf, 0xfa, 0x00, 0x86, 0x7b, 0x07,
+	0xd5, 0x98, 0x05, 0xec, 0x2d, 0xc4, 0xe1, 0x39, 0xe0, 0x5f, 0x8a, 0xaa, 0x17, 0x43, 0x68, 0xba,
+	0x3e, 0x3d, 0xe1, 0x7c, 0x6c, 0x86, 0x1e, 0x76, 0x76, 0xb5, 0x41, 0x96, 0x7e, 0x7f, 0xc4, 0x3a,
+	0x88, 0x35, 0xb8, 0xec, 0xfa, 0xc1, 0x5c, 0x0a, 0xb6, 0x22, 0xfd, 0x5c, 0xe8, 0xe5, 0x65, 0xed,
+	0x48, 0x7f, 0x22, 0x09, 0x32, 0x0f, 0x08, 0xf1, 0xd6, 0x91, 0xe3, 0xb3, 0x94, 0x5c, 0xb6, 0x96,
+	0xc8, 0x68, 0xe5, 0xc9, 0x2c, 0x68, 0xbf, 0x50, 0xa4, 0x00, 0x2f, 0xe6, 0xc3, 0xca, 0xa3, 0xe0,
+	0x9f, 0x92, 0x08, 0x6d, 0x62, 0x07, 0x36, 0xed, 0x23, 0xe8, 0x79, 0x67, 0xf8, 0xda, 0x10, 0x5b,
+	0xc5, 0x3f, 0x64, 0xdd, 0xc3, 0x1a, 0x9c, 0x8b, 0xd1, 0x19, 0x0a, 0xd2, 0x84, 0x71, 0x36, 0xf4,
+	0x72, 0xa2, 0x34, 0x5d, 0x14, 0xe4, 0x62, 0xb2, 0xb8, 0x39, 0x96, 0x4b, 0xe0, 0x45, 0x0f, 0x65,
+	0x11, 0x3d, 0x81, 0xa8, 0x15, 0x6d, 0x18, 0x0a, 0x14, 0xe0, 0x85, 0x7c, 0x80, 0x39, 0x10, 0xb8,
+	0x6a, 0xaf, 0x87, 0xe3, 0xc3, 0xd9, 0x25, 0xc6, 0x36, 0xda, 0xc4, 0x61, 0x53, 0xd3, 0xd8, 0x2b,
+	0x9b, 0xa6, 0xe4, 0x13, 0x70, 0x89, 0xbc, 0xca, 0xa0, 0x94, 0x7c, 0x41, 0xde, 0xf1, 0x90, 0x2e,
+	0x3a, 0x00, 0xef, 0x29, 0xea, 0x10, 0x0a, 0x03, 0xd7, 0x08, 0x9b, 0xeb, 0x1e, 0xb2, 0x70, 0x56,
+	0x0c, 0x6d, 0x68, 0x8f, 0xb2, 0x89, 0x5c, 0xa6, 0x2d, 0x17, 0x55, 0x59, 0x8b, 0x35, 0x78, 0x1d,
+	0xf1, 0x72, 0xda, 0x9d, 0xc8, 0x40, 0x71, 0xfa, 0x26, 0xc4, 0xca, 0x70, 0x7c, 0x02, 0x4a, 0xbd,
+	0x81, 0x86, 0x3a, 0xc4, 0x39, 0x04, 0xae, 0xd1, 0xf4, 0xe8, 0x2b, 0x66, 0x67, 0xb1, 0xaf, 0x9d,
+	0x67, 0x13, 0x70, 0x83, 0x12, 0x49, 0x54, 0x56, 0xdd, 0x65, 0x0f, 0xc3, 0x04, 0x6f, 0x47, 0xfa,
+	0xf9, 0xf8, 0x15, 0x4a, 0xc0, 0x0a, 0x94, 0xda, 0x80, 0x2d, 0x15, 0x6c, 0x62, 0xdc, 0x34, 0x02,
+	0xdc, 0x68, 0xba, 0x1e, 0xf2, 0x6c, 0xec, 0x1b, 0x1b, 0xda, 0x05, 0x16, 0xf2, 0xcb, 0x74, 0x23,
+	0x50, 0x74, 0x35, 0x03, 0x69, 0xb8, 0x97, 0xd8, 0x28, 0x45, 0x40, 0xec, 0xc5, 0xae, 0x8b, 0xa1,
+	0x4e, 0x5c, 0x87, 0x25, 0x2f, 0x60, 0x57, 0xed, 0x33, 0x91, 0xb9, 0x81, 0x0d, 0x7b, 0x9d, 0xb8,
+	0x1e, 0xb6, 0x8c, 0xba, 0xed, 0x60, 0x5f, 0xbb, 0xc8, 0x42, 0x9c, 0xa3, 0x27, 0x1a, 0x83, 0xe7,
+	0x62, 0x74, 0x96, 0x82, 0xe9, 0x44, 0x97, 0x90, 0xd2, 0x1e, 0x4c, 0xf7, 0x16, 0x2c, 0xbb, 0x01,
+	0xdf, 0x53, 0xd4, 0xf3, 0x4d, 0xcf, 0x5d, 0xa7, 0xcd, 0x8c, 0x11, 0x36, 0x2d, 0x14, 0x60, 0xb1,
+	0x41, 0x78, 0x8c, 0xc5, 0xbe, 0x4a, 0xeb, 0x5b, 0xae, 0xb5, 0xc6, 0x94, 0xc4, 0x66, 0x20, 0x6e,
+	0xb2, 0x3b, 0xe0, 0x02, 0x9d, 0xe7, 0x85, 0x89, 0x50, 0x9e, 0x87, 0x9d, 0x3c, 0x82, 0x77, 0x14,
+	0x75, 0xd0, 0xb1, 0x1b, 0x76, 0x60, 0xa4, 0x1f, 0xb7, 0x0c, 0x9b, 0x18, 0x0e, 0x22, 0xda, 0x30,
+	0x9b, 0x92, 0x05, 0xd6, 0x3c, 0x52, 0x8d, 0x29, 0xae, 0x30, 0x47, 0xe6, 0x11, 0xc9, 0x1a, 0xfe,
+	0x32, 0x76, 0x8f, 0x69, 0x91, 0xb9, 0x02, 0x6f, 0x2b, 0x2a, 0x68, 0xd8, 0xc4, 0xd8, 0x70, 0x1b,
+	0xd8, 0xb0, 0x6c, 0x7f, 0xd3, 0xa8, 0x7b, 0x18, 0x6b, 0xfa, 0x88, 0x32, 0xda, 0x35, 0xd1, 0x7d,
+	0x25, 0xfe, 0xb2, 0x76, 0x65, 0xc5, 0x7e, 0x13, 0x4f, 0xdd, 0xfe, 0x34, 0xd2, 0x8f, 0xd1, 0x9d,
+	0xd8, 0xb0, 0xc9, 0xcb, 0x6e, 0x03, 0xcf, 0xd8, 0xfe, 0xe6, 0xac, 0x87, 0x71, 0xba, 0x3a, 0x0a,
+	0x72, 0x71, 0x1f, 0x8c, 0x5c, 0xa6, 0x44, 0x4e, 0x8c, 0x8f, 0x5c, 0x86, 0x45, 0x73, 0x70, 0x57,
+	0x51, 0xbb, 0xf9, 0x7a, 0x67, 0xc7, 0xce, 0x08, 0x3b, 0x76, 0xfe, 0xc4, 0x4a, 0x1e, 0xbe, 0x68,
+	0xe3, 0xc3, 0xa7, 0xcb, 0xcb, 0x1e, 0xdb, 0x91, 0x3e, 0xc3, 0x3b, 0x0e, 0x2e, 0x93, 0x1c, 0x44,
+	0xc9, 0x0e, 0xf0, 0x0b, 0x67, 0x4a, 0x03, 0x07, 0xe8, 0xca, 0x57, 0x7d, 0x97, 0xd0, 0xdc, 0x9d,
+	0x73, 0x9b, 0x7f, 0x3c, 0xda, 0xaf, 0x8e, 0x3e, 0xa8, 0x2b, 0x5a, 0x1f, 0x09, 0x7c, 0x61, 0xe6,
+	0xc7, 0x73, 0xc0, 0xab, 0x6a, 0x2f, 0x72, 0xb6, 0x69, 0xf7, 0x15, 0x7f, 0x4d, 0x20, 0x38, 0xf0,
+	0xb5, 0xc7, 0xd9, 0x47, 0x3c, 0xda, 0xf4, 0x9e, 0x8d, 0x41, 0xd6, 0x95, 0x2f, 0xe2, 0x80, 0x2e,
+	0xfc, 0xfe, 0x38, 0xc3, 0xe4, 0xe4, 0x15, 0x58, 0x54, 0x04, 0xff, 0x51, 0xd4, 0x51, 0x77, 0x0b,
+	0x7b, 0xdb, 0x9e, 0x1d, 0xd0, 0xc4, 0xd1, 0x70, 0x03, 0x6c, 0x58, 0x78, 0xcb, 0x36, 0xb1, 0x41,
+	0x50, 0x03, 0xfb, 0x34, 0x9d, 0x26, 0x8d, 0x90, 0x56, 0xc9, 0x3e, 0x2f, 0x0d, 0x2d, 0x71, 0x23,
+	0xc8, 0x6c, 0x66, 0xf0, 0xd6, 0x22, 0x55, 0x6f, 0x45, 0xfa, 0x25, 0xb7, 0x04, 0xd9, 0x26, 0x66,
+	0xe8, 0x12, 0x99, 0x8e, 0x5d, 0xb5, 0x23, 0xfd, 0x45, 0x46, 0xf0, 0x01, 0x74, 0x3b, 0x2f, 0x4a,
+	0xda, 0xc5, 0x75, 0xe0, 0x01, 0x1f, 0x84, 0x05, 0xf8, 0x86, 0x3a, 0x40, 0xd3, 0x98, 0x61, 0x13,
+	0x0b, 0xef, 0x18, 0x74, 0x25, 0xd7, 0x1c, 0xd7, 0xdc, 0xf4, 0xb5, 0x4b, 0x6c, 0x4b, 0xd3, 0x45,
+	0x03, 0xa8, 0xc2, 0x1c, 0xc5, 0x17, 0x6c, 0x32, 0xc5, 0xd0, 0xf4, 0xab, 0x6d, 0x19, 0x92, 0x56,
+	0xca, 0x71, 0xfd, 0x0b, 0x25, 0x9e, 0xc0, 0x3f, 0x68, 0xb9, 0x4b, 0x90, 0xb9, 0x89, 0x2d, 0x83,
+	0xb8, 0x81, 0x5d, 0xb7, 0x4d, 0x14, 0x7f, 0x7f, 0xb0, 0x7c, 0xad, 0xca, 0xde, 0xef, 0xfb, 0x74,
+	0xba, 0x07, 0xd7, 0x62, 0xa5, 0x45, 0x41, 0x67, 0x6e, 0x86, 0xce, 0xf6, 0x60, 0x28, 0x45, 0xda,
+	0x91, 0x7e, 0x21, 0x4e, 0xed, 0x32, 0x98, 0x7d, 0xab, 0x94, 0x22, 0xed, 0xfd, 0x6a, 0x07, 0x8f,
+	0x7b, 0x07, 0xd5, 0x0e, 0x2c, 0xa0, 0xd4, 0xc2, 0xf2, 0x01, 0x54, 0xcf, 0x04, 0x1e, 0xaa, 0xd7,
+	0x6d, 0xd3, 0x30, 0x1d, 0xe4, 0xfb, 0xda, 0x65, 0x36, 0xad, 0xcf, 0xd1, 0x7e, 0x39, 0x01, 0xa6,
+	0xa9, 0xbc, 0x1d, 0xe9, 0x20, 0x9e, 0x50, 0x41, 0x98, 0x7e, 0xa8, 0xc9, 0xa9, 0x82, 0xb7, 0xd4,
+	0xbe, 0x64, 0x8a, 0x8d, 0xba, 0xeb, 0x58, 0xd8, 0x33, 0x9a, 0x28, 0xd8, 0xd0, 0x9e, 0x60, 0xbb,
+	0xfe, 0xce, 0x61, 0xa4, 0x5f, 0x98, 0xc1, 0x4d, 0x0f, 0x9b, 0x28, 0xc0, 0xd6, 0x4c, 0xac, 0x38,
+	0xcb, 0xf4, 0x96, 0x51, 0xb0, 0xd1, 0x8a, 0x74, 0xe5, 0xb9, 0xb4, 0x3b, 0xb7, 0x8a, 0xf0, 0xb3,
+	0x6e, 0xc3, 0xa6, 0x2f, 0x29, 0xd8, 0xad, 0x68, 0x0a, 0xec, 0x2d, 0xe1, 0x60, 0x53, 0x3d, 0xe7,
+	0xe3, 0xc0, 0x70, 0xdc, 0x6d, 0xa3, 0xe9, 0xd9, 0xae, 0x67, 0x07, 0xbb, 0xda, 0x93, 0x6c, 0x53,
+	0x4c, 0xb6, 0x22, 0xbd, 0xc7, 0xc7, 0xc1, 0xbc, 0xbb, 0xbd, 0x9c, 0x20, 0x69, 0x66, 0xcb, 0x8b,
+	0x3b, 0x96, 0x18, 0x05, 0x73, 0xf0, 0x81, 0xa2, 0x0e, 0x36, 0xd0, 0x0e, 0x0f, 0xd3, 0x74, 0x89,
+	0x19, 0x7a, 0x1e, 0x26, 0xe6, 0xae, 0x36, 0xca, 0xe6, 0xd1, 0x67, 0x1f, 0x5b, 0xd0, 0xf6, 0x02,
+	0xda, 0x89, 0x39, 0x4e, 0x67, 0x2a, 0xf4, 0xc8, 0x6f, 0x48, 0xe4, 0xe9, 0x91, 0x2f, 0x03, 0xf9,
+	0x94, 0xb3, 0xaf, 0x23, 0x72, 0xbf, 0x50, 0xea, 0x15, 0x7c, 0xa6, 0xa8, 0x7d, 0xa6, 0x87, 0xfc,
+	0x8d, 0x42, 0x0f, 0xf0, 0x14, 0x7b, 0x2d, 0x1f, 0xb2, 0x1e, 0x60, 0x9a, 0xf7, 0x00, 0x66, 0xd2,
+	0x03, 0xcc, 0xc6, 0x67, 0x33, 0x35, 0xcb, 0xaa, 0x71, 0x69, 0x1a, 0x66, 0x3a, 0xe5, 0xba, 0x9e,
+	0x89, 0xe9, 0x5a, 0xee, 0x2d, 0x39, 0xa1, 0xdd, 0x81, 0x99, 0x74, 0x07, 0xd5, 0x07, 0x71, 0x43,
+	0xfb, 0x83, 0xe9, 0xb8, 0x3f, 0x28, 0x38, 0xf3, 0x1c, 0xf0, 0x33, 0x45, 0x1d, 0x2a, 0x86, 0xc7,
+	0x3f, 0xcb, 0x3c, 0xcd, 0xde, 0xbf, 0x7d, 0x18, 0xe9, 0xa7, 0xa7, 0xa1, 0x70, 0xa3, 0x90, 0xf7,
+	0x52, 0xbc, 0x51, 0x90, 0xa2, 0x9d, 0x96, 0xc6, 0xde, 0x41, 0x35, 0xf3, 0x0d, 0xe5, 0x9e, 0xc1,
+	0x37, 0x15, 0x75, 0xd0, 0x0f, 0x42, 0x62, 0xd0, 0xca, 0x09, 0x39, 0xf6, 0x16, 0x36, 0xe2, 0x7a,
+	0xd8, 0xd7, 0x9e, 0x49, 0xeb, 0xd1, 0x3e, 0xaa, 0x71, 0x87, 0x2b, 0xac, 0x50, 0x7c, 0x25, 0xad,
+	0x92, 0x24, 0x58, 0xbe, 0x98, 0x17, 0x12, 0xda, 0x89, 0xf1, 0x9b, 0x63, 0x50, 0xe6, 0x8d, 0xf6,
+	0xc8, 0x05, 0x1a, 0x34, 0xaf, 0xfa, 0xda, 0xb3, 0x8c, 0xc4, 0x2b, 0xb4, 0x50, 0xcb, 0x99, 0x2d,
+	0xd8, 0x24, 0xeb, 0x25, 0x4a, 0x88, 0x58, 0x23, 0xe6, 0x12, 0xea, 0xc4, 0x18, 0x2c, 0xfb, 0xa1,
+	0x55, 0x79, 0x37, 0x1b, 0x9d, 0x5f, 0x74, 0x3d, 0xc7, 0x72, 0xa8, 0x75, 0x18, 0xe9, 0x3d, 0x10,
+	0x6d, 0xaf, 0x04, 0xa1, 0x70, 0xc5, 0xd5, 0xe5, 0x67, 0x8f, 0xe9, 0xc7, 0xa8, 0x4c, 0x76, 0xdf,
+	0x6b, 0xb8, 0x82, 0x47, 0x28, 0xfa, 0x03, 0x5b, 0xea, 0x59, 0x7e, 0x2f, 0x69, 0xc4, 0x37, 0x97,
+	0xda, 0x95, 0x11, 0x65, 0xb4, 0x67, 0xa2, 0x87, 0x97, 0x45, 0xab, 0x4c, 0xca, 0xbe, 0x1e, 0xf6,
+	0x70, 0xd5, 0x58, 0x96, 0x66, 0x8e, 0xbc, 0xb8, 0x32, 0x92, 0x34, 0x21, 0xc9, 0xf2, 0x78, 0xfb,
+	0xa0, 0xaa, 0xc0, 0x82, 0x29, 0xf8, 0xfe, 0x71, 0xf5, 0x12, 0xcd, 0x1a, 0x69, 0xba, 0xa0, 0x4d,
+	0xac, 0xe9, 0x36, 0xe8, 0x92, 0xf5, 0xf0, 0x1b, 0x21, 0xf6, 0x03, 0x63, 0xd3, 0xae, 0x69, 0x57,
+	0xd9, 0xeb, 0xf8, 0x8b, 0x92, 0xdc, 0x55, 0x2e, 0xa0, 0x9d, 0xe9, 0x39, 0x18, 0xe3, 0x77, 0xec,
+	0xa9, 0x56, 0xa4, 0xeb, 0x0d, 0xb4, 0x93, 0x6e, 0xf1, 0x60, 0x2e, 0xf1, 0x91, 0xa9, 0xa4, 0xa7,
+	0xe0, 0x7d, 0xf4, 0x84, 0x06, 0xf0, 0xbe, 0x2e, 0xef, 0xaf, 0x92, 0xdc, 0x7e, 0x16, 0xe8, 0xc2,
+	0xfb, 0x98, 0xd5, 0xc0, 0x17, 0x8a, 0x3a, 0x98, 0x5e, 0xc1, 0x38, 0x48, 0xbc, 0xb4, 0x1d, 0x63,
+	0x1b, 0xf8, 0x63, 0x3a, 0x13, 0xfd, 0xfc, 0x0a, 0x63, 0x7e, 0x72, 0x51, 0xbc, 0xb7, 0xed, 0x47,
+	0x12, 0x79, 0x5a, 0x48, 0xcb, 0x40, 0xd9, 0xcd, 0x99, 0xd4, 0x49, 0x07, 0xb9, 0xb0, 0xf5, 0xa5,
+	0xa4, 0x60, 0x66, 0x85, 0x84, 0x4b, 0xdf, 0x2d, 0xf5, 0x3c, 0xbb, 0x65, 0xa9, 0x87, 0x8e, 0x93,
+	0x54, 0x35, 0x2e, 0xe1, 0x2d, 0xaa, 0x36, 0xce, 0x22, 0xbd, 0x45, 0xab, 0x06, 0xaa, 0x35, 0x1b,
+	0x3a, 0x0e, 0xab, 0x47, 0x96, 0x48, 0xd2, 0x54, 0xb6, 0x23, 0xfd, 0x62, 0x72, 0x64, 0xc9, 0xe0,
+	0x0a, 0xec, 0x60, 0x07, 0x5e, 0x51, 0xcf, 0xd4, 0x31, 0x0a, 0x42, 0x0f, 0x1b, 0x75, 0x07, 0xad,
+	0xfb, 0xda, 0x04, 0xdb, 0x77, 0x97, 0xe9, 0x49, 0x9f, 0x00, 0xb3, 0x54, 0x9e, 0xde, 0xc8, 0x08,
+	0xc2, 0x0a, 0xcc, 0xa9, 0x80, 0x6d, 0x75, 0x48, 0xb8, 0x88, 0x89, 0x7b, 0x1c, 0x4c, 0xdc, 0x70,
+	0x7d, 0x43, 0xbb, 0xc6, 0x16, 0xed, 0x4b, 0x2c, 0xbd, 0xa6, 0x2a, 0xf3, 0x54, 0xe3, 0x36, 0x53,
+	0x48, 0xab, 0x1e, 0x29, 0x9a, 0x56, 0x14, 0x72, 0x63, 0xb0, 0xa9, 0xf6, 0x97, 0x06, 0x6e, 0xa0,
+	0x1d, 0xed, 0x3a, 0x1b, 0xf5, 0x45, 0x5a, 0x0c, 0x16, 0x0c, 0x17, 0xd0, 0x4e, 0x3b, 0xd2, 0x35,
+	0xd9, 0x90, 0x0b, 0x68, 0x27, 0x1d, 0x4f, 0x62, 0x06, 0xde, 0x3b, 0xae, 0xea, 0xfc, 0xeb, 0x92,
+	0x81, 0x1c, 0x5a, 0x52, 0xb8, 0x8e, 0x65, 0x04, 0x8e, 0x6f, 0xd0, 0xfc, 0x61, 0xbb, 0xc4, 0xd7,
+	0x9e, 0x67, 0xef, 0xeb, 0x13, 0xba, 0x32, 0x2f, 0xf0, 0x6f, 0x39, 0x93, 0x54, 0x75, 0xc9, 0xb1,
+	0x56, 0xe7, 0x57, 0xfe, 0x3f, 0xd1, 0x6b, 0x45, 0xfa, 0x05, 0xbb, 0x33, 0x9c, 0xd6, 0x3b, 0xf7,
+	0xd0, 0xa1, 0xeb, 0xf3, 0x9e, 0x3e, 0xee, 0x0d, 0xef, 0x1d, 0x54, 0xef, 0x45, 0x10, 0x96, 0x6d,
+	0x1d, 0x9f, 0x83, 0xe0, 0x5d, 0x45, 0x3d, 0x97, 0xa6, 0xca, 0xe4, 0x3f, 0x1c, 0xda, 0x0d, 0x96,
+	0x2b, 0x87, 0x78, 0xae, 0x9c, 0x49, 0xf0, 0xa9, 0x18, 0x66, 0x4b, 0x20, 0xcd, 0xaf, 0x89, 0x30,
+	0x3d, 0x44, 0x0a, 0x72, 0x69, 0xda, 0x2c, 0x1a, 0x83, 0x8f, 0x14, 0x15, 0x64, 0x9d, 0x34, 0xff,
+	0x9f, 0x88, 0xf6, 0xc2, 0xc8, 0x89, 0xd1, 0xae, 0x89, 0x61, 0xce, 0x23, 0xed, 0x7f, 0x57, 0x12,
+	0x85, 0xdb, 0x24, 0xf0, 0x76, 0xa7, 0x5e, 0x4f, 0x9a, 0xdb, 0xde, 0x5a, 0x11, 0x4f, 0x27, 0xbf,
+	0x84, 0x08, 0xc5, 0x26, 0x9d, 0xfc, 0x7b, 0xe0, 0xb0, 0xec, 0x96, 0x4e, 0x5a, 0x77, 0xc3, 0x22,
+	0xd9, 0xb5, 0xdf, 0x4d, 0xb6, 0x56, 0xbe, 0x42, 0xbb, 0xde, 0x85, 0x99, 0xc5, 0x95, 0xac, 0x10,
+	0xe9, 0xa2, 0x6a, 0x59, 0xf9, 0x91, 0x5c, 0x88, 0x66, 0x32, 0xd6, 0xc2, 0x8a, 0x3a, 0xf9, 0x47,
+	0xda, 0x96, 0x0a, 0x0e, 0xa1, 0x88, 0x81, 0x0d, 0x75, 0xa0, 0x89, 0xb1, 0x67, 0xe0, 0x1d, 0x73,
+	0x03, 0x91, 0x75, 0x9c, 0xb2, 0x79, 0x91, 0xb1, 0xb9, 0x4e, 0x2b, 0x0e, 0xaa, 0x70, 0x3b, 0xc1,
+	0x33, 0x1a, 0x8f, 0xc6, 0x9f, 0x43, 0xca, 0x58, 0x05, 0xca, 0x2c, 0xc0, 0xd7, 0xd4, 0xee, 0xb0,
+	0x49, 0x9a, 0xe9, 0x00, 0xbf, 0x9a, 0x65, 0x23, 0x7c, 0xe9, 0x30, 0xd2, 0x07, 0xb2, 0x82, 0x7f,
+	0x6d, 0x99, 0x2c, 0x67, 0x91, 0xb3, 0x52, 0x3f, 0xe9, 0x82, 0x9a, 0xa4, 0x99, 0x00, 0xc2, 0xbc,
+	0xef, 0x1d, 0x54, 0xe5, 0xc6, 0x9a, 0x02, 0xbb, 0x04, 0x13, 0xf0, 0x0b, 0x25, 0x19, 0x9e, 0xdf,
+	0x71, 0x7d, 0x30, 0xcb, 0x72, 0xc2, 0xdb, 0xec, 0xd0, 0xc8, 0xbb, 0x48, 0xef, 0xbb, 0xd8, 0xf0,
+	0x23, 0xe9, 0xf0, 0xe2, 0x3d, 0x95, 0xc0, 0x21, 0x3b, 0x1d, 0xcf, 0x77, 0xd6, 0xa2, 0xa7, 0x80,
+	0x6c, 0x14, 0x4d, 0x81, 0x6a, 0x66, 0x05, 0x7e, 0xab, 0xa8, 0x3d, 0x8c, 0x66, 0x76, 0x9b, 0xf5,
+	0xeb, 0x98, 0xe8, 0xb7, 0x59, 0x13, 0x99, 0x77, 0x21, 0xdc, 0x6c, 0x31, 0xaa, 0x95, 0x94, 0x6a,
+	0xfe, 0x2e, 0x4a, 0x4a, 0xf6, 0xe2, 0xbd, 0xf4, 0x68, 0xab, 0x28, 0x1f, 0x4b, 0x53, 0x60, 0xb7,
+	0x68, 0x99, 0x51, 0xce, 0xee, 0xac, 0x3e, 0xec, 0x4c, 0x59, 0xb8, 0xbf, 0x2a, 0x50, 0xce, 0xdf,
+	0x38, 0x75, 0xa6, 0xdc, 0x49, 0xaf, 0x4c, 0x99, 0x6b, 0x72, 0xca, 0xe9, 0x15, 0x55, 0x5d, 0x8d,
+	0xef, 0xc6, 0xd3, 0x1a, 0xf3, 0xa3, 0x59, 0x76, 0xd8, 0xfd, 0x6f, 0x9e, 0x2f, 0xbb, 0x5e, 0xce,
+	0x8a, 0x4d, 0x61, 0x31, 0x7a, 0x19, 0x92, 0xef, 0x38, 0xbb, 0x05, 0xc4, 0x67, 0x5f, 0xf8, 0xca,
+	0x1f, 0xd7, 0x8c, 0xa6, 0x19, 0x68, 0x1f, 0xd3, 0x29, 0x52, 0xa6, 0x16, 0x0e, 0x23, 0xfd, 0x62,
+	0x36, 0xe2, 0x42, 0xfe, 0xd3, 0xd8, 0xb2, 0x19, 0xe4, 0xe7, 0xa9, 0x51, 0xc2, 0xf3, 0xc3, 0x83,
+	0xb2, 0x02, 0x2d, 0xa8, 0xfb, 0x0b, 0xe5, 0xa4, 0x6f, 0x22, 0xe2, 0x6b, 0xbf, 0x89, 0xdf, 0xd2,
+	0x6a, 0x81, 0x82, 0x58, 0x86, 0xad, 0x50, 0xc5, 0x02, 0x85, 0x12, 0x5e, 0x7e, 0x55, 0x8c, 0x49,
+	0x49, 0x6f, 0xea, 0xce, 0xa7, 0x9f, 0x0f, 0x1f, 0x3b, 0xf8, 0x7c, 0xf8, 0xd8, 0xa7, 0x87, 0xc3,
+	0xca, 0xc1, 0xe1, 0xb0, 0xf2, 0xdd, 0xbb, 0xc3, 0xc7, 0xde, 0xbf, 0x3b, 0xac, 0x1c, 0xdc, 0x1d,
+	0x3e, 0xf6, 0xf7, 0xbb, 0xc3, 0xc7, 0x5e, 0x7b, 0x6a, 0xdd, 0x0e, 0x36, 0xc2, 0xda, 0x15, 0xd3,
+	0x6d, 0x5c, 0x4d, 0x9b, 0x3c, 0xe1, 0x57, 0xf6, 0x67, 0xbf, 0xda, 0x29, 0xf6, 0xef, 0xbe, 0x6b,
+	0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x34, 0x0e, 0x61, 0x17, 0x8f, 0x28, 0x00, 0x00,
 }
 
 func (m *OptionsConfiguration) Marshal() (dAtA []byte, err error) {
@@ -438,6 +463,53 @@ func (m *OptionsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if m.PeerExchangeEnabled {
+		i--
+		if m.PeerExchangeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MDNSEnabled {
+		i--
+		if m.MDNSEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.BandwidthSchedule) > 0 {
+		for iNdEx := len(m.BandwidthSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BandwidthSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOptionsconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.DatabaseBackend != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.DatabaseBackend))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.InsecureAllowOldTLSVersions {
 		i--
 		if m.InsecureAllowOldTLSVersions {
@@ -1094,6 +1166,21 @@ func (m *OptionsConfiguration) ProtoSize() (n int) {
 	if m.InsecureAllowOldTLSVersions {
 		n += 3
 	}
+	if m.DatabaseBackend != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.DatabaseBackend))
+	}
+	if len(m.BandwidthSchedule) > 0 {
+		for _, e := range m.BandwidthSchedule {
+			l = e.ProtoSize()
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.MDNSEnabled {
+		n += 3
+	}
+	if m.PeerExchangeEnabled {
+		n += 3
+	}
 	if m.DeprecatedUPnPEnabled {
 		n += 4
 	}
@@ -2330,6 +2417,99 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.InsecureAllowOldTLSVersions = bool(v != 0)
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseBackend", wireType)
+			}
+			m.DatabaseBackend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseBackend |= DatabaseBackend(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandwidthSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandwidthSchedule = append(m.BandwidthSchedule, BandwidthScheduleEntry{})
+			if err := m.BandwidthSchedule[len(m.BandwidthSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MDNSEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MDNSEnabled = bool(v != 0)
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerExchangeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PeerExchangeEnabled = bool(v != 0)
 		case 9000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPEnabled", wireType)
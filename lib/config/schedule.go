@@ -0,0 +1,130 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// A period is a time of day range, on some or all days of the week, as
+// used by bandwidth schedules and sync windows. The part of a period that
+// continues past midnight belongs to the day it started on.
+type period struct {
+	days       []time.Weekday // all days when empty
+	start, end int            // minutes since midnight
+}
+
+// parsePeriod parses days given as English day names, or the first three
+// letters of them, and times given as "HH:MM". An empty start is midnight,
+// an empty end the following midnight, and an end before the start means
+// the period continues past midnight.
+func parsePeriod(days []string, start, end string) (period, error) {
+	p := period{start: 0, end: minutesPerDay}
+	var err error
+	if start != "" {
+		if p.start, err = parseClock(start); err != nil {
+			return period{}, err
+		}
+	}
+	if end != "" {
+		if p.end, err = parseClock(end); err != nil {
+			return period{}, err
+		}
+	}
+	for _, d := range days {
+		day, err := parseWeekday(d)
+		if err != nil {
+			return period{}, err
+		}
+		p.days = append(p.days, day)
+	}
+	return p, nil
+}
+
+// active returns whether the period includes the given time.
+func (p period) active(t time.Time) bool {
+	now := t.Hour()*60 + t.Minute()
+	if p.start <= p.end {
+		return now >= p.start && now < p.end && p.onDay(t.Weekday())
+	}
+	if now >= p.start {
+		return p.onDay(t.Weekday())
+	}
+	if now < p.end {
+		return p.onDay((t.Weekday() + 6) % 7)
+	}
+	return false
+}
+
+// next returns the first time at or after the given time when the period
+// is active, or the zero time if it never is.
+func (p period) next(t time.Time) time.Time {
+	if p.active(t) {
+		return t
+	}
+	if p.start == p.end {
+		return time.Time{}
+	}
+	y, m, d := t.Date()
+	for i := 0; i <= 7; i++ {
+		start := time.Date(y, m, d+i, p.start/60, p.start%60, 0, 0, t.Location())
+		if start.After(t) && p.onDay(start.Weekday()) {
+			return start
+		}
+	}
+	return time.Time{}
+}
+
+// closes returns when the period, active at the given time, ends.
+func (p period) closes(t time.Time) time.Time {
+	y, m, d := t.Date()
+	if p.start > p.end && t.Hour()*60+t.Minute() >= p.start {
+		// It continues past midnight.
+		d++
+	}
+	return time.Date(y, m, d, p.end/60, p.end%60, 0, 0, t.Location())
+}
+
+func (p period) onDay(day time.Weekday) bool {
+	if len(p.days) == 0 {
+		return true
+	}
+	for _, d := range p.days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClock parses "HH:MM" (or "24:00") into minutes since midnight.
+func parseClock(s string) (int, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	if h < 0 || m < 0 || m > 59 || h*60+m > minutesPerDay {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return h*60 + m, nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) >= 3 {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.HasPrefix(strings.ToLower(day.String()), s) {
+				return day, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid day %q", s)
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"time"
+)
+
+func (w SyncWindow) Copy() SyncWindow {
+	c := w
+	c.Days = make([]string, len(w.Days))
+	copy(c.Days, w.Days)
+	return c
+}
+
+// Active returns whether the window is open at the given time.
+func (w SyncWindow) Active(t time.Time) bool {
+	p, err := parsePeriod(w.Days, w.Start, w.End)
+	if err != nil {
+		return false
+	}
+	return p.active(t)
+}
+
+// Next returns the first time at or after the given time when the window
+// is open, or the zero time if it never is.
+func (w SyncWindow) Next(t time.Time) time.Time {
+	p, err := parsePeriod(w.Days, w.Start, w.End)
+	if err != nil {
+		return time.Time{}
+	}
+	return p.next(t)
+}
+
+// Closes returns when the window, open at the given time, closes.
+func (w SyncWindow) Closes(t time.Time) time.Time {
+	p, err := parsePeriod(w.Days, w.Start, w.End)
+	if err != nil {
+		return t
+	}
+	return p.closes(t)
+}
+
+func prepareSyncWindows(folder string, windows []SyncWindow) []SyncWindow {
+	prepared := make([]SyncWindow, 0, len(windows))
+	for _, w := range windows {
+		if _, err := parsePeriod(w.Days, w.Start, w.End); err != nil {
+			l.Warnf("Ignoring sync window %s-%s for folder %q: %v", w.Start, w.End, folder, err)
+			continue
+		}
+		prepared = append(prepared, w)
+	}
+	return prepared
+}
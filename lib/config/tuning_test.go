@@ -24,3 +24,25 @@ func TestTuningMatches(t *testing.T) {
 		t.Error("mismatch for TuningLarge")
 	}
 }
+
+func TestDatabaseBackendMatches(t *testing.T) {
+	if int(config.DatabaseBackendLevelDB) != int(backend.TypeLevelDB) {
+		t.Error("mismatch for DatabaseBackendLevelDB")
+	}
+	if int(config.DatabaseBackendBolt) != int(backend.TypeBolt) {
+		t.Error("mismatch for DatabaseBackendBolt")
+	}
+}
+
+func TestDatabaseBackendUnmarshal(t *testing.T) {
+	for _, typ := range []config.DatabaseBackend{config.DatabaseBackendLevelDB, config.DatabaseBackendBolt} {
+		var b config.DatabaseBackend
+		if err := b.UnmarshalText([]byte(typ.String())); err != nil || b != typ {
+			t.Errorf("UnmarshalText(%q) = %v, %v", typ.String(), b, err)
+		}
+	}
+	var b config.DatabaseBackend
+	if err := b.UnmarshalText([]byte("sqlite")); err == nil {
+		t.Error("unexpected nil error for unknown backend")
+	}
+}
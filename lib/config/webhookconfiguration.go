@@ -0,0 +1,73 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/util"
+)
+
+func (c WebhookConfiguration) Copy() WebhookConfiguration {
+	cp := c
+	cp.Events = append([]string(nil), c.Events...)
+	cp.Folders = append([]string(nil), c.Folders...)
+	cp.Devices = append([]string(nil), c.Devices...)
+	return cp
+}
+
+func (c *WebhookConfiguration) UnmarshalJSON(data []byte) error {
+	util.SetDefaults(c)
+	type noCustomUnmarshal WebhookConfiguration
+	ptr := (*noCustomUnmarshal)(c)
+	return json.Unmarshal(data, ptr)
+}
+
+func (c *WebhookConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	util.SetDefaults(c)
+	type noCustomUnmarshal WebhookConfiguration
+	ptr := (*noCustomUnmarshal)(c)
+	return d.DecodeElement(ptr, &start)
+}
+
+func (c *WebhookConfiguration) prepare() {
+	util.FillNilSlices(c)
+	if c.MaxRetries < 0 {
+		c.MaxRetries = 0
+	}
+	if c.TimeoutS <= 0 {
+		c.TimeoutS = 10
+	}
+}
+
+// Timeout returns the timeout for a single delivery attempt.
+func (c WebhookConfiguration) Timeout() time.Duration {
+	return time.Duration(c.TimeoutS) * time.Second
+}
+
+// PermitsFolder returns true if events for the given folder should be sent
+// to this webhook.
+func (c WebhookConfiguration) PermitsFolder(folder string) bool {
+	return len(c.Folders) == 0 || containsString(c.Folders, folder)
+}
+
+// PermitsDevice returns true if events for the given device should be sent
+// to this webhook.
+func (c WebhookConfiguration) PermitsDevice(device string) bool {
+	return len(c.Devices) == 0 || containsString(c.Devices, device)
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
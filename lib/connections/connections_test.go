@@ -14,6 +14,7 @@ import (
 	"math/rand"
 	"net"
 	"net/url"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -151,12 +152,15 @@ func TestGetDialer(t *testing.T) {
 		disabled   bool
 		deprecated bool
 	}{
-		{mustParseURI("tcp://1.2.3.4:5678"), true, false, false},   // ok
-		{mustParseURI("tcp4://1.2.3.4:5678"), true, false, false},  // ok
-		{mustParseURI("kcp://1.2.3.4:5678"), false, false, true},   // deprecated
-		{mustParseURI("relay://1.2.3.4:5678"), false, true, false}, // disabled
-		{mustParseURI("http://1.2.3.4:5678"), false, false, false}, // generally bad
-		{mustParseURI("bananas!"), false, false, false},            // wat
+		{mustParseURI("tcp://1.2.3.4:5678"), true, false, false},    // ok
+		{mustParseURI("tcp4://1.2.3.4:5678"), true, false, false},   // ok
+		{mustParseURI("kcp://1.2.3.4:5678"), false, false, true},    // deprecated
+		{mustParseURI("relay://1.2.3.4:5678"), false, true, false},  // disabled
+		{mustParseURI("ws://1.2.3.4:5678/bep"), true, false, false}, // ok
+		{mustParseURI("wss://1.2.3.4/bep"), true, false, false},     // ok
+		{mustParseURI("unix:///tmp/bep.sock"), true, false, false},  // ok
+		{mustParseURI("http://1.2.3.4:5678"), false, false, false},  // generally bad
+		{mustParseURI("bananas!"), false, false, false},             // wat
 	}
 
 	cfg := config.New(protocol.LocalDeviceID)
@@ -294,10 +298,76 @@ func TestNextDialRegistryCleanup(t *testing.T) {
 	}
 }
 
+func TestUnixSocket(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	supervisor := suture.New("main", suture.Spec{
+		PassThroughPanics: true,
+	})
+	supervisor.ServeBackground(ctx)
+
+	cert := mustGetCert(t)
+	tlsCfg := tlsutil.SecureDefaultTLS13()
+	tlsCfg.Certificates = []tls.Certificate{cert}
+	tlsCfg.ClientAuth = tls.RequestClientCert
+	tlsCfg.InsecureSkipVerify = true
+
+	cfg := config.Configuration{}
+	wcfg := config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger)
+	uri := &url.URL{Scheme: "unix", Path: filepath.Join(t.TempDir(), "bep.sock")}
+	lf, err := getListenerFactory(cfg, uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conns := make(chan internalConn, 1)
+	listenSvc := lf.New(uri, wcfg, tlsCfg, conns, nil)
+	if len(listenSvc.WANAddresses()) != 0 || len(listenSvc.LANAddresses()) != 0 {
+		t.Error("socket address should not be announced")
+	}
+	supervisor.Add(listenSvc)
+
+	df, err := getDialerFactory(cfg, uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer := df.New(cfg.Options, tlsCfg)
+
+	// The listener might not be up yet
+	clientConn, err := dialer.Dial(ctx, protocol.LocalDeviceID, uri)
+	for i := 0; i < 20 && err != nil; i++ {
+		time.Sleep(100 * time.Millisecond)
+		clientConn, err = dialer.Dial(ctx, protocol.LocalDeviceID, uri)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clientConn.Close()
+	serverConn := <-conns
+	defer serverConn.Close()
+
+	data := []byte("hello")
+	go func() {
+		_ = sendMsg(clientConn, data)
+	}()
+	if err := recvMsg(serverConn, data); err != nil {
+		t.Fatal(err)
+	}
+
+	if tr := serverConn.Transport(); tr != "unix" {
+		t.Errorf("transport %q, expected unix", tr)
+	}
+	s := &service{cfg: wcfg}
+	if !s.isLAN(serverConn.RemoteAddr()) {
+		t.Error("unix socket connections should be on the LAN")
+	}
+}
+
 func BenchmarkConnections(pb *testing.B) {
 	addrs := []string{
 		"tcp://127.0.0.1:0",
 		"quic://127.0.0.1:0",
+		"ws://127.0.0.1:0/bep",
+		"wss://127.0.0.1:0/bep",
 		"relay://127.0.0.1:22067",
 	}
 	sizes := []int{
@@ -467,7 +537,7 @@ func withConnectionPair(b *testing.B, connUri string, h func(client, server inte
 	_ = serverConn.Close()
 }
 
-func mustGetCert(b *testing.B) tls.Certificate {
+func mustGetCert(b testing.TB) tls.Certificate {
 	cert, err := tlsutil.NewCertificateInMemory("bench", 10)
 	if err != nil {
 		b.Fatal(err)
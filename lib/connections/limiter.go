@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"sync/atomic"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -19,7 +20,7 @@ import (
 )
 
 // limiter manages a read and write rate limit, reacting to config changes
-// as appropriate.
+// and bandwidth schedules as appropriate.
 type limiter struct {
 	myID                protocol.DeviceID
 	mu                  sync.Mutex
@@ -28,6 +29,13 @@ type limiter struct {
 	limitsLAN           atomicBool
 	deviceReadLimiters  map[protocol.DeviceID]*rate.Limiter
 	deviceWriteLimiters map[protocol.DeviceID]*rate.Limiter
+
+	// The current config, for re-evaluating the bandwidth schedules, and
+	// the overall limits last applied.
+	cfg      config.Configuration
+	sendKbps int
+	recvKbps int
+	profile  string
 }
 
 type waiter interface {
@@ -49,29 +57,55 @@ func newLimiter(myId protocol.DeviceID, cfg config.Wrapper) *limiter {
 		mu:                  sync.NewMutex(),
 		deviceReadLimiters:  make(map[protocol.DeviceID]*rate.Limiter),
 		deviceWriteLimiters: make(map[protocol.DeviceID]*rate.Limiter),
+		// Makes sure the initial limits are applied and logged
+		sendKbps: -1,
+		recvKbps: -1,
 	}
 
 	cfg.Subscribe(l)
-	prev := config.Configuration{Options: config.OptionsConfiguration{MaxRecvKbps: -1, MaxSendKbps: -1}}
-
-	l.CommitConfiguration(prev, cfg.RawCopy())
+	l.CommitConfiguration(config.Configuration{}, cfg.RawCopy())
 	return l
 }
 
-// This function sets limiters according to corresponding DeviceConfiguration
-func (lim *limiter) setLimitsLocked(device config.DeviceConfiguration) bool {
-	readLimiter := lim.getReadLimiterLocked(device.DeviceID)
-	writeLimiter := lim.getWriteLimiterLocked(device.DeviceID)
+// serve re-evaluates the bandwidth schedules at the start of every minute,
+// which is their granularity. Changed limits take effect on existing
+// connections.
+func (lim *limiter) serve(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		now := time.Now()
+		lim.evaluate(now)
+		timer.Reset(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+	}
+}
+
+func (lim *limiter) evaluate(now time.Time) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.processDevicesConfigurationLocked(lim.cfg, lim.cfg, now)
+	lim.setOverallLimitsLocked(lim.cfg.Options, now)
+}
+
+// This function sets the device's limiters to the given limits in KiB/s
+func (lim *limiter) setLimitsLocked(deviceID protocol.DeviceID, sendKbps, recvKbps int) bool {
+	readLimiter := lim.getReadLimiterLocked(deviceID)
+	writeLimiter := lim.getWriteLimiterLocked(deviceID)
 
 	// limiters for this device are created so we can store previous rates for logging
 	previousReadLimit := readLimiter.Limit()
 	previousWriteLimit := writeLimiter.Limit()
-	currentReadLimit := rate.Limit(device.MaxRecvKbps) * 1024
-	currentWriteLimit := rate.Limit(device.MaxSendKbps) * 1024
-	if device.MaxSendKbps <= 0 {
+	currentReadLimit := rate.Limit(recvKbps) * 1024
+	currentWriteLimit := rate.Limit(sendKbps) * 1024
+	if sendKbps <= 0 {
 		currentWriteLimit = rate.Inf
 	}
-	if device.MaxRecvKbps <= 0 {
+	if recvKbps <= 0 {
 		currentReadLimit = rate.Inf
 	}
 	// Nothing about this device has changed. Start processing next device
@@ -86,7 +120,7 @@ func (lim *limiter) setLimitsLocked(device config.DeviceConfiguration) bool {
 }
 
 // This function handles removing, adding and updating of device limiters.
-func (lim *limiter) processDevicesConfigurationLocked(from, to config.Configuration) {
+func (lim *limiter) processDevicesConfigurationLocked(from, to config.Configuration, now time.Time) {
 	seen := make(map[protocol.DeviceID]struct{})
 
 	// Mark devices which should not be removed, create new limiters if needed and assign new limiter rate
@@ -97,17 +131,18 @@ func (lim *limiter) processDevicesConfigurationLocked(from, to config.Configurat
 		}
 		seen[dev.DeviceID] = struct{}{}
 
-		if lim.setLimitsLocked(dev) {
+		sendKbps, recvKbps, profile := dev.BandwidthLimits(now)
+		if lim.setLimitsLocked(dev.DeviceID, sendKbps, recvKbps) {
 			readLimitStr := "is unlimited"
-			if dev.MaxRecvKbps > 0 {
-				readLimitStr = fmt.Sprintf("limit is %d KiB/s", dev.MaxRecvKbps)
+			if recvKbps > 0 {
+				readLimitStr = fmt.Sprintf("limit is %d KiB/s", recvKbps)
 			}
 			writeLimitStr := "is unlimited"
-			if dev.MaxSendKbps > 0 {
-				writeLimitStr = fmt.Sprintf("limit is %d KiB/s", dev.MaxSendKbps)
+			if sendKbps > 0 {
+				writeLimitStr = fmt.Sprintf("limit is %d KiB/s", sendKbps)
 			}
 
-			l.Infof("Device %s send rate %s, receive rate %s", dev.DeviceID, writeLimitStr, readLimitStr)
+			l.Infof("Device %s send rate %s, receive rate %s%s", dev.DeviceID, writeLimitStr, readLimitStr, profileStr(profile))
 		}
 	}
 
@@ -127,50 +162,79 @@ func (lim *limiter) CommitConfiguration(from, to config.Configuration) bool {
 	lim.mu.Lock()
 	defer lim.mu.Unlock()
 
+	lim.cfg = to
+	now := time.Now()
+
 	// Delete, add or update limiters for devices
-	lim.processDevicesConfigurationLocked(from, to)
+	lim.processDevicesConfigurationLocked(from, to, now)
 
-	if from.Options.MaxRecvKbps == to.Options.MaxRecvKbps &&
-		from.Options.MaxSendKbps == to.Options.MaxSendKbps &&
-		from.Options.LimitBandwidthInLan == to.Options.LimitBandwidthInLan {
-		return true
+	lanChanged := from.Options.LimitBandwidthInLan != to.Options.LimitBandwidthInLan
+	if !lim.setOverallLimitsLocked(to.Options, now) && lanChanged {
+		lim.limitsLAN.set(to.Options.LimitBandwidthInLan)
+		lim.logLANLimitsLocked(to.Options)
 	}
 
-	limited := false
+	return true
+}
+
+// setOverallLimitsLocked applies the overall limits in effect at the given
+// time, returning whether they changed.
+func (lim *limiter) setOverallLimitsLocked(opts config.OptionsConfiguration, now time.Time) bool {
+	sendKbps, recvKbps, profile := opts.BandwidthLimits(now)
+	if sendKbps < 0 {
+		sendKbps = 0
+	}
+	if recvKbps < 0 {
+		recvKbps = 0
+	}
+	if sendKbps == lim.sendKbps && recvKbps == lim.recvKbps && profile == lim.profile {
+		return false
+	}
+	lim.sendKbps, lim.recvKbps, lim.profile = sendKbps, recvKbps, profile
+
 	sendLimitStr := "is unlimited"
 	recvLimitStr := "is unlimited"
 
 	// The rate variables are in KiB/s in the config (despite the camel casing
 	// of the name). We multiply by 1024 to get bytes/s.
-	if to.Options.MaxRecvKbps <= 0 {
+	if recvKbps == 0 {
 		lim.read.SetLimit(rate.Inf)
 	} else {
-		lim.read.SetLimit(1024 * rate.Limit(to.Options.MaxRecvKbps))
-		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxRecvKbps)
-		limited = true
+		lim.read.SetLimit(1024 * rate.Limit(recvKbps))
+		recvLimitStr = fmt.Sprintf("limit is %d KiB/s", recvKbps)
 	}
 
-	if to.Options.MaxSendKbps <= 0 {
+	if sendKbps == 0 {
 		lim.write.SetLimit(rate.Inf)
 	} else {
-		lim.write.SetLimit(1024 * rate.Limit(to.Options.MaxSendKbps))
-		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", to.Options.MaxSendKbps)
-		limited = true
+		lim.write.SetLimit(1024 * rate.Limit(sendKbps))
+		sendLimitStr = fmt.Sprintf("limit is %d KiB/s", sendKbps)
 	}
 
-	lim.limitsLAN.set(to.Options.LimitBandwidthInLan)
+	lim.limitsLAN.set(opts.LimitBandwidthInLan)
 
-	l.Infof("Overall send rate %s, receive rate %s", sendLimitStr, recvLimitStr)
+	l.Infof("Overall send rate %s, receive rate %s%s", sendLimitStr, recvLimitStr, profileStr(profile))
+	lim.logLANLimitsLocked(opts)
 
-	if limited {
-		if to.Options.LimitBandwidthInLan {
-			l.Infoln("Rate limits apply to LAN connections")
-		} else {
-			l.Infoln("Rate limits do not apply to LAN connections")
-		}
+	return true
+}
+
+func (lim *limiter) logLANLimitsLocked(opts config.OptionsConfiguration) {
+	if lim.sendKbps == 0 && lim.recvKbps == 0 {
+		return
+	}
+	if opts.LimitBandwidthInLan {
+		l.Infoln("Rate limits apply to LAN connections")
+	} else {
+		l.Infoln("Rate limits do not apply to LAN connections")
 	}
+}
 
-	return true
+func profileStr(profile string) string {
+	if profile == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (bandwidth schedule %q)", profile)
 }
 
 func (lim *limiter) String() string {
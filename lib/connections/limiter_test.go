@@ -13,6 +13,7 @@ import (
 	"io"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/events"
@@ -213,6 +214,53 @@ func TestAddAndRemove(t *testing.T) {
 	checkActualAndExpected(t, actualR, actualW, expectedR, expectedW)
 }
 
+func TestLimiterSchedule(t *testing.T) {
+	wrapper, wrapperCancel := initConfig()
+	defer wrapperCancel()
+
+	mondays := []config.BandwidthScheduleEntry{{Name: "monday", Days: []string{"mon"}, MaxSendKbps: 10, MaxRecvKbps: 20}}
+	dev3Conf.BandwidthSchedule = mondays
+	waiter, _ := wrapper.Modify(func(cfg *config.Configuration) {
+		cfg.Options.MaxSendKbps = 100
+		cfg.Options.BandwidthSchedule = mondays
+		cfg.SetDevice(dev3Conf)
+	})
+	waiter.Wait()
+	lim := newLimiter(device1, wrapper)
+
+	// 2022-10-17 is a Monday
+	monday := time.Date(2022, 10, 17, 12, 0, 0, 0, time.Local)
+	tuesday := monday.Add(24 * time.Hour)
+
+	lim.evaluate(monday)
+	if l := lim.write.Limit(); l != 10*1024 {
+		t.Errorf("write limit is %v on monday", l)
+	}
+	if l := lim.read.Limit(); l != 20*1024 {
+		t.Errorf("read limit is %v on monday", l)
+	}
+	if l := lim.deviceWriteLimiters[device3].Limit(); l != 10*1024 {
+		t.Errorf("device write limit is %v on monday", l)
+	}
+	if lim.profile != "monday" {
+		t.Errorf("profile is %q on monday", lim.profile)
+	}
+
+	lim.evaluate(tuesday)
+	if l := lim.write.Limit(); l != 100*1024 {
+		t.Errorf("write limit is %v on tuesday", l)
+	}
+	if l := lim.read.Limit(); l != rate.Inf {
+		t.Errorf("read limit is %v on tuesday", l)
+	}
+	if l := lim.deviceWriteLimiters[device3].Limit(); l != rate.Inf {
+		t.Errorf("device write limit is %v on tuesday", l)
+	}
+	if lim.profile != "" {
+		t.Errorf("profile is %q on tuesday", lim.profile)
+	}
+}
+
 func TestLimitedWriterWrite(t *testing.T) {
 	// Check that the limited writer writes the correct data in the correct manner.
 
@@ -21,7 +21,10 @@ import (
 const relayPriority = 200
 
 func init() {
-	dialers["relay"] = relayDialerFactory{}
+	factory := relayDialerFactory{}
+	for _, scheme := range []string{"relay", "relay+ws", "relay+wss"} {
+		dialers[scheme] = factory
+	}
 }
 
 type relayDialer struct {
@@ -34,15 +37,16 @@ func (d *relayDialer) Dial(ctx context.Context, id protocol.DeviceID, uri *url.U
 		return internalConn{}, err
 	}
 
-	conn, err := client.JoinSession(ctx, inv)
+	conn, err := client.JoinSession(ctx, uri, inv)
 	if err != nil {
 		return internalConn{}, err
 	}
 
+	// Sessions through relays reached over WebSocket aren't TCP
+	// connections of our own.
 	err = dialer.SetTCPOptions(conn)
 	if err != nil {
-		conn.Close()
-		return internalConn{}, err
+		l.Debugln("Dial (BEP/relay): setting tcp options:", err)
 	}
 
 	err = dialer.SetTrafficClass(conn, d.trafficClass)
@@ -25,6 +25,8 @@ import (
 func init() {
 	factory := &relayListenerFactory{}
 	listeners["relay"] = factory
+	listeners["relay+ws"] = factory
+	listeners["relay+wss"] = factory
 	listeners["dynamic+http"] = factory
 	listeners["dynamic+https"] = factory
 }
@@ -74,7 +76,7 @@ func (t *relayListener) handleInvitations(ctx context.Context, clnt client.Relay
 	for {
 		select {
 		case inv := <-invitations:
-			conn, err := client.JoinSession(ctx, inv)
+			conn, err := client.JoinSession(ctx, clnt.URI(), inv)
 			if err != nil {
 				if !errors.Is(err, context.Canceled) {
 					l.Infoln("Listen (BEP/relay): joining session:", err)
@@ -32,6 +32,7 @@ import (
 	"github.com/syncthing/syncthing/lib/util"
 
 	// Registers NAT service providers
+	_ "github.com/syncthing/syncthing/lib/pcp"
 	_ "github.com/syncthing/syncthing/lib/pmp"
 	_ "github.com/syncthing/syncthing/lib/upnp"
 
@@ -148,6 +149,10 @@ type service struct {
 	dialNowDevices    map[protocol.DeviceID]struct{}
 	dialNowDevicesMut sync.Mutex
 
+	// The number of connections negotiated with each connected device.
+	wantedStreams    map[protocol.DeviceID]int
+	wantedStreamsMut sync.Mutex
+
 	listenersMut   sync.RWMutex
 	listeners      map[string]genericListener
 	listenerTokens map[string]suture.ServiceToken
@@ -175,6 +180,9 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 		dialNow:           make(chan struct{}, 1),
 		dialNowDevices:    make(map[protocol.DeviceID]struct{}),
 
+		wantedStreams:    make(map[protocol.DeviceID]int),
+		wantedStreamsMut: sync.NewMutex(),
+
 		listenersMut:   sync.NewRWMutex(),
 		listeners:      make(map[string]genericListener),
 		listenerTokens: make(map[string]suture.ServiceToken),
@@ -195,6 +203,7 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 
 	service.Add(svcutil.AsService(service.connect, fmt.Sprintf("%s/connect", service)))
 	service.Add(svcutil.AsService(service.handle, fmt.Sprintf("%s/handle", service)))
+	service.Add(svcutil.AsService(service.limiter.serve, fmt.Sprintf("%s/limiter", service)))
 	service.Add(service.natService)
 
 	svcutil.OnSupervisorDone(service.Supervisor, func() {
@@ -246,7 +255,11 @@ func (s *service) handle(ctx context.Context) error {
 		}
 
 		_ = c.SetDeadline(time.Now().Add(20 * time.Second))
-		hello, err := protocol.ExchangeHello(c, s.model.GetHello(remoteID))
+		ourHello := s.model.GetHello(remoteID)
+		if h, ok := ourHello.(*protocol.Hello); ok && c.secondary {
+			h.Secondary = true
+		}
+		hello, err := protocol.ExchangeHello(c, ourHello)
 		if err != nil {
 			if protocol.IsVersionMismatch(err) {
 				// The error will be a relatively user friendly description
@@ -283,8 +296,16 @@ func (s *service) handle(ctx context.Context) error {
 		// not a relay connection, we should drop that, and prefer this one.
 		ct, connected := s.model.Connection(remoteID)
 
-		// Lower priority is better, just like nice etc.
-		if connected && (ct.Priority() > c.priority || time.Since(ct.Statistics().StartedAt) > minConnectionReplaceAge) {
+		// Secondary connections are added to the existing connection to
+		// the device, up to the negotiated number of them.
+		secondary := c.secondary || hello.Secondary
+		if secondary {
+			if !connected || len(ct.Statistics().Streams) >= s.numWantedStreams(remoteID) {
+				l.Debugf("Dropping unwanted secondary connection to %s at %s", remoteID, c)
+				c.Close()
+				continue
+			}
+		} else if connected && (ct.Priority() > c.priority || time.Since(ct.Statistics().StartedAt) > minConnectionReplaceAge) {
 			l.Debugf("Switching connections %s (existing: %s new: %s)", remoteID, ct, c)
 		} else if connected {
 			// We should not already be connected to the other party. TODO: This
@@ -327,11 +348,24 @@ func (s *service) handle(ctx context.Context) error {
 
 		// Wrap the connection in rate limiters. The limiter itself will
 		// keep up with config changes to the rate and whether or not LAN
-		// connections are limited.
-		isLAN := s.isLAN(c.RemoteAddr())
+		// connections are limited. Incoming WebSocket connections usually
+		// come through a reverse proxy, whose address says nothing about
+		// where the device is, so they are always considered WAN.
+		isLAN := c.connType != connTypeWebsocketServer && s.isLAN(c.RemoteAddr())
 		rd, wr := s.limiter.getLimiters(remoteID, c, isLAN)
 
-		protoConn := protocol.NewConnection(remoteID, rd, wr, c, s.model, c, deviceCfg.Compression, s.cfg.FolderPasswords(remoteID))
+		if secondary {
+			if err := ct.AddStream(rd, wr, c, c); err != nil {
+				l.Debugf("Adding secondary connection to %s at %s: %v", remoteID, c, err)
+				c.Close()
+				continue
+			}
+			l.Infof("Established secondary connection to %s at %s", remoteID, c)
+			continue
+		}
+
+		algo := protocol.NegotiateCompression(deviceCfg.CompressionAlgorithm, hello)
+		protoConn := protocol.NewConnection(remoteID, rd, wr, c, s.model, c, deviceCfg.Compression, algo, s.cfg.FolderPasswords(remoteID))
 		go func() {
 			<-protoConn.Closed()
 			s.dialNowDevicesMut.Lock()
@@ -342,6 +376,14 @@ func (s *service) handle(ctx context.Context) error {
 
 		l.Infof("Established secure connection to %s at %s", remoteID, c)
 
+		wanted := deviceCfg.NumConnections
+		if hello.NumConnections < wanted {
+			wanted = hello.NumConnections
+		}
+		s.wantedStreamsMut.Lock()
+		s.wantedStreams[remoteID] = wanted
+		s.wantedStreamsMut.Unlock()
+
 		s.model.AddConnection(protoConn, hello)
 		continue
 	}
@@ -374,6 +416,9 @@ func (s *service) connect(ctx context.Context) error {
 		// Attempt to dial all devices that are unconnected or can be connection-upgraded
 		s.dialDevices(ctx, now, cfg, bestDialerPriority, nextDialAt, isInitialRampup)
 
+		// Add more connections to connected devices, if wanted
+		s.dialStreams(ctx, now, cfg, nextDialAt, isInitialRampup)
+
 		var sleep time.Duration
 		if isInitialRampup {
 			// We are in the initial rampup time, so we slowly, statically
@@ -529,6 +574,65 @@ func (s *service) dialDevices(ctx context.Context, now time.Time, cfg config.Con
 	}
 }
 
+// dialStreams dials secondary connections to connected devices that have
+// fewer connections than negotiated. Only the device with the lower device
+// ID dials them, so that the two don't race each other to the limit.
+func (s *service) dialStreams(ctx context.Context, now time.Time, cfg config.Configuration, nextDialAt nextDialRegistry, initial bool) {
+	dialSemaphore := util.NewSemaphore(dialMaxParallel)
+	dialWG := new(stdsync.WaitGroup)
+	defer dialWG.Wait()
+
+	for _, deviceCfg := range cfg.Devices {
+		if deviceCfg.DeviceID == s.myID || deviceCfg.Paused || s.myID.Compare(deviceCfg.DeviceID) > 0 {
+			continue
+		}
+		connection, connected := s.model.Connection(deviceCfg.DeviceID)
+		if !connected {
+			continue
+		}
+		missing := s.numWantedStreams(deviceCfg.DeviceID) - len(connection.Statistics().Streams)
+		if missing <= 0 {
+			continue
+		}
+
+		// Secondary connections use the same or a better kind of
+		// connection than the primary one.
+		dialTargets := s.resolveDialTargets(ctx, now, cfg, deviceCfg, nextDialAt, initial, connection.Priority()+1)
+		if len(dialTargets) == 0 {
+			continue
+		}
+
+		dialWG.Add(1)
+		go func(deviceID protocol.DeviceID) {
+			defer dialWG.Done()
+			for i := 0; i < missing; i++ {
+				conn, ok := s.dialParallel(ctx, deviceID, dialTargets, dialSemaphore)
+				if !ok {
+					return
+				}
+				conn.secondary = true
+				select {
+				case s.conns <- conn:
+				case <-ctx.Done():
+					conn.Close()
+					return
+				}
+			}
+		}(deviceCfg.DeviceID)
+	}
+}
+
+// numWantedStreams returns the number of connections negotiated with the
+// given device, at least one.
+func (s *service) numWantedStreams(deviceID protocol.DeviceID) int {
+	s.wantedStreamsMut.Lock()
+	defer s.wantedStreamsMut.Unlock()
+	if n := s.wantedStreams[deviceID]; n > 1 {
+		return n
+	}
+	return 1
+}
+
 func (s *service) resolveDialTargets(ctx context.Context, now time.Time, cfg config.Configuration, deviceCfg config.DeviceConfiguration, nextDialAt nextDialRegistry, initial bool, priorityCutoff int) []dialTarget {
 	deviceID := deviceCfg.DeviceID
 
@@ -645,8 +749,10 @@ func (s *service) isLAN(addr net.Addr) bool {
 		ip = addr.IP
 	case *net.UDPAddr:
 		ip = addr.IP
+	case *net.UnixAddr:
+		// Always another process on this host.
+		return true
 	default:
-		// From the standard library, just Unix sockets.
 		// If you invent your own, handle it.
 		return false
 	}
@@ -33,12 +33,14 @@ type tlsConn interface {
 }
 
 // internalConn is the raw TLS connection plus some metadata on where it
-// came from (type, priority).
+// came from (type, priority), and whether it was dialed as an additional
+// connection to an already connected device.
 type internalConn struct {
 	tlsConn
 	connType      connType
 	priority      int
 	establishedAt time.Time
+	secondary     bool
 }
 
 type connType int
@@ -50,6 +52,10 @@ const (
 	connTypeTCPServer
 	connTypeQUICClient
 	connTypeQUICServer
+	connTypeWebsocketClient
+	connTypeWebsocketServer
+	connTypeUnixClient
+	connTypeUnixServer
 )
 
 func (t connType) String() string {
@@ -66,6 +72,14 @@ func (t connType) String() string {
 		return "quic-client"
 	case connTypeQUICServer:
 		return "quic-server"
+	case connTypeWebsocketClient:
+		return "websocket-client"
+	case connTypeWebsocketServer:
+		return "websocket-server"
+	case connTypeUnixClient:
+		return "unix-client"
+	case connTypeUnixServer:
+		return "unix-server"
 	default:
 		return "unknown-type"
 	}
@@ -79,6 +93,10 @@ func (t connType) Transport() string {
 		return "tcp"
 	case connTypeQUICClient, connTypeQUICServer:
 		return "quic"
+	case connTypeWebsocketClient, connTypeWebsocketServer:
+		return "websocket"
+	case connTypeUnixClient, connTypeUnixServer:
+		return "unix"
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,93 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// A Unix socket is only ever to another process on the same host, which
+// beats any network connection.
+const unixPriority = 5
+
+var errNoSocketPath = errors.New("missing socket path")
+
+func init() {
+	dialers["unix"] = &unixDialerFactory{}
+}
+
+type unixDialer struct {
+	commonDialer
+}
+
+func (d *unixDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL) (internalConn, error) {
+	path, err := unixSocketPath(uri)
+	if err != nil {
+		return internalConn{}, err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(timeoutCtx, "unix", path)
+	if err != nil {
+		return internalConn{}, err
+	}
+
+	tc := tls.Client(conn, d.tlsCfg)
+	err = tlsTimedHandshake(tc)
+	if err != nil {
+		tc.Close()
+		return internalConn{}, err
+	}
+
+	return newInternalConn(tc, connTypeUnixClient, unixPriority), nil
+}
+
+type unixDialerFactory struct{}
+
+func (unixDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config) genericDialer {
+	return &unixDialer{commonDialer{
+		reconnectInterval: time.Duration(opts.ReconnectIntervalS) * time.Second,
+		tlsCfg:            tlsCfg,
+	}}
+}
+
+func (unixDialerFactory) Priority() int {
+	return unixPriority
+}
+
+func (unixDialerFactory) AlwaysWAN() bool {
+	return false
+}
+
+func (unixDialerFactory) Valid(_ config.Configuration) error {
+	// Always valid
+	return nil
+}
+
+func (unixDialerFactory) String() string {
+	return "Unix Socket Dialer"
+}
+
+// unixSocketPath returns the socket path of a unix:///path/to/socket URI.
+func unixSocketPath(uri *url.URL) (string, error) {
+	// A relative path ends up as the host, as in unix://relative/path.
+	path := uri.Host + uri.Path
+	if path == "" {
+		return "", errNoSocketPath
+	}
+	return path, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/websocket"
+)
+
+// WebSocket connections have overhead over plain TCP, and are mostly
+// useful where nothing but HTTP gets through.
+const websocketPriority = 150
+
+func init() {
+	factory := &websocketDialerFactory{}
+	for _, scheme := range []string{"ws", "wss"} {
+		dialers[scheme] = factory
+	}
+}
+
+type websocketDialer struct {
+	commonDialer
+}
+
+func (d *websocketDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL) (internalConn, error) {
+	uri = fixupPort(uri, websocketDefaultPort(uri))
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	conn, err := websocket.Dial(timeoutCtx, uri, func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dialer.DialContext(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		if err := dialer.SetTCPOptions(conn); err != nil {
+			l.Debugln("Dial (BEP/websocket): setting tcp options:", err)
+		}
+		if err := dialer.SetTrafficClass(conn, d.trafficClass); err != nil {
+			l.Debugln("Dial (BEP/websocket): setting traffic class:", err)
+		}
+		return conn, nil
+	})
+	if err != nil {
+		return internalConn{}, err
+	}
+
+	tc := tls.Client(conn, d.tlsCfg)
+	err = tlsTimedHandshake(tc)
+	if err != nil {
+		tc.Close()
+		return internalConn{}, err
+	}
+
+	return newInternalConn(tc, connTypeWebsocketClient, websocketPriority), nil
+}
+
+type websocketDialerFactory struct{}
+
+func (websocketDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config) genericDialer {
+	return &websocketDialer{commonDialer{
+		trafficClass:      opts.TrafficClass,
+		reconnectInterval: time.Duration(opts.ReconnectIntervalS) * time.Second,
+		tlsCfg:            tlsCfg,
+	}}
+}
+
+func (websocketDialerFactory) Priority() int {
+	return websocketPriority
+}
+
+func (websocketDialerFactory) AlwaysWAN() bool {
+	return false
+}
+
+func (websocketDialerFactory) Valid(_ config.Configuration) error {
+	// Always valid
+	return nil
+}
+
+func (websocketDialerFactory) String() string {
+	return "WebSocket Dialer"
+}
+
+// websocketDefaultPort returns the standard HTTP or HTTPS port, depending
+// on the scheme of the URI.
+func websocketDefaultPort(uri *url.URL) int {
+	if uri.Scheme == "wss" {
+		return 443
+	}
+	return 80
+}
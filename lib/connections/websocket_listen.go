@@ -0,0 +1,175 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/svcutil"
+	"github.com/syncthing/syncthing/lib/websocket"
+)
+
+func init() {
+	factory := &websocketListenerFactory{}
+	for _, scheme := range []string{"ws", "wss"} {
+		listeners[scheme] = factory
+	}
+}
+
+// The websocketListener serves BEP over WebSocket on the path of the URI,
+// for devices that connect through an HTTP reverse proxy. A wss:// listener
+// terminates the outer TLS layer itself, using the device certificate. As
+// the remote address is normally that of the proxy, the connections are
+// never considered to be on the LAN.
+type websocketListener struct {
+	svcutil.ServiceWithError
+	onAddressesChangedNotifier
+
+	uri     *url.URL
+	cfg     config.Wrapper
+	tlsCfg  *tls.Config
+	conns   chan internalConn
+	factory listenerFactory
+
+	laddr net.Addr
+	mut   sync.RWMutex
+}
+
+func (t *websocketListener) serve(ctx context.Context) error {
+	tcaddr, err := net.ResolveTCPAddr("tcp", t.uri.Host)
+	if err != nil {
+		l.Infoln("Listen (BEP/websocket):", err)
+		return err
+	}
+
+	listener, err := net.ListenTCP("tcp", tcaddr)
+	if err != nil {
+		l.Infoln("Listen (BEP/websocket):", err)
+		return err
+	}
+	// We might bind to :0, so use the port we've been given.
+	tcaddr = listener.Addr().(*net.TCPAddr)
+
+	var inner net.Listener = listener
+	if t.uri.Scheme == "wss" {
+		outerCfg := &tls.Config{
+			Certificates: t.tlsCfg.Certificates,
+			NextProtos:   []string{"http/1.1"},
+			MinVersion:   tls.VersionTLS12,
+		}
+		inner = tls.NewListener(listener, outerCfg)
+	}
+	path := t.uri.Path
+	if path == "" {
+		path = "/"
+	}
+	wsListener := websocket.NewListener(inner, path)
+	defer wsListener.Close()
+	go func() {
+		<-ctx.Done()
+		wsListener.Close()
+	}()
+
+	t.notifyAddressesChanged(t)
+	defer t.clearAddresses(t)
+
+	l.Infof("WebSocket listener (%v) starting", t.uri)
+	defer l.Infof("WebSocket listener (%v) shutting down", t.uri)
+
+	t.mut.Lock()
+	t.laddr = tcaddr
+	t.mut.Unlock()
+	defer func() {
+		t.mut.Lock()
+		t.laddr = nil
+		t.mut.Unlock()
+	}()
+
+	for {
+		conn, err := wsListener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				return err
+			}
+		} else if err != nil {
+			l.Warnln("Listen (BEP/websocket): Accepting connection:", err)
+			continue
+		}
+
+		l.Debugln("Listen (BEP/websocket): connect from", conn.RemoteAddr())
+
+		tc := tls.Server(conn, t.tlsCfg)
+		if err := tlsTimedHandshake(tc); err != nil {
+			l.Infoln("Listen (BEP/websocket): TLS handshake:", err)
+			tc.Close()
+			continue
+		}
+
+		t.conns <- newInternalConn(tc, connTypeWebsocketServer, websocketPriority)
+	}
+}
+
+func (t *websocketListener) URI() *url.URL {
+	return t.uri
+}
+
+func (t *websocketListener) WANAddresses() []*url.URL {
+	t.mut.RLock()
+	uri := maybeReplacePort(t.uri, t.laddr)
+	t.mut.RUnlock()
+	return []*url.URL{uri}
+}
+
+func (t *websocketListener) LANAddresses() []*url.URL {
+	t.mut.RLock()
+	uri := maybeReplacePort(t.uri, t.laddr)
+	t.mut.RUnlock()
+	addrs := []*url.URL{uri}
+	addrs = append(addrs, getURLsForAllAdaptersIfUnspecified("tcp", uri)...)
+	return addrs
+}
+
+func (t *websocketListener) String() string {
+	return t.uri.String()
+}
+
+func (t *websocketListener) Factory() listenerFactory {
+	return t.factory
+}
+
+func (t *websocketListener) NATType() string {
+	return "unknown"
+}
+
+type websocketListenerFactory struct{}
+
+func (f *websocketListenerFactory) New(uri *url.URL, cfg config.Wrapper, tlsCfg *tls.Config, conns chan internalConn, _ *nat.Service) genericListener {
+	l := &websocketListener{
+		uri:     fixupPort(uri, websocketDefaultPort(uri)),
+		cfg:     cfg,
+		tlsCfg:  tlsCfg,
+		conns:   conns,
+		factory: f,
+	}
+	l.ServiceWithError = svcutil.AsService(l.serve, l.String())
+	return l
+}
+
+func (websocketListenerFactory) Valid(_ config.Configuration) error {
+	// Always valid
+	return nil
+}
@@ -125,8 +125,31 @@ const (
 	TuningLarge
 )
 
-func Open(path string, tuning Tuning) (Backend, error) {
-	return OpenLevelDB(path, tuning)
+type Type int
+
+const (
+	// N.b. these constants must match those in lib/config.DatabaseBackend!
+	TypeLevelDB Type = iota
+	TypeBolt
+)
+
+func Open(path string, typ Type, tuning Tuning) (Backend, error) {
+	switch typ {
+	case TypeBolt:
+		return OpenBolt(path)
+	default:
+		return OpenLevelDB(path, tuning)
+	}
+}
+
+// OpenRO opens the database of the given type read only.
+func OpenRO(path string, typ Type) (Backend, error) {
+	switch typ {
+	case TypeBolt:
+		return OpenBoltRO(path)
+	default:
+		return OpenLevelDBRO(path)
+	}
 }
 
 func OpenMemory() Backend {
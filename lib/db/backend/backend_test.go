@@ -6,7 +6,10 @@
 
 package backend
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 // testBackendBehavior is the generic test suite that must be fulfilled by
 // every backend implementation. It should be called by each implementation
@@ -15,6 +18,8 @@ func testBackendBehavior(t *testing.T, open func() Backend) {
 	t.Run("WriteIsolation", func(t *testing.T) { testWriteIsolation(t, open) })
 	t.Run("DeleteNonexisten", func(t *testing.T) { testDeleteNonexistent(t, open) })
 	t.Run("IteratorClosedDB", func(t *testing.T) { testIteratorClosedDB(t, open) })
+	t.Run("Iterators", func(t *testing.T) { testIterators(t, open) })
+	t.Run("EmptyValue", func(t *testing.T) { testEmptyValue(t, open) })
 }
 
 func testWriteIsolation(t *testing.T, open func() Backend) {
@@ -74,3 +79,74 @@ func testIteratorClosedDB(t *testing.T, open func() Backend) {
 		t.Error("Next: IsClosed(err) == false:", err)
 	}
 }
+
+func testIterators(t *testing.T, open func() Backend) {
+	db := open()
+	defer db.Close()
+
+	// Enough keys to span several batches for backends that read in
+	// batches.
+	tx, _ := db.NewWriteTransaction()
+	for i := 0; i < 3000; i++ {
+		_ = tx.Put([]byte(fmt.Sprintf("a%05d", i)), []byte("a"))
+		_ = tx.Put([]byte(fmt.Sprintf("b%05d", i)), []byte("b"))
+	}
+	_ = tx.Put([]byte("c"), []byte("c"))
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	count := func(it Iterator, err error, expected string) int {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer it.Release()
+		n := 0
+		var prev []byte
+		for it.Next() {
+			if prev != nil && string(prev) >= string(it.Key()) {
+				t.Fatalf("keys out of order: %q >= %q", prev, it.Key())
+			}
+			prev = append(prev[:0], it.Key()...)
+			if string(it.Value()) != expected {
+				t.Fatalf("unexpected value %q for %q", it.Value(), it.Key())
+			}
+			n++
+		}
+		if err := it.Error(); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	it, err := db.NewPrefixIterator([]byte("b"))
+	if n := count(it, err, "b"); n != 3000 {
+		t.Errorf("prefix iterator returned %d keys, expected 3000", n)
+	}
+	it, err = db.NewRangeIterator([]byte("a01000"), []byte("a02000"))
+	if n := count(it, err, "a"); n != 1000 {
+		t.Errorf("range iterator returned %d keys, expected 1000", n)
+	}
+	it, err = db.NewPrefixIterator([]byte("d"))
+	if n := count(it, err, ""); n != 0 {
+		t.Errorf("prefix iterator returned %d keys, expected none", n)
+	}
+}
+
+func testEmptyValue(t *testing.T, open func() Backend) {
+	// An empty value is still a value
+
+	db := open()
+	defer db.Close()
+
+	if err := db.Put([]byte("a"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Get([]byte("a")); err != nil {
+		t.Error("empty value should be found:", err)
+	}
+	if _, err := db.Get([]byte("b")); !IsNotFound(err) {
+		t.Error("missing key should not be found:", err)
+	}
+}
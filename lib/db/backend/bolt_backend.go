@@ -0,0 +1,484 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"runtime"
+	"strconv"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// The single bucket holding all our keys. Bolt keeps keys sorted
+	// within a bucket, so this gives us the same flat, ordered key space
+	// as leveldb.
+	boltBucket = "syncthing"
+
+	// Iterators outside of read transactions read this many entries per
+	// bolt transaction, instead of keeping one open while the caller does
+	// its thing.
+	boltIteratorBatch = 1024
+
+	// How long to wait for the lock on the database file.
+	boltOpenTimeout = 5 * time.Second
+)
+
+// Bolt can't grow its memory map while there are read transactions open,
+// so a writer that needs to grow it waits for all readers. Our callers
+// commonly write while holding a read transaction, which would deadlock.
+// We avoid remapping entirely by mapping a large region of address space
+// up front; it's only backed by the file as the database grows. This needs
+// a 64 bit address space, and doesn't work on Windows where bolt grows the
+// file to the size of the map.
+const boltMmapSize = 1 << 40
+
+var errBoltUnsupported = errors.New("the bolt database backend requires a 64 bit, non-Windows platform")
+
+func boltSupported() bool {
+	return strconv.IntSize == 64 && runtime.GOOS != "windows"
+}
+
+// boltBackend implements Backend on top of a bbolt database. Read
+// transactions are bolt read transactions, i.e. snapshots. Write
+// transactions batch up writes like the leveldb ones do, but read the
+// latest committed data rather than a snapshot.
+type boltBackend struct {
+	bdb       *bolt.DB
+	closeWG   *closeWaitGroup
+	location  string
+	temporary bool
+}
+
+// OpenBolt opens, or creates, the bolt database at the given location.
+func OpenBolt(location string) (Backend, error) {
+	return openBolt(location, &bolt.Options{
+		Timeout:         boltOpenTimeout,
+		InitialMmapSize: boltMmapSize,
+	})
+}
+
+// OpenBoltRO opens the bolt database at the given location, read only.
+func OpenBoltRO(location string) (Backend, error) {
+	return openBolt(location, &bolt.Options{
+		Timeout:  boltOpenTimeout,
+		ReadOnly: true,
+	})
+}
+
+// OpenBoltMemory returns a new Backend referencing a bolt database in a
+// temporary file, which is removed when the database is closed. Bolt has no
+// in-memory mode.
+func OpenBoltMemory() Backend {
+	fd, err := os.CreateTemp("", "syncthing-bolt-")
+	if err != nil {
+		panic(err)
+	}
+	fd.Close()
+	b, err := openBolt(fd.Name(), &bolt.Options{
+		Timeout:         boltOpenTimeout,
+		InitialMmapSize: boltMmapSize,
+	})
+	if err != nil {
+		panic(err)
+	}
+	b.location = ""
+	b.temporary = true
+	return b
+}
+
+func openBolt(location string, opts *bolt.Options) (*boltBackend, error) {
+	if !boltSupported() {
+		return nil, errBoltUnsupported
+	}
+	bdb, err := bolt.Open(location, 0600, opts)
+	if err != nil {
+		return nil, wrapBoltErr(err)
+	}
+	if !opts.ReadOnly {
+		err = bdb.Update(func(tx *bolt.Tx) error {
+			_, err := tx.CreateBucketIfNotExists([]byte(boltBucket))
+			return err
+		})
+		if err != nil {
+			bdb.Close()
+			return nil, wrapBoltErr(err)
+		}
+	}
+	return &boltBackend{
+		bdb:      bdb,
+		closeWG:  &closeWaitGroup{},
+		location: location,
+	}, nil
+}
+
+func (b *boltBackend) NewReadTransaction() (ReadTransaction, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := b.bdb.Begin(false)
+	if err != nil {
+		rel.Release()
+		return nil, wrapBoltErr(err)
+	}
+	return &boltSnapshot{
+		tx:  tx,
+		rel: rel,
+	}, nil
+}
+
+func (b *boltBackend) NewWriteTransaction(hooks ...CommitHook) (WriteTransaction, error) {
+	rel, err := newReleaser(b.closeWG)
+	if err != nil {
+		return nil, err
+	}
+	return &boltTransaction{
+		b:           b,
+		rel:         rel,
+		commitHooks: hooks,
+	}, nil
+}
+
+func (b *boltBackend) Close() error {
+	b.closeWG.CloseWait()
+	err := wrapBoltErr(b.bdb.Close())
+	if b.temporary {
+		os.Remove(b.bdb.Path())
+	}
+	return err
+}
+
+func (b *boltBackend) Get(key []byte) ([]byte, error) {
+	var val []byte
+	err := b.bdb.View(func(tx *bolt.Tx) error {
+		var err error
+		val, err = boltGet(tx, key)
+		return err
+	})
+	return val, wrapBoltErr(err)
+}
+
+func (b *boltBackend) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	return newBoltPrefixIterator(b.bdb.View, prefix), nil
+}
+
+func (b *boltBackend) NewRangeIterator(first, last []byte) (Iterator, error) {
+	return newBoltRangeIterator(b.bdb.View, first, last), nil
+}
+
+func (b *boltBackend) Put(key, val []byte) error {
+	return b.update([]boltOp{{key: key, val: val}})
+}
+
+func (b *boltBackend) Delete(key []byte) error {
+	return b.update([]boltOp{{key: key, delete: true}})
+}
+
+func (b *boltBackend) update(ops []boltOp) error {
+	err := b.bdb.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(boltBucket))
+		for _, op := range ops {
+			var err error
+			if op.delete {
+				err = bkt.Delete(op.key)
+			} else {
+				err = bkt.Put(op.key, op.val)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return wrapBoltErr(err)
+}
+
+// Compact is a no-op; bolt reuses freed pages and does not need
+// compaction in the leveldb sense.
+func (b *boltBackend) Compact() error {
+	return nil
+}
+
+func (b *boltBackend) Location() string {
+	return b.location
+}
+
+// boltSnapshot implements backend.ReadTransaction on top of a bolt read
+// transaction.
+type boltSnapshot struct {
+	tx  *bolt.Tx
+	rel *releaser
+}
+
+func (s *boltSnapshot) Get(key []byte) ([]byte, error) {
+	return boltGet(s.tx, key)
+}
+
+func (s *boltSnapshot) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	return newBoltPrefixIterator(s.view, prefix), nil
+}
+
+func (s *boltSnapshot) NewRangeIterator(first, last []byte) (Iterator, error) {
+	return newBoltRangeIterator(s.view, first, last), nil
+}
+
+func (s *boltSnapshot) view(fn func(*bolt.Tx) error) error {
+	return fn(s.tx)
+}
+
+func (s *boltSnapshot) Release() {
+	// Rollback is the only way to end a read transaction, and it's fine to
+	// call it several times.
+	_ = s.tx.Rollback()
+	s.rel.Release()
+}
+
+type boltOp struct {
+	key    []byte
+	val    []byte
+	delete bool
+}
+
+// boltTransaction implements backend.WriteTransaction by batching up
+// writes and applying them in a single bolt transaction when flushed. As
+// with leveldb, reads in the transaction don't see the pending writes.
+type boltTransaction struct {
+	b           *boltBackend
+	rel         *releaser
+	ops         []boltOp
+	size        int
+	commitHooks []CommitHook
+	inFlush     bool
+}
+
+func (t *boltTransaction) Get(key []byte) ([]byte, error) {
+	return t.b.Get(key)
+}
+
+func (t *boltTransaction) NewPrefixIterator(prefix []byte) (Iterator, error) {
+	return t.b.NewPrefixIterator(prefix)
+}
+
+func (t *boltTransaction) NewRangeIterator(first, last []byte) (Iterator, error) {
+	return t.b.NewRangeIterator(first, last)
+}
+
+func (t *boltTransaction) Put(key, val []byte) error {
+	// The caller may reuse the slices after we return.
+	t.ops = append(t.ops, boltOp{
+		key: append([]byte(nil), key...),
+		val: append([]byte(nil), val...),
+	})
+	t.size += len(key) + len(val)
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *boltTransaction) Delete(key []byte) error {
+	t.ops = append(t.ops, boltOp{
+		key:    append([]byte(nil), key...),
+		delete: true,
+	})
+	t.size += len(key)
+	return t.checkFlush(dbFlushBatchMax)
+}
+
+func (t *boltTransaction) Checkpoint() error {
+	return t.checkFlush(dbFlushBatchMin)
+}
+
+func (t *boltTransaction) Commit() error {
+	err := t.flush()
+	t.rel.Release()
+	return err
+}
+
+func (t *boltTransaction) Release() {
+	t.ops = nil
+	t.rel.Release()
+}
+
+// checkFlush flushes and resets the batch if its size exceeds the given size.
+func (t *boltTransaction) checkFlush(size int) error {
+	// Hooks might put values in the database, which triggers a checkFlush which might trigger a flush,
+	// which might trigger the hooks.
+	// Don't recurse...
+	if t.inFlush || t.size < size {
+		return nil
+	}
+	return t.flush()
+}
+
+func (t *boltTransaction) flush() error {
+	t.inFlush = true
+	defer func() { t.inFlush = false }()
+
+	for _, hook := range t.commitHooks {
+		if err := hook(t); err != nil {
+			return err
+		}
+	}
+	if len(t.ops) == 0 {
+		return nil
+	}
+	if err := t.b.update(t.ops); err != nil {
+		return err
+	}
+	t.ops = t.ops[:0]
+	t.size = 0
+	return nil
+}
+
+// boltGet returns a copy of the value for the given key, as bolt values are
+// only valid for the life of the transaction.
+func boltGet(tx *bolt.Tx, key []byte) ([]byte, error) {
+	bkt := tx.Bucket([]byte(boltBucket))
+	if bkt == nil {
+		return nil, errNotFound
+	}
+	// Bucket.Get can't tell a missing key from an empty value.
+	k, v := bkt.Cursor().Seek(key)
+	if k == nil || !bytes.Equal(k, key) {
+		return nil, errNotFound
+	}
+	return append([]byte(nil), v...), nil
+}
+
+// boltIterator iterates over a prefix or a range of keys, reading them in
+// batches through the given view function. When that's a bolt read
+// transaction the result is a consistent snapshot; otherwise each batch
+// is read in its own short lived transaction.
+type boltIterator struct {
+	view   func(func(*bolt.Tx) error) error
+	seek   []byte // next key to read from, inclusive
+	prefix []byte // set for prefix iterators
+	limit  []byte // set for range iterators, exclusive
+	keys   [][]byte
+	vals   [][]byte
+	idx    int
+	done   bool
+	err    error
+}
+
+func newBoltPrefixIterator(view func(func(*bolt.Tx) error) error, prefix []byte) *boltIterator {
+	return &boltIterator{
+		view:   view,
+		seek:   prefix,
+		prefix: prefix,
+		idx:    -1,
+	}
+}
+
+func newBoltRangeIterator(view func(func(*bolt.Tx) error) error, first, last []byte) *boltIterator {
+	return &boltIterator{
+		view:  view,
+		seek:  first,
+		limit: last,
+		idx:   -1,
+	}
+}
+
+func (it *boltIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.idx++
+	if it.idx < len(it.keys) {
+		return true
+	}
+	if it.done {
+		return false
+	}
+	if err := it.fill(); err != nil {
+		it.err = err
+		return false
+	}
+	it.idx = 0
+	return len(it.keys) > 0
+}
+
+func (it *boltIterator) fill() error {
+	it.keys = it.keys[:0]
+	it.vals = it.vals[:0]
+	err := it.view(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(boltBucket))
+		if bkt == nil {
+			it.done = true
+			return nil
+		}
+		c := bkt.Cursor()
+		var k, v []byte
+		if it.seek == nil {
+			k, v = c.First()
+		} else {
+			k, v = c.Seek(it.seek)
+		}
+		for ; k != nil; k, v = c.Next() {
+			if !it.inRange(k) {
+				it.done = true
+				return nil
+			}
+			if len(it.keys) == boltIteratorBatch {
+				// Continue from here in the next batch.
+				it.seek = append([]byte(nil), k...)
+				return nil
+			}
+			it.keys = append(it.keys, append([]byte(nil), k...))
+			it.vals = append(it.vals, append([]byte(nil), v...))
+		}
+		it.done = true
+		return nil
+	})
+	return wrapBoltErr(err)
+}
+
+func (it *boltIterator) inRange(key []byte) bool {
+	if it.prefix != nil && !bytes.HasPrefix(key, it.prefix) {
+		return false
+	}
+	if it.limit != nil && bytes.Compare(key, it.limit) >= 0 {
+		return false
+	}
+	return true
+}
+
+func (it *boltIterator) Key() []byte {
+	if it.idx < 0 || it.idx >= len(it.keys) {
+		return nil
+	}
+	return it.keys[it.idx]
+}
+
+func (it *boltIterator) Value() []byte {
+	if it.idx < 0 || it.idx >= len(it.vals) {
+		return nil
+	}
+	return it.vals[it.idx]
+}
+
+func (it *boltIterator) Error() error {
+	return it.err
+}
+
+func (it *boltIterator) Release() {
+	it.keys = nil
+	it.vals = nil
+	it.done = true
+}
+
+// wrapBoltErr wraps errors so that the backend package can recognize them
+func wrapBoltErr(err error) error {
+	switch {
+	case errors.Is(err, bolt.ErrDatabaseNotOpen), errors.Is(err, bolt.ErrTxClosed):
+		return errClosed
+	}
+	return err
+}
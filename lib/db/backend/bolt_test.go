@@ -0,0 +1,16 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package backend
+
+import "testing"
+
+func TestBoltBackendBehavior(t *testing.T) {
+	if !boltSupported() {
+		t.Skip(errBoltUnsupported)
+	}
+	testBackendBehavior(t, OpenBoltMemory)
+}
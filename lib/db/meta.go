@@ -24,6 +24,7 @@ var errMetaInconsistent = errors.New("inconsistent counts detected")
 type countsMap struct {
 	counts  CountsSet
 	indexes map[metaKey]int // device ID + local flags -> index in counts
+	gen     uint64          // incremented on every change
 }
 
 // metadataTracker keeps metadata on a per device, per local flag basis.
@@ -183,6 +184,7 @@ func (m *metadataTracker) addFile(dev protocol.DeviceID, f protocol.FileIntf) {
 
 func (m *metadataTracker) updateFileLocked(dev protocol.DeviceID, f protocol.FileIntf, fn func(protocol.DeviceID, uint32, protocol.FileIntf)) {
 	m.dirty = true
+	m.gen++
 
 	if f.IsInvalid() && (f.FileLocalFlags() == 0 || dev == protocol.GlobalDeviceID) {
 		// This is a remote invalid file or concern the global state.
@@ -207,6 +209,7 @@ func (m *metadataTracker) emptyNeeded(dev protocol.DeviceID) {
 	defer m.mut.Unlock()
 
 	m.dirty = true
+	m.gen++
 
 	empty := Counts{
 		DeviceID:   dev[:],
@@ -227,6 +230,7 @@ func (m *metadataTracker) addNeeded(dev protocol.DeviceID, f protocol.FileIntf)
 	defer m.mut.Unlock()
 
 	m.dirty = true
+	m.gen++
 
 	m.addFileLocked(dev, needFlag, f)
 }
@@ -247,19 +251,22 @@ func (m *metadataTracker) updateSeqLocked(dev protocol.DeviceID, f protocol.File
 }
 
 func (m *metadataTracker) addFileLocked(dev protocol.DeviceID, flag uint32, f protocol.FileIntf) {
-	cp := m.countsPtr(dev, flag)
+	m.countsPtr(dev, flag).addFile(f)
+}
 
+// addFile adds a file to the counts
+func (c *Counts) addFile(f protocol.FileIntf) {
 	switch {
 	case f.IsDeleted():
-		cp.Deleted++
+		c.Deleted++
 	case f.IsDirectory() && !f.IsSymlink():
-		cp.Directories++
+		c.Directories++
 	case f.IsSymlink():
-		cp.Symlinks++
+		c.Symlinks++
 	default:
-		cp.Files++
+		c.Files++
 	}
-	cp.Bytes += f.FileSize()
+	c.Bytes += f.FileSize()
 }
 
 // removeFile removes a file from the counts
@@ -276,6 +283,7 @@ func (m *metadataTracker) removeNeeded(dev protocol.DeviceID, f protocol.FileInt
 	defer m.mut.Unlock()
 
 	m.dirty = true
+	m.gen++
 
 	m.removeFileLocked(dev, needFlag, f)
 }
@@ -324,6 +332,7 @@ func (m *metadataTracker) removeFileLocked(dev protocol.DeviceID, flag uint32, f
 func (m *metadataTracker) resetAll(dev protocol.DeviceID) {
 	m.mut.Lock()
 	m.dirty = true
+	m.gen++
 	for i, c := range m.counts.Counts {
 		if bytes.Equal(c.DeviceID, dev[:]) {
 			if c.LocalFlags != needFlag {
@@ -344,6 +353,7 @@ func (m *metadataTracker) resetAll(dev protocol.DeviceID) {
 func (m *metadataTracker) resetCounts(dev protocol.DeviceID) {
 	m.mut.Lock()
 	m.dirty = true
+	m.gen++
 
 	for i, c := range m.counts.Counts {
 		if bytes.Equal(c.DeviceID, dev[:]) {
@@ -387,6 +397,7 @@ func (m *metadataTracker) Snapshot() *countsMap {
 			Created: m.counts.Created,
 		},
 		indexes: make(map[metaKey]int, len(m.indexes)),
+		gen:     m.gen,
 	}
 	for k, v := range m.indexes {
 		c.indexes[k] = v
@@ -445,6 +456,7 @@ func (m *metadataTracker) SetCreated() {
 	m.mut.Lock()
 	m.counts.Created = time.Now().UnixNano()
 	m.dirty = true
+	m.gen++
 	m.mut.Unlock()
 }
 
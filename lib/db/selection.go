@@ -0,0 +1,123 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// A PathSelection is the set of subtrees of a folder that a device has
+// subscribed to with selective sync. The empty selection means the whole
+// folder.
+type PathSelection []string
+
+// NewPathSelection returns the selection of the given paths, which are
+// slash separated and relative to the folder root. Paths within other
+// selected paths are redundant and dropped.
+func NewPathSelection(paths []string) PathSelection {
+	var sel PathSelection
+	for _, p := range paths {
+		p = path.Clean("/" + filepath.ToSlash(p))[1:]
+		if p == "" {
+			// The root, i.e. everything
+			return nil
+		}
+		sel = append(sel, osutil.NormalizedFilename(p))
+	}
+	sort.Strings(sel)
+
+	// After sorting, any path within another one comes after it.
+	res := sel[:0]
+outer:
+	for _, p := range sel {
+		for _, dir := range res {
+			if isWithin(p, dir) {
+				continue outer
+			}
+		}
+		res = append(res, p)
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+// IsEmpty returns whether the selection is the whole folder.
+func (s PathSelection) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Contains returns whether the file with the given name, using either
+// separator, is part of the selection, i.e. within one of the selected
+// paths or a parent directory of one.
+func (s PathSelection) Contains(name string) bool {
+	if len(s) == 0 {
+		return true
+	}
+	name = osutil.NormalizedFilename(filepath.ToSlash(name))
+	for _, p := range s {
+		if isWithin(name, p) || strings.HasPrefix(p, name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isWithin returns whether name is dir or within it.
+func isWithin(name, dir string) bool {
+	return name == dir || strings.HasPrefix(name, dir+"/")
+}
+
+func (s PathSelection) key() string {
+	return strings.Join(s, "\x00")
+}
+
+// selectionSizes caches the counts of the files in selections, which take
+// an index walk to compute, for a given generation of the folder metadata.
+type selectionSizes struct {
+	mut   sync.Mutex
+	gen   uint64
+	sizes map[selectionSizeKey]Counts
+}
+
+type selectionSizeKey struct {
+	device protocol.DeviceID // or GlobalDeviceID for the global size
+	sel    string
+}
+
+func (c *selectionSizes) get(key selectionSizeKey, gen uint64) (Counts, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if gen != c.gen {
+		return Counts{}, false
+	}
+	counts, ok := c.sizes[key]
+	return counts, ok
+}
+
+func (c *selectionSizes) put(key selectionSizeKey, gen uint64, counts Counts) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if gen < c.gen {
+		// Computed from an older snapshot.
+		return
+	}
+	if gen > c.gen || c.sizes == nil {
+		// Everything we have is outdated.
+		c.gen = gen
+		c.sizes = make(map[selectionSizeKey]Counts)
+	}
+	c.sizes[key] = counts
+}
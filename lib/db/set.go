@@ -14,6 +14,7 @@ package db
 
 import (
 	"fmt"
+	"path"
 
 	"github.com/syncthing/syncthing/lib/db/backend"
 	"github.com/syncthing/syncthing/lib/fs"
@@ -23,9 +24,10 @@ import (
 )
 
 type FileSet struct {
-	folder string
-	db     *Lowlevel
-	meta   *metadataTracker
+	folder         string
+	db             *Lowlevel
+	meta           *metadataTracker
+	selectionSizes *selectionSizes
 
 	updateMutex sync.Mutex // protects database updates and the corresponding metadata changes
 }
@@ -51,6 +53,9 @@ func NewFileSet(folder string, db *Lowlevel) (*FileSet, error) {
 		db:          db,
 		meta:        meta,
 		updateMutex: sync.NewMutex(),
+		selectionSizes: &selectionSizes{
+			mut: sync.NewMutex(),
+		},
 	}
 	if id := s.IndexID(protocol.LocalDeviceID); id == 0 {
 		// No index ID set yet. We create one now.
@@ -159,10 +164,11 @@ func (s *FileSet) RemoveLocalItems(items []string) {
 }
 
 type Snapshot struct {
-	folder     string
-	t          readOnlyTransaction
-	meta       *countsMap
-	fatalError func(error, string)
+	folder         string
+	t              readOnlyTransaction
+	meta           *countsMap
+	selectionSizes *selectionSizes
+	fatalError     func(error, string)
 }
 
 func (s *FileSet) Snapshot() (*Snapshot, error) {
@@ -174,9 +180,10 @@ func (s *FileSet) Snapshot() (*Snapshot, error) {
 		return nil, err
 	}
 	return &Snapshot{
-		folder: s.folder,
-		t:      t,
-		meta:   s.meta.Snapshot(),
+		folder:         s.folder,
+		t:              t,
+		meta:           s.meta.Snapshot(),
+		selectionSizes: s.selectionSizes,
 		fatalError: func(err error, opStr string) {
 			fatalError(err, opStr, s.db)
 		},
@@ -263,6 +270,16 @@ func (s *Snapshot) WithPrefixedGlobalTruncated(prefix string, fn Iterator) {
 	}
 }
 
+// WithGlobalChildrenTruncated iterates over the global files directly
+// within the given directory, the empty name being the folder root.
+func (s *Snapshot) WithGlobalChildrenTruncated(dir string, fn Iterator) {
+	opStr := fmt.Sprintf(`%s WithGlobalChildrenTruncated("%v")`, s.folder, dir)
+	l.Debugf(opStr)
+	if err := s.t.withGlobalChildren([]byte(s.folder), []byte(osutil.NormalizedFilename(dir)), true, nativeFileIterator(fn)); err != nil && !backend.IsClosed(err) {
+		s.fatalError(err, opStr)
+	}
+}
+
 func (s *Snapshot) Get(device protocol.DeviceID, file string) (protocol.FileInfo, bool) {
 	opStr := fmt.Sprintf("%s Get(%v)", s.folder, file)
 	l.Debugf(opStr)
@@ -368,6 +385,62 @@ func (s *Snapshot) NeedSize(device protocol.DeviceID) Counts {
 	return s.meta.Counts(device, needFlag)
 }
 
+// GlobalSizeIn is like GlobalSize, but only counts the files in the
+// selection. The result is cached until the folder changes.
+func (s *Snapshot) GlobalSizeIn(sel PathSelection) Counts {
+	if sel.IsEmpty() {
+		return s.GlobalSize()
+	}
+	key := selectionSizeKey{device: protocol.GlobalDeviceID, sel: sel.key()}
+	if counts, ok := s.selectionSizes.get(key, s.meta.gen); ok {
+		return counts
+	}
+	var counts Counts
+	add := func(f protocol.FileIntf) bool {
+		if !f.IsInvalid() {
+			counts.addFile(f)
+		}
+		return true
+	}
+	parents := make(map[string]struct{})
+	for _, p := range sel {
+		for dir := path.Dir(p); dir != "."; dir = path.Dir(dir) {
+			if _, ok := parents[dir]; ok {
+				break
+			}
+			parents[dir] = struct{}{}
+			if f, ok := s.GetGlobalTruncated(dir); ok {
+				add(f)
+			}
+		}
+		s.WithPrefixedGlobalTruncated(p, add)
+	}
+	s.selectionSizes.put(key, s.meta.gen, counts)
+	return counts
+}
+
+// NeedSizeIn is like NeedSize, but only counts the files in the selection.
+// Unlike NeedSize it iterates over the needed files, so the result is
+// cached until the folder changes.
+func (s *Snapshot) NeedSizeIn(device protocol.DeviceID, sel PathSelection) Counts {
+	if sel.IsEmpty() {
+		return s.NeedSize(device)
+	}
+	key := selectionSizeKey{device: device, sel: sel.key()}
+	if counts, ok := s.selectionSizes.get(key, s.meta.gen); ok {
+		return counts
+	}
+	var counts Counts
+	s.WithNeedTruncated(device, func(f protocol.FileIntf) bool {
+		if sel.Contains(f.FileName()) {
+			counts.addFile(f)
+		}
+		return true
+	})
+	s.selectionSizes.put(key, s.meta.gen, counts)
+	return counts
+}
+
 func (s *Snapshot) WithBlocksHash(hash []byte, fn Iterator) {
 	opStr := fmt.Sprintf(`%s WithBlocksHash("%x")`, s.folder, hash)
 	l.Debugf(opStr)
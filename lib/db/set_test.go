@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"testing"
 	"time"
@@ -829,7 +830,7 @@ func BenchmarkUpdateOneFile(b *testing.B) {
 		protocol.FileInfo{Name: "zajksdhaskjdh/askjdhaskjdashkajshd/kasjdhaskjdhaskdjhaskdjash/dkjashdaksjdhaskdjahskdjh", Version: protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1000}}}, Blocks: genBlocks(8)},
 	}
 
-	be, err := backend.Open("testdata/benchmarkupdate.db", backend.TuningAuto)
+	be, err := backend.Open("testdata/benchmarkupdate.db", backend.TypeLevelDB, backend.TuningAuto)
 	if err != nil {
 		b.Fatal(err)
 	}
@@ -1099,6 +1100,49 @@ func TestIssue4925(t *testing.T) {
 	}
 }
 
+func TestWithGlobalChildren(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	folder := "test"
+	s := newFileSet(t, folder, ldb)
+
+	localHave := fileList{
+		protocol.FileInfo{Name: "a"},
+		protocol.FileInfo{Name: "dir"},
+		protocol.FileInfo{Name: "dir-a"},
+		protocol.FileInfo{Name: "dir/file"},
+		protocol.FileInfo{Name: "dir/sub"},
+		protocol.FileInfo{Name: "dir/sub-a"},
+		protocol.FileInfo{Name: "dir/sub/file"},
+		protocol.FileInfo{Name: "dir/sub/sub/file"},
+		protocol.FileInfo{Name: "dir/z"},
+		protocol.FileInfo{Name: "dir0"},
+	}
+
+	replace(s, protocol.LocalDeviceID, localHave)
+
+	for dir, expected := range map[string][]string{
+		"":     {"a", "dir", "dir-a", "dir0"},
+		"dir":  {"dir/file", "dir/sub", "dir/sub-a", "dir/z"},
+		"dir/": {"dir/file", "dir/sub", "dir/sub-a", "dir/z"},
+		// Only the contents of dir/sub/sub are in the index.
+		"dir/sub": {"dir/sub/file"},
+		"a":       nil,
+	} {
+		var names []string
+		snap := snapshot(t, s)
+		snap.WithGlobalChildrenTruncated(dir, func(fi protocol.FileIntf) bool {
+			names = append(names, fi.FileName())
+			return true
+		})
+		snap.Release()
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("Children of %q are %v, expected %v", dir, names, expected)
+		}
+	}
+}
+
 func TestMoveGlobalBack(t *testing.T) {
 	ldb := newLowlevelMemory(t)
 	defer ldb.Close()
@@ -1902,3 +1946,85 @@ func snapshot(t testing.TB, fset *db.FileSet) *db.Snapshot {
 	}
 	return snap
 }
+
+func TestPathSelection(t *testing.T) {
+	sel := db.NewPathSelection([]string{"b/c/", "a", "a-b", "a/x", "./d/../b/c"})
+	if fmt.Sprint(sel) != "[a a-b b/c]" {
+		t.Errorf("unexpected selection %v", sel)
+	}
+	for name, expected := range map[string]bool{
+		"a":       true,
+		"a/x/y":   true,
+		"b":       true,
+		"b/c/e":   true,
+		"b/d":     false,
+		"ab":      false,
+		"b/cd":    false,
+		"unknown": false,
+	} {
+		if sel.Contains(name) != expected {
+			t.Errorf("Contains(%q) != %v", name, expected)
+		}
+	}
+	if name := filepath.Join("b", "c", "e"); !sel.Contains(name) {
+		t.Errorf("Contains(%q) != true", name)
+	}
+	if !db.NewPathSelection([]string{"a", "/"}).IsEmpty() {
+		t.Error("selecting the root should select everything")
+	}
+}
+
+func TestSizesInSelection(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	s := newFileSet(t, "test", ldb)
+
+	version := protocol.Vector{Counters: []protocol.Counter{{ID: myID, Value: 1000}}}
+	remote0Have := fileList{
+		protocol.FileInfo{Name: "a", Type: protocol.FileInfoTypeDirectory, Version: version},
+		protocol.FileInfo{Name: "a/f1", Version: version, Blocks: genBlocks(1)},
+		protocol.FileInfo{Name: "b", Type: protocol.FileInfoTypeDirectory, Version: version},
+		protocol.FileInfo{Name: "b/c", Type: protocol.FileInfoTypeDirectory, Version: version},
+		protocol.FileInfo{Name: "b/c/f2", Version: version, Blocks: genBlocks(2)},
+		protocol.FileInfo{Name: "b/d", Version: version, Blocks: genBlocks(3)},
+	}
+	replace(s, remoteDevice0, remote0Have)
+
+	snap := snapshot(t, s)
+	defer snap.Release()
+
+	if c := snap.GlobalSizeIn(nil); !sameCounts(c, snap.GlobalSize()) {
+		t.Errorf("empty selection global size %v != %v", c, snap.GlobalSize())
+	}
+	if c := snap.NeedSizeIn(protocol.LocalDeviceID, nil); !sameCounts(c, snap.NeedSize(protocol.LocalDeviceID)) {
+		t.Errorf("empty selection need size %v != %v", c, snap.NeedSize(protocol.LocalDeviceID))
+	}
+
+	sel := db.NewPathSelection([]string{"b/c"})
+	expected := db.Counts{Files: 1, Directories: 2, Bytes: remote0Have[2].FileSize() + remote0Have[3].FileSize() + remote0Have[4].FileSize()}
+	if c := snap.GlobalSizeIn(sel); !sameCounts(c, expected) {
+		t.Errorf("global size %v != %v", c, expected)
+	}
+	if c := snap.NeedSizeIn(protocol.LocalDeviceID, sel); !sameCounts(c, expected) {
+		t.Errorf("need size %v != %v", c, expected)
+	}
+	if c := snap.NeedSizeIn(remoteDevice0, sel); !sameCounts(c, db.Counts{}) {
+		t.Errorf("remote need size %v, expected zero", c)
+	}
+
+	// The cached sizes must not survive a change to the folder.
+	s.Update(protocol.LocalDeviceID, remote0Have[2:5])
+	snap2 := snapshot(t, s)
+	defer snap2.Release()
+	if c := snap2.NeedSizeIn(protocol.LocalDeviceID, sel); !sameCounts(c, db.Counts{}) {
+		t.Errorf("need size after update %v, expected zero", c)
+	}
+	if c := snap.NeedSizeIn(protocol.LocalDeviceID, sel); !sameCounts(c, expected) {
+		t.Errorf("need size in old snapshot %v != %v", c, expected)
+	}
+}
+
+func sameCounts(a, b db.Counts) bool {
+	return a.Files == b.Files && a.Directories == b.Directories && a.Symlinks == b.Symlinks && a.Deleted == b.Deleted && a.Bytes == b.Bytes
+}
@@ -156,6 +156,7 @@ func (f FileInfoTruncated) copyToFileInfo() protocol.FileInfo {
 		Sequence:      f.Sequence,
 		SymlinkTarget: f.SymlinkTarget,
 		BlocksHash:    f.BlocksHash,
+		Platform:      f.Platform,
 		Type:          f.Type,
 		Permissions:   f.Permissions,
 		ModifiedNs:    f.ModifiedNs,
@@ -118,6 +118,7 @@ type FileInfoTruncated struct {
 	SymlinkTarget string                `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
 	BlocksHash    []byte                `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
 	Encrypted     []byte                `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
+	Platform      protocol.PlatformData `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
 	Type          protocol.FileInfoType `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
 	Permissions   uint32                `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
 	ModifiedNs    int                   `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
@@ -495,100 +496,101 @@ func init() {
 func init() { proto.RegisterFile("lib/db/structs.proto", fileDescriptor_5465d80e8cba02e3) }
 
 var fileDescriptor_5465d80e8cba02e3 = []byte{
-	// 1476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x36, 0x2d, 0xf9, 0x43, 0x2b, 0xf9, 0x8b, 0x79, 0x6d, 0xe8, 0xf5, 0xfb, 0x56, 0xab, 0x6e,
-	0x1c, 0x40, 0xfd, 0x80, 0x0c, 0x38, 0x88, 0x51, 0x04, 0x68, 0x83, 0x30, 0xae, 0x13, 0x07, 0x69,
-	0x52, 0xac, 0x83, 0xa4, 0x68, 0x0f, 0x82, 0x48, 0xae, 0x65, 0x22, 0x14, 0xa9, 0x72, 0x69, 0x3b,
-	0xca, 0xad, 0x97, 0x02, 0xbd, 0x05, 0x41, 0x0f, 0x45, 0x51, 0x14, 0x39, 0xf5, 0x27, 0xf4, 0x17,
-	0x14, 0x45, 0x8e, 0x3e, 0x16, 0x3d, 0xb0, 0x88, 0x7d, 0x69, 0x75, 0xd4, 0xa9, 0xe8, 0xa9, 0xd8,
-	0xd9, 0xe5, 0x92, 0xb2, 0x91, 0x22, 0x49, 0x7d, 0xd3, 0x3c, 0xf3, 0xcc, 0x88, 0x9c, 0x7d, 0x66,
-	0x76, 0x88, 0xfe, 0xe3, 0x7b, 0xf6, 0xaa, 0x6b, 0xaf, 0xf2, 0x38, 0xda, 0x73, 0x62, 0xde, 0xec,
-	0x45, 0x61, 0x1c, 0x9a, 0xe3, 0xae, 0xbd, 0x7c, 0x3e, 0x62, 0xbd, 0x90, 0xaf, 0x02, 0x60, 0xef,
-	0xed, 0xac, 0x76, 0xc2, 0x4e, 0x08, 0x06, 0xfc, 0x92, 0xc4, 0x65, 0xdc, 0x09, 0xc3, 0x8e, 0xcf,
-	0x32, 0x56, 0xec, 0x75, 0x19, 0x8f, 0xdb, 0xdd, 0x9e, 0x22, 0x2c, 0x89, 0xfc, 0xf0, 0xd3, 0x09,
-	0xfd, 0x55, 0x9b, 0xa5, 0x78, 0x89, 0x3d, 0x8c, 0xe5, 0x4f, 0xf2, 0xfd, 0x38, 0x2a, 0x6f, 0x7a,
-	0x3e, 0xbb, 0xc7, 0x22, 0xee, 0x85, 0x81, 0x79, 0x0b, 0x4d, 0xed, 0xcb, 0x9f, 0x55, 0xa3, 0x6e,
-	0x34, 0xca, 0x6b, 0xf3, 0xcd, 0x34, 0x41, 0xf3, 0x1e, 0x73, 0xe2, 0x30, 0xb2, 0xea, 0xcf, 0x12,
-	0x3c, 0x36, 0x48, 0x70, 0x4a, 0x1c, 0x26, 0x78, 0xe6, 0x61, 0xd7, 0xbf, 0x4c, 0x94, 0x4d, 0x68,
-	0xea, 0x31, 0xd7, 0xd1, 0x94, 0xcb, 0x7c, 0x16, 0x33, 0xb7, 0x3a, 0x5e, 0x37, 0x1a, 0xd3, 0xd6,
-	0xff, 0x45, 0x9c, 0x82, 0x74, 0x9c, 0xb2, 0x09, 0x4d, 0x3d, 0xe6, 0x25, 0x11, 0xb7, 0xef, 0x39,
-	0x8c, 0x57, 0x0b, 0xf5, 0x42, 0xa3, 0x62, 0xfd, 0x4f, 0xc6, 0x01, 0x34, 0x4c, 0x70, 0x45, 0xc5,
-	0x09, 0x1b, 0xc2, 0xc0, 0x61, 0x52, 0x34, 0xe7, 0x05, 0xfb, 0x6d, 0xdf, 0x73, 0x5b, 0x69, 0x78,
-	0x11, 0xc2, 0xdf, 0x1a, 0x24, 0x78, 0x56, 0xb9, 0x36, 0x74, 0x96, 0x73, 0x90, 0x65, 0x04, 0x26,
-	0xf4, 0x04, 0x8d, 0x7c, 0x61, 0xa0, 0xb2, 0x2a, 0xce, 0x2d, 0x8f, 0xc7, 0xa6, 0x8f, 0xa6, 0xd5,
-	0xdb, 0xf1, 0xaa, 0x51, 0x2f, 0x34, 0xca, 0x6b, 0x73, 0x4d, 0xd7, 0x6e, 0xe6, 0x6a, 0x68, 0x5d,
-	0x11, 0x05, 0x3a, 0x4a, 0x70, 0x99, 0xb6, 0x0f, 0x14, 0xc6, 0x07, 0x09, 0xd6, 0x71, 0xa7, 0x0a,
-	0xf6, 0xe4, 0x70, 0x25, 0xcf, 0xa5, 0x9a, 0x79, 0xb9, 0xf8, 0xcd, 0x53, 0x3c, 0x46, 0xfe, 0x44,
-	0x68, 0x41, 0xfc, 0xc1, 0x56, 0xb0, 0x13, 0xde, 0x8d, 0xf6, 0x02, 0xa7, 0x2d, 0x8a, 0xf4, 0x36,
-	0x2a, 0x06, 0xed, 0x2e, 0x83, 0x73, 0x2a, 0x59, 0x4b, 0x83, 0x04, 0x83, 0x3d, 0x4c, 0x30, 0x82,
-	0xec, 0xc2, 0x20, 0x14, 0x30, 0xc1, 0xe5, 0xde, 0x23, 0x56, 0x2d, 0xd4, 0x8d, 0x46, 0x41, 0x72,
-	0x85, 0xad, 0xb9, 0xc2, 0x20, 0x14, 0x30, 0xf3, 0x0a, 0x42, 0xdd, 0xd0, 0xf5, 0x76, 0x3c, 0xe6,
-	0xb6, 0x78, 0x75, 0x02, 0x22, 0xea, 0x83, 0x04, 0x97, 0x52, 0x74, 0x7b, 0x98, 0xe0, 0x39, 0x08,
-	0xd3, 0x08, 0xa1, 0x99, 0xd7, 0xfc, 0xd1, 0x40, 0x65, 0x9d, 0xc1, 0xee, 0x57, 0x2b, 0x75, 0xa3,
-	0x51, 0xb4, 0xbe, 0x36, 0x44, 0x59, 0x7e, 0x4d, 0xf0, 0xc5, 0x8e, 0x17, 0xef, 0xee, 0xd9, 0x4d,
-	0x27, 0xec, 0xae, 0xf2, 0x7e, 0xe0, 0xc4, 0xbb, 0x5e, 0xd0, 0xc9, 0xfd, 0xca, 0x8b, 0xb6, 0xb9,
-	0xbd, 0x1b, 0x46, 0xf1, 0xd6, 0xc6, 0x20, 0xc1, 0xfa, 0xa1, 0xac, 0xfe, 0x30, 0xc1, 0xf3, 0x23,
-	0xff, 0x6f, 0xf5, 0xc9, 0xb7, 0x87, 0x2b, 0xaf, 0x93, 0x98, 0xe6, 0xd2, 0xe6, 0xc5, 0x5f, 0xfa,
-	0xf7, 0xe2, 0xbf, 0x8c, 0xa6, 0x39, 0xfb, 0x7c, 0x8f, 0x05, 0x0e, 0xab, 0x22, 0xa8, 0x62, 0x4d,
-	0xa8, 0x20, 0xc5, 0x86, 0x09, 0x9e, 0x95, 0xb5, 0x57, 0x00, 0xa1, 0xda, 0x67, 0xde, 0x41, 0xb3,
-	0xbc, 0xdf, 0xf5, 0xbd, 0xe0, 0x41, 0x2b, 0x6e, 0x47, 0x1d, 0x16, 0x57, 0x17, 0xe0, 0x94, 0x1b,
-	0x83, 0x04, 0xcf, 0x28, 0xcf, 0x5d, 0x70, 0x68, 0x1d, 0x8f, 0xa0, 0x84, 0x8e, 0xb2, 0xcc, 0x6b,
-	0xa8, 0x6c, 0xfb, 0xa1, 0xf3, 0x80, 0xb7, 0x76, 0xdb, 0x7c, 0xb7, 0x6a, 0xd6, 0x8d, 0x46, 0xc5,
-	0x22, 0xa2, 0xac, 0x12, 0xbe, 0xd1, 0xe6, 0xbb, 0xba, 0xac, 0x19, 0x44, 0x68, 0xce, 0x6f, 0x7e,
-	0x80, 0x4a, 0x2c, 0x70, 0xa2, 0x7e, 0x4f, 0x34, 0xf4, 0x39, 0x48, 0x01, 0xc2, 0xd0, 0xa0, 0x16,
-	0x86, 0x46, 0x08, 0xcd, 0xbc, 0xa6, 0x85, 0x8a, 0x71, 0xbf, 0xc7, 0x60, 0x16, 0xcc, 0xae, 0x2d,
-	0x65, 0xc5, 0xd5, 0xe2, 0xee, 0xf7, 0x98, 0x54, 0xa7, 0xe0, 0x69, 0x75, 0x0a, 0x83, 0x50, 0xc0,
-	0xcc, 0x4d, 0x54, 0xee, 0xb1, 0xa8, 0xeb, 0x71, 0xd9, 0x82, 0xc5, 0xba, 0xd1, 0x98, 0xb1, 0x56,
-	0x06, 0x09, 0xce, 0xc3, 0xc3, 0x04, 0x2f, 0x40, 0x64, 0x0e, 0x23, 0x34, 0xcf, 0x30, 0x6f, 0xe6,
-	0x34, 0x1a, 0xf0, 0x6a, 0xb9, 0x6e, 0x34, 0x26, 0x60, 0x4e, 0x68, 0x41, 0xdc, 0xe6, 0xa7, 0x74,
-	0x76, 0x9b, 0x93, 0xbf, 0x12, 0x5c, 0xf0, 0x82, 0x98, 0xe6, 0x68, 0xe6, 0x0e, 0x92, 0x55, 0x6a,
-	0x41, 0x8f, 0xcd, 0x40, 0xaa, 0xeb, 0x47, 0x09, 0xae, 0xd0, 0xf6, 0x81, 0x25, 0x1c, 0xdb, 0xde,
-	0x23, 0x26, 0x0a, 0x65, 0xa7, 0x86, 0x2e, 0x94, 0x46, 0xd2, 0xc4, 0x4f, 0x0e, 0x57, 0x46, 0xc2,
-	0x68, 0x16, 0x64, 0x6e, 0xa0, 0xb2, 0x1f, 0x3a, 0x6d, 0xbf, 0xb5, 0xe3, 0xb7, 0x3b, 0xbc, 0xfa,
-	0xfb, 0x14, 0xbc, 0x3c, 0x9c, 0x22, 0xe0, 0x9b, 0x02, 0xd6, 0x0f, 0x9d, 0x41, 0x84, 0xe6, 0xfc,
-	0xe6, 0x0d, 0x54, 0x51, 0x12, 0x95, 0x5a, 0xf8, 0x63, 0x0a, 0x4e, 0x12, 0x6a, 0xa8, 0x1c, 0x4a,
-	0x0d, 0x0b, 0x79, 0x65, 0x4b, 0x39, 0xe4, 0x19, 0xf9, 0xf1, 0x3e, 0xf9, 0x2a, 0xe3, 0x9d, 0xa2,
-	0x29, 0x35, 0x65, 0xab, 0x53, 0x10, 0xf7, 0xde, 0x51, 0x82, 0x11, 0x6d, 0x1f, 0x6c, 0x49, 0x54,
-	0x64, 0x51, 0x04, 0x9d, 0x45, 0xd9, 0x62, 0x56, 0xe6, 0x98, 0x34, 0xe5, 0x89, 0x8e, 0x09, 0xc2,
-	0x56, 0x5e, 0x1a, 0xd3, 0x90, 0x1a, 0x3a, 0x26, 0x08, 0x3f, 0x1e, 0x11, 0x87, 0xec, 0x98, 0x11,
-	0x94, 0xd0, 0x51, 0x96, 0x1a, 0xbd, 0xf7, 0x51, 0x09, 0x8e, 0x02, 0x66, 0xff, 0x4d, 0x34, 0x29,
-	0xbb, 0x41, 0x4d, 0xfe, 0x73, 0x99, 0x82, 0x81, 0x24, 0x24, 0x6c, 0xbd, 0xa1, 0x26, 0x84, 0xa2,
-	0x0e, 0x13, 0x5c, 0xce, 0x4e, 0x9a, 0x50, 0x05, 0x93, 0x1f, 0x0c, 0xb4, 0xb8, 0x15, 0xb8, 0x5e,
-	0xc4, 0x9c, 0x58, 0xd5, 0x93, 0xf1, 0x3b, 0x81, 0xdf, 0x3f, 0x9b, 0x56, 0x3d, 0xb3, 0x43, 0x26,
-	0xdf, 0x15, 0xd1, 0xe4, 0xb5, 0x70, 0x2f, 0x88, 0xb9, 0x79, 0x09, 0x4d, 0xec, 0x78, 0x3e, 0xe3,
-	0x70, 0xe5, 0x4c, 0x58, 0x78, 0x90, 0x60, 0x09, 0xe8, 0x97, 0x04, 0x4b, 0xf7, 0x88, 0x74, 0x9a,
-	0x1f, 0xa1, 0xb2, 0x7c, 0xcf, 0x30, 0xf2, 0x18, 0x87, 0xee, 0x9f, 0xb0, 0xde, 0x11, 0x4f, 0x92,
-	0x83, 0xf5, 0x93, 0xe4, 0x30, 0x9d, 0x28, 0x4f, 0x34, 0xaf, 0xa2, 0x69, 0x35, 0xdb, 0x38, 0xdc,
-	0x67, 0x13, 0xd6, 0x05, 0x98, 0xab, 0x0a, 0xcb, 0xe6, 0xaa, 0x02, 0x74, 0x16, 0x4d, 0x31, 0xdf,
-	0xcf, 0x84, 0x5b, 0x84, 0x0c, 0xe7, 0xff, 0x49, 0xb8, 0x69, 0xbc, 0xd6, 0x6f, 0x13, 0x4d, 0xd8,
-	0xfd, 0x98, 0xa5, 0x97, 0x63, 0x55, 0xd4, 0x01, 0x80, 0xec, 0xb0, 0x85, 0x45, 0xa8, 0x44, 0x47,
-	0x6e, 0x82, 0xc9, 0x57, 0xbc, 0x09, 0xb6, 0x51, 0x49, 0xee, 0x32, 0x2d, 0xcf, 0x85, 0x4b, 0xa0,
-	0x62, 0xad, 0x1f, 0x25, 0x78, 0x5a, 0xee, 0x27, 0x70, 0x33, 0x4e, 0x4b, 0xc2, 0x96, 0xab, 0x13,
-	0xa5, 0x80, 0xe8, 0x16, 0xcd, 0xa4, 0x9a, 0x27, 0x24, 0x96, 0x1f, 0x24, 0xe6, 0xeb, 0xcc, 0x11,
-	0xd5, 0x20, 0x5f, 0x1a, 0xa8, 0x24, 0xe5, 0xb1, 0xcd, 0x62, 0xf3, 0x2a, 0x9a, 0x74, 0xc0, 0x50,
-	0x1d, 0x82, 0xc4, 0x6e, 0x24, 0xdd, 0x59, 0x63, 0x48, 0x86, 0xae, 0x15, 0x98, 0x84, 0x2a, 0x58,
-	0x0c, 0x15, 0x27, 0x62, 0xed, 0x74, 0x67, 0x2c, 0xc8, 0xa1, 0xa2, 0x20, 0x7d, 0x36, 0xca, 0x26,
-	0x34, 0xf5, 0x90, 0xaf, 0xc6, 0xd1, 0x62, 0x6e, 0x0b, 0xdb, 0x60, 0xbd, 0x88, 0xc9, 0x45, 0xe9,
-	0x6c, 0x77, 0xda, 0x35, 0x34, 0x29, 0xeb, 0x08, 0x8f, 0x57, 0xb1, 0x96, 0xc5, 0x2b, 0x49, 0xe4,
-	0xd4, 0x66, 0xaa, 0x70, 0xf1, 0x4e, 0xe9, 0xc0, 0x2b, 0x64, 0x83, 0xf2, 0x45, 0x23, 0x2e, 0x1b,
-	0x6a, 0xeb, 0xa3, 0x3a, 0x7d, 0xd9, 0x01, 0x4b, 0x0e, 0xd0, 0x62, 0x6e, 0x67, 0xcd, 0x95, 0xe2,
-	0x93, 0x53, 0xdb, 0xeb, 0x7f, 0x4f, 0x6c, 0xaf, 0x19, 0xd9, 0x7a, 0x53, 0x15, 0xe5, 0xc5, 0x8b,
-	0xeb, 0xa9, 0x4d, 0xf5, 0xe7, 0x71, 0x34, 0x7b, 0xc7, 0xe6, 0x2c, 0xda, 0x67, 0xee, 0x66, 0xe8,
-	0xbb, 0x2c, 0x32, 0x6f, 0xa3, 0xa2, 0xf8, 0x2e, 0x51, 0xa5, 0x5f, 0x6e, 0xca, 0x8f, 0x96, 0x66,
-	0xfa, 0xd1, 0xd2, 0xbc, 0x9b, 0x7e, 0xb4, 0x58, 0x35, 0xf5, 0x7f, 0xc0, 0xcf, 0x2e, 0x7f, 0xaf,
-	0xcb, 0xc8, 0xe3, 0xdf, 0xb0, 0x41, 0x01, 0x17, 0xcd, 0xe7, 0xb7, 0x6d, 0xe6, 0x43, 0xf9, 0x4b,
-	0xb2, 0xf9, 0x00, 0xd0, 0x82, 0x02, 0x8b, 0x50, 0x89, 0x9a, 0x9f, 0xa1, 0x85, 0x88, 0x39, 0xcc,
-	0xdb, 0x67, 0xad, 0x6c, 0x79, 0x91, 0xa7, 0xd0, 0x1c, 0x24, 0x78, 0x5e, 0x39, 0x3f, 0xcc, 0xed,
-	0x30, 0x4b, 0x90, 0xe6, 0xa4, 0x83, 0xd0, 0x53, 0x5c, 0xf3, 0x3e, 0x9a, 0x8f, 0x58, 0x37, 0x8c,
-	0xf3, 0xb9, 0xe5, 0x49, 0xbd, 0x3b, 0x48, 0xf0, 0x9c, 0xf4, 0xe5, 0x53, 0x2f, 0xaa, 0xd4, 0x23,
-	0x38, 0xa1, 0x27, 0x99, 0xe4, 0x27, 0x23, 0x2b, 0xa4, 0x6c, 0xe0, 0x33, 0x2f, 0x64, 0xfa, 0xfd,
-	0x30, 0xfe, 0x12, 0xdf, 0x0f, 0xeb, 0x68, 0xaa, 0xed, 0xba, 0x11, 0xe3, 0x72, 0xe4, 0x96, 0xa4,
-	0x10, 0x15, 0xa4, 0x65, 0xa1, 0x6c, 0x42, 0x53, 0x8f, 0x75, 0xfd, 0xd9, 0xf3, 0xda, 0xd8, 0xe1,
-	0xf3, 0xda, 0xd8, 0xb3, 0xa3, 0x9a, 0x71, 0x78, 0x54, 0x33, 0x1e, 0x1f, 0xd7, 0xc6, 0x9e, 0x1e,
-	0xd7, 0x8c, 0xc3, 0xe3, 0xda, 0xd8, 0x2f, 0xc7, 0xb5, 0xb1, 0x4f, 0x2f, 0xbc, 0xc4, 0xd2, 0xee,
-	0xda, 0xf6, 0x24, 0xbc, 0xe6, 0xc5, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xa4, 0xea, 0xfc,
-	0x33, 0x0f, 0x00, 0x00,
+	// 1503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x4e, 0x6c, 0x8f, 0x9d, 0xaf, 0x2d, 0x89, 0x96, 0x00, 0x1e, 0x33, 0x4d, 0x25,
+	0xf3, 0x21, 0x47, 0x6a, 0xd5, 0x08, 0x55, 0x82, 0xaa, 0xdb, 0x90, 0x36, 0x55, 0x69, 0xab, 0x49,
+	0xd5, 0x22, 0x38, 0x58, 0xfb, 0x31, 0x76, 0x56, 0x5d, 0xef, 0x9a, 0x9d, 0x4d, 0x52, 0xf7, 0xc6,
+	0x05, 0x89, 0x5b, 0x55, 0x71, 0x40, 0x08, 0xa1, 0x9e, 0xf8, 0x13, 0xf8, 0x0b, 0x10, 0xea, 0xd1,
+	0x47, 0xc4, 0x61, 0x51, 0x93, 0x0b, 0xf8, 0xe8, 0x23, 0x27, 0x34, 0x1f, 0x3b, 0xbb, 0x4e, 0x54,
+	0xd4, 0x96, 0xdc, 0xfc, 0x7e, 0xef, 0xf7, 0x9e, 0x77, 0xdf, 0xfc, 0xde, 0x9b, 0xb7, 0xe0, 0x0d,
+	0xdf, 0xb3, 0xd7, 0x5d, 0x7b, 0x9d, 0xc6, 0xd1, 0x9e, 0x13, 0xd3, 0x56, 0x3f, 0x0a, 0xe3, 0x50,
+	0x9f, 0x76, 0xed, 0xd5, 0xb3, 0x11, 0xe9, 0x87, 0x74, 0x9d, 0x03, 0xf6, 0x5e, 0x67, 0xbd, 0x1b,
+	0x76, 0x43, 0x6e, 0xf0, 0x5f, 0x82, 0xb8, 0x0a, 0xbb, 0x61, 0xd8, 0xf5, 0x49, 0xc6, 0x8a, 0xbd,
+	0x1e, 0xa1, 0xb1, 0xd5, 0xeb, 0x4b, 0xc2, 0x0a, 0xcb, 0xcf, 0x7f, 0x3a, 0xa1, 0xbf, 0x6e, 0x93,
+	0x14, 0xaf, 0x90, 0x87, 0xb1, 0xf8, 0x89, 0x7e, 0x9a, 0x06, 0xd5, 0x2d, 0xcf, 0x27, 0xf7, 0x48,
+	0x44, 0xbd, 0x30, 0xd0, 0x6f, 0x82, 0xd2, 0xbe, 0xf8, 0x69, 0x68, 0x0d, 0xad, 0x59, 0x3d, 0xbf,
+	0xd8, 0x4a, 0x13, 0xb4, 0xee, 0x11, 0x27, 0x0e, 0x23, 0xb3, 0xf1, 0x2c, 0x81, 0x53, 0xa3, 0x04,
+	0xa6, 0xc4, 0x71, 0x02, 0xe7, 0x1e, 0xf6, 0xfc, 0x4b, 0x48, 0xda, 0x08, 0xa7, 0x1e, 0x7d, 0x03,
+	0x94, 0x5c, 0xe2, 0x93, 0x98, 0xb8, 0xc6, 0x74, 0x43, 0x6b, 0x96, 0xcd, 0xb7, 0x59, 0x9c, 0x84,
+	0x54, 0x9c, 0xb4, 0x11, 0x4e, 0x3d, 0xfa, 0x45, 0x16, 0xb7, 0xef, 0x39, 0x84, 0x1a, 0x85, 0x46,
+	0xa1, 0x59, 0x33, 0xdf, 0x12, 0x71, 0x1c, 0x1a, 0x27, 0xb0, 0x26, 0xe3, 0x98, 0xcd, 0xc3, 0xb8,
+	0x43, 0xc7, 0x60, 0xc1, 0x0b, 0xf6, 0x2d, 0xdf, 0x73, 0xdb, 0x69, 0x78, 0x91, 0x87, 0xbf, 0x37,
+	0x4a, 0xe0, 0xbc, 0x74, 0x6d, 0xaa, 0x2c, 0x67, 0x78, 0x96, 0x09, 0x18, 0xe1, 0x63, 0x34, 0xf4,
+	0xb5, 0x06, 0xaa, 0xb2, 0x38, 0x37, 0x3d, 0x1a, 0xeb, 0x3e, 0x28, 0xcb, 0xb7, 0xa3, 0x86, 0xd6,
+	0x28, 0x34, 0xab, 0xe7, 0x17, 0x5a, 0xae, 0xdd, 0xca, 0xd5, 0xd0, 0xbc, 0xcc, 0x0a, 0x74, 0x98,
+	0xc0, 0x2a, 0xb6, 0x0e, 0x24, 0x46, 0x47, 0x09, 0x54, 0x71, 0x27, 0x0a, 0xf6, 0x64, 0xb8, 0x96,
+	0xe7, 0x62, 0xc5, 0xbc, 0x54, 0xfc, 0xfe, 0x29, 0x9c, 0x42, 0xc3, 0x2a, 0x58, 0x62, 0x7f, 0xb0,
+	0x1d, 0x74, 0xc2, 0xbb, 0xd1, 0x5e, 0xe0, 0x58, 0xac, 0x48, 0xef, 0x83, 0x62, 0x60, 0xf5, 0x08,
+	0x3f, 0xa7, 0x8a, 0xb9, 0x32, 0x4a, 0x20, 0xb7, 0xc7, 0x09, 0x04, 0x3c, 0x3b, 0x33, 0x10, 0xe6,
+	0x18, 0xe3, 0x52, 0xef, 0x11, 0x31, 0x0a, 0x0d, 0xad, 0x59, 0x10, 0x5c, 0x66, 0x2b, 0x2e, 0x33,
+	0x10, 0xe6, 0x98, 0x7e, 0x19, 0x80, 0x5e, 0xe8, 0x7a, 0x1d, 0x8f, 0xb8, 0x6d, 0x6a, 0xcc, 0xf0,
+	0x88, 0xc6, 0x28, 0x81, 0x95, 0x14, 0xdd, 0x19, 0x27, 0x70, 0x81, 0x87, 0x29, 0x04, 0xe1, 0xcc,
+	0xab, 0xff, 0xa2, 0x81, 0xaa, 0xca, 0x60, 0x0f, 0x8c, 0x5a, 0x43, 0x6b, 0x16, 0xcd, 0xef, 0x34,
+	0x56, 0x96, 0x3f, 0x12, 0x78, 0xa1, 0xeb, 0xc5, 0xbb, 0x7b, 0x76, 0xcb, 0x09, 0x7b, 0xeb, 0x74,
+	0x10, 0x38, 0xf1, 0xae, 0x17, 0x74, 0x73, 0xbf, 0xf2, 0xa2, 0x6d, 0xed, 0xec, 0x86, 0x51, 0xbc,
+	0xbd, 0x39, 0x4a, 0xa0, 0x7a, 0x28, 0x73, 0x30, 0x4e, 0xe0, 0xe2, 0xc4, 0xff, 0x9b, 0x03, 0xf4,
+	0xc3, 0x70, 0xed, 0x75, 0x12, 0xe3, 0x5c, 0xda, 0xbc, 0xf8, 0x2b, 0xff, 0x5f, 0xfc, 0x97, 0x40,
+	0x99, 0x92, 0xaf, 0xf6, 0x48, 0xe0, 0x10, 0x03, 0xf0, 0x2a, 0xd6, 0x99, 0x0a, 0x52, 0x6c, 0x9c,
+	0xc0, 0x79, 0x51, 0x7b, 0x09, 0x20, 0xac, 0x7c, 0xfa, 0x6d, 0x30, 0x4f, 0x07, 0x3d, 0xdf, 0x0b,
+	0x1e, 0xb4, 0x63, 0x2b, 0xea, 0x92, 0xd8, 0x58, 0xe2, 0xa7, 0xdc, 0x1c, 0x25, 0x70, 0x4e, 0x7a,
+	0xee, 0x72, 0x87, 0xd2, 0xf1, 0x04, 0x8a, 0xf0, 0x24, 0x4b, 0xbf, 0x0a, 0xaa, 0xb6, 0x1f, 0x3a,
+	0x0f, 0x68, 0x7b, 0xd7, 0xa2, 0xbb, 0x86, 0xde, 0xd0, 0x9a, 0x35, 0x13, 0xb1, 0xb2, 0x0a, 0xf8,
+	0xba, 0x45, 0x77, 0x55, 0x59, 0x33, 0x08, 0xe1, 0x9c, 0x5f, 0xff, 0x04, 0x54, 0x48, 0xe0, 0x44,
+	0x83, 0x3e, 0x6b, 0xe8, 0x33, 0x3c, 0x05, 0x17, 0x86, 0x02, 0x95, 0x30, 0x14, 0x82, 0x70, 0xe6,
+	0xd5, 0xef, 0x81, 0x72, 0xdf, 0xb7, 0xe2, 0x4e, 0x18, 0xf5, 0x8c, 0x79, 0x5e, 0xe0, 0x95, 0xac,
+	0xc0, 0x77, 0xa4, 0x67, 0xd3, 0x8a, 0x2d, 0x13, 0xc9, 0x32, 0x2b, 0xbe, 0xaa, 0x56, 0x0a, 0x20,
+	0xac, 0x7c, 0xba, 0x09, 0x8a, 0xf1, 0xa0, 0x4f, 0xf8, 0x8c, 0x99, 0xcf, 0xe7, 0x54, 0x4d, 0x33,
+	0xe8, 0x13, 0xa1, 0x7a, 0xc6, 0x53, 0xaa, 0x67, 0x06, 0xc2, 0x1c, 0xd3, 0xb7, 0x40, 0xb5, 0x4f,
+	0xa2, 0x9e, 0x47, 0x45, 0x6b, 0x17, 0x1b, 0x5a, 0x73, 0xce, 0x5c, 0x1b, 0x25, 0x30, 0x0f, 0x8f,
+	0x13, 0xb8, 0x24, 0x9e, 0x22, 0xc3, 0x10, 0xce, 0x33, 0xf4, 0x1b, 0x39, 0xed, 0x07, 0xd4, 0xa8,
+	0x36, 0xb4, 0xe6, 0x0c, 0x9f, 0x3f, 0x4a, 0x68, 0xb7, 0xe8, 0x09, 0xfd, 0xde, 0xa2, 0xe8, 0x9f,
+	0x04, 0x16, 0xbc, 0x20, 0xc6, 0x39, 0x9a, 0xde, 0x01, 0xa2, 0xfa, 0x6d, 0xde, 0xbb, 0x73, 0x3c,
+	0xd5, 0xb5, 0xc3, 0x04, 0xd6, 0xb0, 0x75, 0x60, 0x32, 0xc7, 0x8e, 0xf7, 0x88, 0xb0, 0x03, 0xb0,
+	0x53, 0x43, 0x1d, 0x80, 0x42, 0xd2, 0xc4, 0x4f, 0x86, 0x6b, 0x13, 0x61, 0x38, 0x0b, 0xd2, 0x37,
+	0x41, 0xd5, 0x0f, 0x1d, 0xcb, 0x6f, 0x77, 0x7c, 0xab, 0x4b, 0x8d, 0xbf, 0x4a, 0xfc, 0xe5, 0xb9,
+	0x3a, 0x38, 0xbe, 0xc5, 0x60, 0xf5, 0xd0, 0x19, 0x84, 0x70, 0xce, 0xaf, 0x5f, 0x07, 0x35, 0x29,
+	0x7d, 0xa1, 0xb1, 0xbf, 0x4b, 0x5c, 0x21, 0xbc, 0x86, 0xd2, 0x21, 0x55, 0xb6, 0x94, 0xef, 0x18,
+	0x21, 0xb3, 0x3c, 0x23, 0x7f, 0x6d, 0xcc, 0xbe, 0xca, 0xb5, 0x81, 0x41, 0x49, 0x4e, 0x6f, 0xa3,
+	0xc4, 0xe3, 0x3e, 0x3a, 0x4c, 0x20, 0xc0, 0xd6, 0xc1, 0xb6, 0x40, 0x59, 0x16, 0x49, 0x50, 0x59,
+	0xa4, 0xcd, 0x66, 0x70, 0x8e, 0x89, 0x53, 0x1e, 0xeb, 0xc4, 0x20, 0x6c, 0xe7, 0xa5, 0x51, 0xe6,
+	0xa9, 0x79, 0x27, 0x06, 0xe1, 0x9d, 0x09, 0x71, 0x88, 0x4e, 0x9c, 0x40, 0x11, 0x9e, 0x64, 0xc9,
+	0x91, 0x7e, 0x1f, 0x54, 0xf8, 0x51, 0xf0, 0x3b, 0xe5, 0x06, 0x98, 0x15, 0x5d, 0x26, 0x6f, 0x94,
+	0x33, 0x99, 0x82, 0x39, 0x89, 0x49, 0xd8, 0x7c, 0x47, 0xb6, 0x84, 0xa4, 0x8e, 0x13, 0x58, 0xcd,
+	0x4e, 0x1a, 0x61, 0x09, 0xa3, 0x9f, 0x35, 0xb0, 0xbc, 0x1d, 0xb8, 0x5e, 0x44, 0x9c, 0x58, 0xd6,
+	0x93, 0xd0, 0xdb, 0x81, 0x3f, 0x38, 0x9d, 0x11, 0x70, 0x6a, 0x87, 0x8c, 0x7e, 0x2c, 0x82, 0xd9,
+	0xab, 0xe1, 0x5e, 0x10, 0x53, 0xfd, 0x22, 0x98, 0xe9, 0x78, 0x3e, 0xa1, 0xfc, 0x2a, 0x9b, 0x31,
+	0xe1, 0x28, 0x81, 0x02, 0x50, 0x2f, 0xc9, 0x2d, 0xd5, 0x23, 0xc2, 0xa9, 0x7f, 0x06, 0xaa, 0xe2,
+	0x3d, 0xc3, 0xc8, 0x23, 0x94, 0x77, 0xff, 0x8c, 0xf9, 0x01, 0x7b, 0x92, 0x1c, 0xac, 0x9e, 0x24,
+	0x87, 0xa9, 0x44, 0x79, 0xa2, 0x7e, 0x05, 0x94, 0xe5, 0xcc, 0xa4, 0xfc, 0x9e, 0x9c, 0x31, 0xcf,
+	0xf1, 0x79, 0x2d, 0xb1, 0x6c, 0x5e, 0x4b, 0x40, 0x65, 0x51, 0x14, 0xfd, 0xe3, 0x4c, 0xb8, 0x45,
+	0x9e, 0xe1, 0xec, 0x7f, 0x09, 0x37, 0x8d, 0x57, 0xfa, 0x6d, 0x81, 0x19, 0x7b, 0x10, 0x93, 0xf4,
+	0xd2, 0x35, 0x58, 0x1d, 0x38, 0x90, 0x1d, 0x36, 0xb3, 0x10, 0x16, 0xe8, 0xc4, 0x0d, 0x33, 0xfb,
+	0x8a, 0x37, 0xcc, 0x0e, 0xa8, 0x88, 0x1d, 0xa9, 0xed, 0xb9, 0xfc, 0x72, 0xa9, 0x99, 0x1b, 0x87,
+	0x09, 0x2c, 0x8b, 0xbd, 0x87, 0xdf, 0xb8, 0x65, 0x41, 0xd8, 0x76, 0x55, 0xa2, 0x14, 0x60, 0xdd,
+	0xa2, 0x98, 0x58, 0xf1, 0x98, 0xc4, 0xf2, 0x83, 0x44, 0x7f, 0x9d, 0x39, 0x22, 0x1b, 0xe4, 0x1b,
+	0x0d, 0x54, 0x84, 0x3c, 0x76, 0x48, 0xac, 0x5f, 0x01, 0xb3, 0x0e, 0x37, 0x64, 0x87, 0x00, 0xb6,
+	0x73, 0x09, 0x77, 0xd6, 0x18, 0x82, 0xa1, 0x6a, 0xc5, 0x4d, 0x84, 0x25, 0xcc, 0x86, 0x8a, 0x13,
+	0x11, 0x2b, 0xdd, 0x45, 0x0b, 0x62, 0xa8, 0x48, 0x48, 0x9d, 0x8d, 0xb4, 0x11, 0x4e, 0x3d, 0xe8,
+	0xdb, 0x69, 0xb0, 0x9c, 0xdb, 0xee, 0x36, 0x49, 0x3f, 0x22, 0x62, 0x01, 0x3b, 0xdd, 0x5d, 0xf9,
+	0x3c, 0x98, 0x15, 0x75, 0xe4, 0x8f, 0x57, 0x33, 0x57, 0xd9, 0x2b, 0x09, 0xe4, 0xc4, 0xc6, 0x2b,
+	0x71, 0xf6, 0x4e, 0xe9, 0xc0, 0x2b, 0x64, 0x83, 0xf2, 0x45, 0x23, 0x2e, 0x1b, 0x6a, 0x1b, 0x93,
+	0x3a, 0x7d, 0xd9, 0x01, 0x8b, 0x0e, 0xc0, 0x72, 0x6e, 0x17, 0xce, 0x95, 0xe2, 0xf3, 0x13, 0x5b,
+	0xf1, 0x9b, 0xc7, 0xb6, 0xe2, 0x8c, 0x6c, 0xbe, 0x9b, 0x5e, 0xee, 0x2f, 0x5c, 0x88, 0x4f, 0x6c,
+	0xc0, 0xbf, 0x4d, 0x83, 0xf9, 0xdb, 0x36, 0x25, 0xd1, 0x3e, 0x71, 0xb7, 0x42, 0xdf, 0x25, 0x91,
+	0x7e, 0x0b, 0x14, 0xd9, 0xf7, 0x8e, 0x2c, 0xfd, 0x6a, 0x4b, 0x7c, 0x0c, 0xb5, 0xd2, 0x8f, 0xa1,
+	0xd6, 0xdd, 0xf4, 0x63, 0xc8, 0xac, 0xcb, 0xff, 0xe3, 0xfc, 0xec, 0xf2, 0xf7, 0x7a, 0x04, 0x3d,
+	0xfe, 0x13, 0x6a, 0x98, 0xe3, 0xac, 0xf9, 0x7c, 0xcb, 0x26, 0x3e, 0x2f, 0x7f, 0x45, 0x34, 0x1f,
+	0x07, 0x94, 0xa0, 0xb8, 0x85, 0xb0, 0x40, 0xf5, 0x2f, 0xc1, 0x52, 0x44, 0x1c, 0xe2, 0xed, 0x93,
+	0x76, 0xb6, 0x14, 0x89, 0x53, 0x68, 0x8d, 0x12, 0xb8, 0x28, 0x9d, 0x9f, 0xe6, 0x76, 0xa3, 0x15,
+	0x9e, 0xe6, 0xb8, 0x03, 0xe1, 0x13, 0x5c, 0xfd, 0x3e, 0x58, 0x8c, 0x48, 0x2f, 0x8c, 0xf3, 0xb9,
+	0xc5, 0x49, 0x7d, 0x38, 0x4a, 0xe0, 0x82, 0xf0, 0xe5, 0x53, 0x2f, 0xcb, 0xd4, 0x13, 0x38, 0xc2,
+	0xc7, 0x99, 0xe8, 0x57, 0x2d, 0x2b, 0xa4, 0x68, 0xe0, 0x53, 0x2f, 0x64, 0xfa, 0x5d, 0x32, 0xfd,
+	0x12, 0xdf, 0x25, 0x1b, 0xa0, 0x64, 0xb9, 0x6e, 0x44, 0xa8, 0x18, 0xb9, 0x15, 0x21, 0x44, 0x09,
+	0x29, 0x59, 0x48, 0x1b, 0xe1, 0xd4, 0x63, 0x5e, 0x7b, 0xf6, 0xbc, 0x3e, 0x35, 0x7c, 0x5e, 0x9f,
+	0x7a, 0x76, 0x58, 0xd7, 0x86, 0x87, 0x75, 0xed, 0xf1, 0x51, 0x7d, 0xea, 0xe9, 0x51, 0x5d, 0x1b,
+	0x1e, 0xd5, 0xa7, 0x7e, 0x3f, 0xaa, 0x4f, 0x7d, 0x71, 0xee, 0x25, 0x3e, 0x06, 0x5c, 0xdb, 0x9e,
+	0xe5, 0xaf, 0x79, 0xe1, 0xdf, 0x01, 0x00, 0xd9, 0x43, 0xb4, 0x08, 0x8b, 0x0f, 0x00, 0x00,
 }
 
 func (m *FileVersion) Marshal() (dAtA []byte, err error) {
@@ -752,6 +754,16 @@ func (m *FileInfoTruncated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x8a
 	}
+	{
+		size, err := m.Platform.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStructs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if m.RawBlockSize != 0 {
 		i = encodeVarintStructs(dAtA, i, uint64(m.RawBlockSize))
 		i--
@@ -1175,12 +1187,12 @@ func (m *ObservedFolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintStructs(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintStructs(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1220,12 +1232,12 @@ func (m *ObservedDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintStructs(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintStructs(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1328,6 +1340,8 @@ func (m *FileInfoTruncated) ProtoSize() (n int) {
 	if m.RawBlockSize != 0 {
 		n += 1 + sovStructs(uint64(m.RawBlockSize))
 	}
+	l = m.Platform.ProtoSize()
+	n += 1 + l + sovStructs(uint64(l))
 	l = len(m.SymlinkTarget)
 	if l > 0 {
 		n += 2 + l + sovStructs(uint64(l))
@@ -2073,6 +2087,39 @@ func (m *FileInfoTruncated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Platform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 17:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
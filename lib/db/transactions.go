@@ -378,6 +378,74 @@ func (t *readOnlyTransaction) withGlobal(folder, prefix []byte, truncate bool, f
 	return dbi.Error()
 }
 
+// withGlobalChildren iterates over the global files directly within the
+// given directory, the empty name being the folder root. The contents of
+// subdirectories are skipped over rather than iterated.
+func (t *readOnlyTransaction) withGlobalChildren(folder, dir []byte, truncate bool, fn Iterator) error {
+	var prefix []byte
+	if dir = bytes.TrimSuffix(dir, []byte{'/'}); len(dir) > 0 {
+		prefix = append(append(prefix, dir...), '/')
+	}
+
+	first, err := t.keyer.GenerateGlobalVersionKey(nil, folder, prefix)
+	if err != nil {
+		return err
+	}
+	last := keyPrefixLimit(first)
+
+	var dk []byte
+	for first != nil {
+		dbi, err := t.NewRangeIterator(first, last)
+		if err != nil {
+			return err
+		}
+		first = nil
+		for dbi.Next() {
+			key := dbi.Key()
+			name := t.keyer.NameFromGlobalVersionKey(key)
+			if i := bytes.IndexByte(name[len(prefix):], '/'); i >= 0 {
+				// Within a subdirectory, continue after all of it.
+				first = keyPrefixLimit(key[:len(key)-len(name)+len(prefix)+i+1])
+				break
+			}
+
+			var vl VersionList
+			if err = vl.Unmarshal(dbi.Value()); err != nil {
+				break
+			}
+			var f protocol.FileIntf
+			if dk, f, err = t.getGlobalFromVersionList(dk, folder, name, truncate, vl); err != nil {
+				break
+			}
+			if !fn(f) {
+				dbi.Release()
+				return nil
+			}
+		}
+		if err == nil {
+			err = dbi.Error()
+		}
+		dbi.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyPrefixLimit returns the smallest key that is larger than all keys with
+// the given prefix, or nil if there is none.
+func keyPrefixLimit(prefix []byte) []byte {
+	limit := append([]byte{}, prefix...)
+	for i := len(limit) - 1; i >= 0; i-- {
+		if limit[i] < 0xff {
+			limit[i]++
+			return limit[:i+1]
+		}
+	}
+	return nil
+}
+
 func (t *readOnlyTransaction) withBlocksHash(folder, hash []byte, iterator Iterator) error {
 	key, err := t.keyer.GenerateBlockListMapKey(nil, folder, hash, nil)
 	if err != nil {
@@ -56,6 +56,7 @@ type serverOptions struct {
 	insecure   bool   // don't check certificate
 	noAnnounce bool   // don't announce
 	noLookup   bool   // don't use for lookups
+	authLookup bool   // present our certificate on lookups
 	id         string // expected server device ID
 }
 
@@ -104,15 +105,20 @@ func NewGlobal(server string, cert tls.Certificate, addrList AddressLister, evLo
 	}
 
 	// The http.Client used for queries. We don't need to present our
-	// certificate here, so lets not include it. May be insecure if requested.
+	// certificate here, so lets not include it unless the server requires
+	// authenticated lookups. May be insecure if requested.
+	queryTLSCfg := &tls.Config{
+		InsecureSkipVerify: opts.insecure,
+	}
+	if opts.authLookup {
+		queryTLSCfg.Certificates = []tls.Certificate{cert}
+	}
 	var queryClient httpClient = &contextClient{&http.Client{
 		Timeout: requestTimeout,
 		Transport: &http.Transport{
-			DialContext: dialer.DialContext,
-			Proxy:       http.ProxyFromEnvironment,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: opts.insecure,
-			},
+			DialContext:     dialer.DialContext,
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: queryTLSCfg,
 		},
 	}}
 	if opts.id != "" {
@@ -313,6 +319,7 @@ func parseOptions(dsn string) (server string, opts serverOptions, err error) {
 	opts.insecure = opts.id != "" || queryBool(q, "insecure")
 	opts.noAnnounce = queryBool(q, "noannounce")
 	opts.noLookup = queryBool(q, "nolookup")
+	opts.authLookup = queryBool(q, "authlookup")
 
 	// Check for disallowed combinations
 	if p.Scheme == "http" {
@@ -447,3 +454,7 @@ func ipv4Identity(port int) string {
 func ipv6Identity(addr string) string {
 	return fmt.Sprintf("IPv6 local multicast discovery on address %s", addr)
 }
+
+func mdnsIdentity(addr string) string {
+	return fmt.Sprintf("mDNS discovery on address %s", addr)
+}
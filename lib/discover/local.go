@@ -219,12 +219,32 @@ func (c *localClient) registerDevice(src net.Addr, device Announce) bool {
 	ce, existsAlready := c.Get(device.ID)
 	isNewDevice := !existsAlready || time.Since(ce.when) > CacheLifeTime || ce.instanceID != device.InstanceID
 
-	// Any empty or unspecified addresses should be set to the source address
-	// of the announcement. We also skip any addresses we can't parse.
-
 	l.Debugln("discover: Registering addresses for", device.ID)
+	validAddresses := resolveAddresses(src, device.Addresses)
+
+	c.Set(device.ID, CacheEntry{
+		Addresses:  validAddresses,
+		when:       time.Now(),
+		found:      true,
+		instanceID: device.InstanceID,
+	})
+
+	if isNewDevice {
+		c.evLogger.Log(events.DeviceDiscovered, map[string]interface{}{
+			"device": device.ID.String(),
+			"addrs":  validAddresses,
+		})
+	}
+
+	return isNewDevice
+}
+
+// resolveAddresses returns the announced addresses, with any empty or
+// unspecified host replaced by the source address of the announcement.
+// Addresses we can't parse are skipped.
+func resolveAddresses(src net.Addr, addrs []string) []string {
 	var validAddresses []string
-	for _, addr := range device.Addresses {
+	for _, addr := range addrs {
 		u, err := url.Parse(addr)
 		if err != nil {
 			continue
@@ -265,19 +285,5 @@ func (c *localClient) registerDevice(src net.Addr, device Announce) bool {
 		}
 	}
 
-	c.Set(device.ID, CacheEntry{
-		Addresses:  validAddresses,
-		when:       time.Now(),
-		found:      true,
-		instanceID: device.InstanceID,
-	})
-
-	if isNewDevice {
-		c.evLogger.Log(events.DeviceDiscovered, map[string]interface{}{
-			"device": device.ID.String(),
-			"addrs":  validAddresses,
-		})
-	}
-
-	return isNewDevice
+	return validAddresses
 }
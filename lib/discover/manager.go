@@ -44,12 +44,16 @@ type manager struct {
 	cert          tls.Certificate
 	evLogger      events.Logger
 	addressLister AddressLister
+	peers         *PeerExchange
 
 	finders map[string]cachedFinder
 	mut     sync.RWMutex
 }
 
-func NewManager(myID protocol.DeviceID, cfg config.Wrapper, cert tls.Certificate, evLogger events.Logger, lister AddressLister) Manager {
+// NewManager returns a manager running the discovery mechanisms enabled in
+// the configuration. The peer exchange finder, if given, is fed by the
+// model with what connected devices tell us.
+func NewManager(myID protocol.DeviceID, cfg config.Wrapper, cert tls.Certificate, evLogger events.Logger, lister AddressLister, peers *PeerExchange) Manager {
 	m := &manager{
 		Supervisor:    suture.New("discover.Manager", svcutil.SpecWithDebugLogger(l)),
 		myID:          myID,
@@ -57,6 +61,7 @@ func NewManager(myID protocol.DeviceID, cfg config.Wrapper, cert tls.Certificate
 		cert:          cert,
 		evLogger:      evLogger,
 		addressLister: lister,
+		peers:         peers,
 
 		finders: make(map[string]cachedFinder),
 		mut:     sync.NewRWMutex(),
@@ -242,6 +247,15 @@ func (m *manager) CommitConfiguration(_, to config.Configuration) (handled bool)
 		toIdentities[ipv6Identity(to.Options.LocalAnnMCAddr)] = struct{}{}
 	}
 
+	if to.Options.MDNSEnabled {
+		toIdentities[mdnsIdentity(MDNSv4Address)] = struct{}{}
+		toIdentities[mdnsIdentity(MDNSv6Address)] = struct{}{}
+	}
+
+	if to.Options.PeerExchangeEnabled && m.peers != nil {
+		toIdentities[peerExchangeIdentity] = struct{}{}
+	}
+
 	// Remove things that we're not expected to have.
 	for identity := range m.finders {
 		if _, ok := toIdentities[identity]; !ok {
@@ -294,5 +308,26 @@ func (m *manager) CommitConfiguration(_, to config.Configuration) (handled bool)
 		}
 	}
 
+	if to.Options.MDNSEnabled {
+		for _, addr := range []string{MDNSv4Address, MDNSv6Address} {
+			identity := mdnsIdentity(addr)
+			if _, ok := m.finders[identity]; ok {
+				continue
+			}
+			md, err := NewMDNS(m.myID, addr, m.addressLister, m.evLogger)
+			if err != nil {
+				l.Warnln("mDNS discovery:", err)
+				continue
+			}
+			m.addLocked(identity, md, 0, 0)
+		}
+	}
+
+	if to.Options.PeerExchangeEnabled && m.peers != nil {
+		if _, ok := m.finders[peerExchangeIdentity]; !ok {
+			m.addLocked(peerExchangeIdentity, m.peers, 0, 0)
+		}
+	}
+
 	return true
 }
@@ -0,0 +1,406 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thejerf/suture/v4"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/syncthing/syncthing/lib/beacon"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/svcutil"
+)
+
+const (
+	MDNSv4Address = "224.0.0.251:5353"
+	MDNSv6Address = "[ff02::fb]:5353"
+
+	mdnsService = "_syncthing._tcp.local."
+	mdnsTTL     = uint32(CacheLifeTime / time.Second)
+	// We answer queries at most this often, as every device on the network
+	// that sees the query would otherwise answer all at once, repeatedly.
+	mdnsMinAnswerInterval = time.Second
+	// Set on the class of records we are the sole owner of, telling
+	// receivers to replace what they have cached for the name (RFC 6762,
+	// section 10.2).
+	mdnsCacheFlush = dnsmessage.Class(1 << 15)
+)
+
+var errNotMDNSAnnouncement = errors.New("not a Syncthing mDNS announcement")
+
+// The mdnsClient announces and browses for the _syncthing._tcp DNS-SD
+// service using multicast DNS. Each device is announced as a service
+// instance named by its device ID, with the usual SRV and address records
+// for the TCP listener. As a device usually listens on several transports,
+// the full set of addresses is also carried in the TXT record.
+type mdnsClient struct {
+	*suture.Supervisor
+	myID     protocol.DeviceID
+	addrList AddressLister
+	name     string
+	evLogger events.Logger
+
+	beacon          beacon.Interface
+	bcastTick       <-chan time.Time
+	forcedBcastTick chan time.Time
+
+	*cache
+}
+
+func NewMDNS(id protocol.DeviceID, addr string, addrList AddressLister, evLogger events.Logger) (FinderService, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsMulticast() {
+		return nil, fmt.Errorf("%s is not a multicast address", addr)
+	}
+
+	c := &mdnsClient{
+		Supervisor:      suture.New("mdns", svcutil.SpecWithDebugLogger(l)),
+		myID:            id,
+		addrList:        addrList,
+		evLogger:        evLogger,
+		bcastTick:       time.NewTicker(BroadcastInterval).C,
+		forcedBcastTick: make(chan time.Time),
+		cache:           newCache(),
+	}
+	if ip.To4() != nil {
+		c.name = "IPv4 mDNS"
+	} else {
+		c.name = "IPv6 mDNS"
+	}
+
+	// Responses must come from the mDNS port, so send from the socket we
+	// listen on.
+	c.beacon = beacon.NewMulticastPort(addr)
+	c.Add(c.beacon)
+	c.Add(svcutil.AsService(c.recvPackets, fmt.Sprintf("%s/recv", c)))
+	c.Add(svcutil.AsService(c.sendAnnouncements, fmt.Sprintf("%s/send", c)))
+
+	return c, nil
+}
+
+// Lookup returns a list of addresses the device is available at.
+func (c *mdnsClient) Lookup(_ context.Context, device protocol.DeviceID) (addresses []string, err error) {
+	if cache, ok := c.Get(device); ok {
+		if time.Since(cache.when) < CacheLifeTime {
+			addresses = cache.Addresses
+		}
+	}
+
+	return
+}
+
+func (c *mdnsClient) String() string {
+	return c.name
+}
+
+func (c *mdnsClient) Error() error {
+	return c.beacon.Error()
+}
+
+func (c *mdnsClient) sendAnnouncements(ctx context.Context) error {
+	// Ask who is out there, then keep announcing ourselves.
+	if query, err := mdnsQueryPkt(); err == nil {
+		c.beacon.Send(query)
+	}
+
+	instanceID := rand.Int63()
+	var lastSent time.Time
+	for {
+		if time.Since(lastSent) >= mdnsMinAnswerInterval {
+			if addrs := c.addrList.AllAddresses(); len(addrs) > 0 {
+				pkt, err := mdnsAnnouncementPkt(Announce{
+					ID:         c.myID,
+					Addresses:  addrs,
+					InstanceID: instanceID,
+				}, mdnsLocalIPs())
+				if err != nil {
+					l.Debugln("discover: building mDNS announcement:", err)
+				} else {
+					c.beacon.Send(pkt)
+					lastSent = time.Now()
+				}
+			}
+		}
+
+		select {
+		case <-c.bcastTick:
+		case <-c.forcedBcastTick:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *mdnsClient) recvPackets(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		buf, addr := c.beacon.Recv()
+		if addr == nil {
+			continue
+		}
+
+		var msg dnsmessage.Message
+		if err := msg.Unpack(buf); err != nil {
+			l.Debugf("discover: Failed to parse mDNS packet from %s: %v", addr, err)
+			continue
+		}
+
+		if !msg.Header.Response {
+			if isMDNSServiceQuery(msg) {
+				l.Debugf("discover: Received mDNS query from %s", addr)
+				c.forceAnnouncement()
+			}
+			continue
+		}
+
+		for _, ann := range parseMDNSAnnouncements(msg) {
+			if ann.ID == c.myID {
+				continue
+			}
+			l.Debugf("discover: Received mDNS announcement from %s for %s", addr, ann.ID)
+			if c.registerDevice(addr, ann) {
+				// Let the new device know about us right away.
+				c.forceAnnouncement()
+			}
+		}
+	}
+}
+
+func (c *mdnsClient) forceAnnouncement() {
+	select {
+	case c.forcedBcastTick <- time.Now():
+	default:
+	}
+}
+
+func (c *mdnsClient) registerDevice(src net.Addr, device Announce) bool {
+	ce, existsAlready := c.Get(device.ID)
+	isNewDevice := !existsAlready || time.Since(ce.when) > CacheLifeTime || ce.instanceID != device.InstanceID
+
+	l.Debugln("discover: Registering mDNS addresses for", device.ID)
+	validAddresses := resolveAddresses(src, device.Addresses)
+
+	c.Set(device.ID, CacheEntry{
+		Addresses:  validAddresses,
+		when:       time.Now(),
+		found:      true,
+		instanceID: device.InstanceID,
+	})
+
+	if isNewDevice {
+		c.evLogger.Log(events.DeviceDiscovered, map[string]interface{}{
+			"device": device.ID.String(),
+			"addrs":  validAddresses,
+		})
+	}
+
+	return isNewDevice
+}
+
+// mdnsQueryPkt returns a query for all instances of our service.
+func mdnsQueryPkt() ([]byte, error) {
+	msg := dnsmessage.Message{
+		Questions: []dnsmessage.Question{{
+			Name:  dnsmessage.MustNewName(mdnsService),
+			Type:  dnsmessage.TypePTR,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	return msg.Pack()
+}
+
+// mdnsAnnouncementPkt returns an unsolicited response pointing our service
+// at an instance for the device. The instance has an SRV record pointing at
+// a host name for the device, with the given addresses, and a TXT record
+// describing it.
+func mdnsAnnouncementPkt(ann Announce, ips []net.IP) ([]byte, error) {
+	instance, err := dnsmessage.NewName(ann.ID.String() + "." + mdnsService)
+	if err != nil {
+		return nil, err
+	}
+	host, err := dnsmessage.NewName(ann.ID.String() + ".local.")
+	if err != nil {
+		return nil, err
+	}
+
+	txt := []string{
+		"txtvers=1",
+		"id=" + ann.ID.String(),
+		"instance=" + strconv.FormatInt(ann.InstanceID, 10),
+	}
+	for _, addr := range ann.Addresses {
+		txt = append(txt, "addr="+addr)
+	}
+
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{
+			Response:      true,
+			Authoritative: true,
+		},
+		Answers: []dnsmessage.Resource{
+			{
+				Header: dnsmessage.ResourceHeader{
+					Name:  dnsmessage.MustNewName(mdnsService),
+					Class: dnsmessage.ClassINET,
+					TTL:   mdnsTTL,
+				},
+				Body: &dnsmessage.PTRResource{PTR: instance},
+			},
+			{
+				Header: dnsmessage.ResourceHeader{
+					Name:  instance,
+					Class: dnsmessage.ClassINET | mdnsCacheFlush,
+					TTL:   mdnsTTL,
+				},
+				Body: &dnsmessage.SRVResource{
+					Target: host,
+					Port:   mdnsServicePort(ann.Addresses),
+				},
+			},
+			{
+				Header: dnsmessage.ResourceHeader{
+					Name:  instance,
+					Class: dnsmessage.ClassINET | mdnsCacheFlush,
+					TTL:   mdnsTTL,
+				},
+				Body: &dnsmessage.TXTResource{TXT: txt},
+			},
+		},
+	}
+	for _, ip := range ips {
+		hdr := dnsmessage.ResourceHeader{
+			Name:  host,
+			Class: dnsmessage.ClassINET | mdnsCacheFlush,
+			TTL:   mdnsTTL,
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			var a dnsmessage.AResource
+			copy(a.A[:], ip4)
+			msg.Answers = append(msg.Answers, dnsmessage.Resource{Header: hdr, Body: &a})
+		} else if len(ip) == net.IPv6len {
+			var aaaa dnsmessage.AAAAResource
+			copy(aaaa.AAAA[:], ip)
+			msg.Answers = append(msg.Answers, dnsmessage.Resource{Header: hdr, Body: &aaaa})
+		}
+	}
+	return msg.Pack()
+}
+
+// mdnsServicePort returns the port of the first TCP address, as that is
+// the transport the service type names, or zero if there is none.
+func mdnsServicePort(addrs []string) uint16 {
+	for _, addr := range addrs {
+		uri, err := url.Parse(addr)
+		if err != nil || !strings.HasPrefix(uri.Scheme, "tcp") {
+			continue
+		}
+		if port, err := strconv.ParseUint(uri.Port(), 10, 16); err == nil {
+			return uint16(port)
+		}
+	}
+	return 0
+}
+
+// mdnsLocalIPs returns the addresses to announce for our host name, being
+// all unicast addresses of the local interfaces.
+func mdnsLocalIPs() []net.IP {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		l.Debugln("discover: listing interface addresses:", err)
+		return nil
+	}
+	var ips []net.IP
+	for _, addr := range addrs {
+		ipnet, ok := addr.(*net.IPNet)
+		if !ok || ipnet.IP.IsLoopback() || ipnet.IP.IsUnspecified() || ipnet.IP.IsMulticast() {
+			continue
+		}
+		ips = append(ips, ipnet.IP)
+	}
+	return ips
+}
+
+func isMDNSServiceQuery(msg dnsmessage.Message) bool {
+	for _, q := range msg.Questions {
+		if (q.Type == dnsmessage.TypePTR || q.Type == dnsmessage.TypeALL) && strings.EqualFold(q.Name.String(), mdnsService) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMDNSAnnouncements returns the devices described by the TXT records
+// of our service in the response.
+func parseMDNSAnnouncements(msg dnsmessage.Message) []Announce {
+	var anns []Announce
+	records := append(msg.Answers[:len(msg.Answers):len(msg.Answers)], msg.Additionals...)
+	for _, rec := range records {
+		txt, ok := rec.Body.(*dnsmessage.TXTResource)
+		if !ok || !strings.HasSuffix(strings.ToLower(rec.Header.Name.String()), "."+mdnsService) {
+			continue
+		}
+		ann, err := parseMDNSTXT(txt.TXT)
+		if err != nil {
+			l.Debugf("discover: Skipping mDNS record %s: %v", rec.Header.Name, err)
+			continue
+		}
+		anns = append(anns, ann)
+	}
+	return anns
+}
+
+func parseMDNSTXT(txt []string) (Announce, error) {
+	var ann Announce
+	var haveID bool
+	for _, kv := range txt {
+		key, val := kv, ""
+		if i := strings.IndexByte(kv, '='); i >= 0 {
+			key, val = kv[:i], kv[i+1:]
+		}
+		switch strings.ToLower(key) {
+		case "txtvers":
+			if val != "1" {
+				return Announce{}, fmt.Errorf("unsupported txtvers %q", val)
+			}
+		case "id":
+			id, err := protocol.DeviceIDFromString(val)
+			if err != nil {
+				return Announce{}, err
+			}
+			ann.ID = id
+			haveID = true
+		case "instance":
+			ann.InstanceID, _ = strconv.ParseInt(val, 10, 64)
+		case "addr":
+			ann.Addresses = append(ann.Addresses, val)
+		}
+	}
+	if !haveID {
+		return Announce{}, errNotMDNSAnnouncement
+	}
+	return ann, nil
+}
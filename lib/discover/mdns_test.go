@@ -0,0 +1,137 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestMDNSAnnouncementRoundtrip(t *testing.T) {
+	ann := Announce{
+		ID:         protocol.LocalDeviceID,
+		Addresses:  []string{"tcp://0.0.0.0:22000", "quic://192.0.2.42:22000"},
+		InstanceID: 1234567890,
+	}
+	ips := []net.IP{net.ParseIP("192.0.2.42"), net.ParseIP("2001:db8::42")}
+	pkt, err := mdnsAnnouncementPkt(ann, ips)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var msg dnsmessage.Message
+	if err := msg.Unpack(pkt); err != nil {
+		t.Fatal(err)
+	}
+	if !msg.Header.Response {
+		t.Error("announcement should be a response")
+	}
+	anns := parseMDNSAnnouncements(msg)
+	if len(anns) != 1 {
+		t.Fatalf("expected one announcement, got %d", len(anns))
+	}
+	if !reflect.DeepEqual(anns[0], ann) {
+		t.Errorf("announcement %+v, expected %+v", anns[0], ann)
+	}
+
+	// The service instance should resolve the usual DNS-SD way as well.
+	instance := ann.ID.String() + "." + mdnsService
+	host := ann.ID.String() + ".local."
+	var haveSRV, haveA, haveAAAA bool
+	for _, rec := range msg.Answers {
+		switch body := rec.Body.(type) {
+		case *dnsmessage.SRVResource:
+			if rec.Header.Name.String() != instance {
+				t.Errorf("SRV record for %s, expected %s", rec.Header.Name, instance)
+			}
+			if body.Target.String() != host || body.Port != 22000 {
+				t.Errorf("SRV record pointing at %s:%d, expected %s:22000", body.Target, body.Port, host)
+			}
+			haveSRV = true
+		case *dnsmessage.AResource:
+			if rec.Header.Name.String() != host || !net.IP(body.A[:]).Equal(ips[0]) {
+				t.Errorf("A record %s %v, expected %s %v", rec.Header.Name, net.IP(body.A[:]), host, ips[0])
+			}
+			haveA = true
+		case *dnsmessage.AAAAResource:
+			if rec.Header.Name.String() != host || !net.IP(body.AAAA[:]).Equal(ips[1]) {
+				t.Errorf("AAAA record %s %v, expected %s %v", rec.Header.Name, net.IP(body.AAAA[:]), host, ips[1])
+			}
+			haveAAAA = true
+		}
+	}
+	if !haveSRV || !haveA || !haveAAAA {
+		t.Errorf("missing records: SRV %v, A %v, AAAA %v", haveSRV, haveA, haveAAAA)
+	}
+}
+
+func TestMDNSServicePort(t *testing.T) {
+	cases := []struct {
+		addrs []string
+		port  uint16
+	}{
+		{nil, 0},
+		{[]string{"quic://0.0.0.0:22000"}, 0},
+		{[]string{"quic://0.0.0.0:22001", "tcp://0.0.0.0:22000"}, 22000},
+		{[]string{"tcp4://192.0.2.42:1234", "tcp://0.0.0.0:22000"}, 1234},
+		{[]string{"tcp://0.0.0.0", "tcp://[2001:db8::42]:5678"}, 5678},
+	}
+	for _, tc := range cases {
+		if port := mdnsServicePort(tc.addrs); port != tc.port {
+			t.Errorf("port %d for %v, expected %d", port, tc.addrs, tc.port)
+		}
+	}
+}
+
+func TestMDNSQuery(t *testing.T) {
+	pkt, err := mdnsQueryPkt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg dnsmessage.Message
+	if err := msg.Unpack(pkt); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Header.Response || !isMDNSServiceQuery(msg) {
+		t.Error("expected a query for our service")
+	}
+}
+
+func TestMDNSRegisterDevice(t *testing.T) {
+	c, err := NewMDNS(protocol.LocalDeviceID, MDNSv4Address, &fakeAddressLister{}, events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mc := c.(*mdnsClient)
+
+	id := protocol.DeviceID{10, 20, 30, 40, 50, 60, 70, 80, 90}
+	src := &net.UDPAddr{IP: []byte{10, 20, 30, 40}, Port: 5353}
+	if !mc.registerDevice(src, Announce{ID: id, Addresses: []string{"tcp://0.0.0.0:22000"}, InstanceID: 1}) {
+		t.Error("first register should be new")
+	}
+	if mc.registerDevice(src, Announce{ID: id, Addresses: []string{"tcp://0.0.0.0:22000"}, InstanceID: 1}) {
+		t.Error("second register should not be new")
+	}
+
+	addrs, _ := c.Lookup(context.Background(), id)
+	if len(addrs) != 1 || addrs[0] != "tcp://10.20.30.40:22000" {
+		t.Errorf("unexpected addresses %v", addrs)
+	}
+}
+
+func TestNewMDNSRequiresMulticast(t *testing.T) {
+	if _, err := NewMDNS(protocol.LocalDeviceID, "192.0.2.1:5353", &fakeAddressLister{}, events.NoopLogger); err == nil {
+		t.Error("expected error for non-multicast address")
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/util"
+)
+
+// PeerExchangeLifeTime is how long addresses learned from a peer are used.
+// Peers resend their observations well within this time while they remain
+// valid.
+const PeerExchangeLifeTime = 30 * time.Minute
+
+const peerExchangeIdentity = "peer exchange"
+
+// The PeerExchange finder returns the addresses other connected devices
+// have told us they reach a device at. Each peer's observation is kept
+// separately, so that it can be replaced by a newer one from the same peer
+// without affecting those of others.
+type PeerExchange struct {
+	myID    protocol.DeviceID
+	entries map[protocol.DeviceID]map[protocol.DeviceID]CacheEntry // device -> via -> addresses
+	mut     sync.Mutex
+}
+
+func NewPeerExchange(myID protocol.DeviceID) *PeerExchange {
+	return &PeerExchange{
+		myID:    myID,
+		entries: make(map[protocol.DeviceID]map[protocol.DeviceID]CacheEntry),
+		mut:     sync.NewMutex(),
+	}
+}
+
+// Record sets the addresses the peer via currently reaches device at,
+// replacing what it told us before. No addresses forgets them.
+func (p *PeerExchange) Record(via, device protocol.DeviceID, addresses []string) {
+	if device == p.myID || device == via {
+		return
+	}
+
+	p.mut.Lock()
+	defer p.mut.Unlock()
+
+	if len(addresses) == 0 {
+		if vias, ok := p.entries[device]; ok {
+			delete(vias, via)
+			if len(vias) == 0 {
+				delete(p.entries, device)
+			}
+		}
+		return
+	}
+
+	vias, ok := p.entries[device]
+	if !ok {
+		vias = make(map[protocol.DeviceID]CacheEntry)
+		p.entries[device] = vias
+	}
+	l.Debugf("discover: Peer %s reaches %s at %v", via, device, addresses)
+	vias[via] = CacheEntry{
+		Addresses: append([]string(nil), addresses...),
+		when:      time.Now(),
+		found:     true,
+	}
+}
+
+// Lookup returns a list of addresses the device is available at.
+func (p *PeerExchange) Lookup(_ context.Context, device protocol.DeviceID) ([]string, error) {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	entry, ok := p.entryLocked(device)
+	if !ok {
+		return nil, nil
+	}
+	return entry.Addresses, nil
+}
+
+func (p *PeerExchange) Error() error {
+	return nil
+}
+
+func (p *PeerExchange) String() string {
+	return peerExchangeIdentity
+}
+
+func (p *PeerExchange) Cache() map[protocol.DeviceID]CacheEntry {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	res := make(map[protocol.DeviceID]CacheEntry, len(p.entries))
+	for device := range p.entries {
+		if entry, ok := p.entryLocked(device); ok {
+			res[device] = entry
+		}
+	}
+	return res
+}
+
+// entryLocked merges the unexpired observations of the device, dropping
+// those that have expired.
+func (p *PeerExchange) entryLocked(device protocol.DeviceID) (CacheEntry, bool) {
+	var res CacheEntry
+	for via, entry := range p.entries[device] {
+		if time.Since(entry.when) > PeerExchangeLifeTime {
+			delete(p.entries[device], via)
+			continue
+		}
+		res.Addresses = append(res.Addresses, entry.Addresses...)
+		if entry.when.After(res.when) {
+			res.when = entry.when
+		}
+		res.found = true
+	}
+	if !res.found {
+		delete(p.entries, device)
+		return CacheEntry{}, false
+	}
+	res.Addresses = util.UniqueTrimmedStrings(res.Addresses)
+	sort.Strings(res.Addresses)
+	return res, true
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestPeerExchange(t *testing.T) {
+	via1 := protocol.DeviceID{1}
+	via2 := protocol.DeviceID{2}
+	device := protocol.DeviceID{3}
+	ctx := context.Background()
+
+	p := NewPeerExchange(protocol.LocalDeviceID)
+	p.Record(via1, device, []string{"tcp://192.0.2.1:22000"})
+	p.Record(via2, device, []string{"tcp://192.0.2.2:22000", "tcp://192.0.2.1:22000"})
+	// Neither we nor the peer itself are looked up through the peer.
+	p.Record(via1, protocol.LocalDeviceID, []string{"tcp://192.0.2.3:22000"})
+	p.Record(via1, via1, []string{"tcp://192.0.2.4:22000"})
+
+	addrs, _ := p.Lookup(ctx, device)
+	expected := []string{"tcp://192.0.2.1:22000", "tcp://192.0.2.2:22000"}
+	if !reflect.DeepEqual(addrs, expected) {
+		t.Errorf("addresses %v, expected %v", addrs, expected)
+	}
+	if cache := p.Cache(); len(cache) != 1 || !reflect.DeepEqual(cache[device].Addresses, expected) {
+		t.Errorf("unexpected cache %v", cache)
+	}
+
+	// A newer observation replaces the old one of the same peer.
+	p.Record(via2, device, nil)
+	addrs, _ = p.Lookup(ctx, device)
+	if !reflect.DeepEqual(addrs, []string{"tcp://192.0.2.1:22000"}) {
+		t.Errorf("unexpected addresses %v after forgetting", addrs)
+	}
+
+	// Old observations expire.
+	p.mut.Lock()
+	entry := p.entries[device][via1]
+	entry.when = time.Now().Add(-PeerExchangeLifeTime - time.Second)
+	p.entries[device][via1] = entry
+	p.mut.Unlock()
+	if addrs, _ := p.Lookup(ctx, device); len(addrs) != 0 {
+		t.Errorf("unexpected addresses %v after expiry", addrs)
+	}
+	if len(p.entries) != 0 {
+		t.Error("expired entries should be dropped")
+	}
+}
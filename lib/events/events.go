@@ -50,6 +50,9 @@ const (
 	FolderSummary
 	FolderCompletion
 	FolderErrors
+	FolderDeletionHold
+	ConflictDetected
+	CorruptionDetected
 	FolderScanProgress
 	FolderPaused
 	FolderResumed
@@ -114,6 +117,12 @@ func (t EventType) String() string {
 		return "FolderCompletion"
 	case FolderErrors:
 		return "FolderErrors"
+	case FolderDeletionHold:
+		return "FolderDeletionHold"
+	case ConflictDetected:
+		return "ConflictDetected"
+	case CorruptionDetected:
+		return "CorruptionDetected"
 	case DevicePaused:
 		return "DevicePaused"
 	case DeviceResumed:
@@ -199,6 +208,12 @@ func UnmarshalEventType(s string) EventType {
 		return FolderCompletion
 	case "FolderErrors":
 		return FolderErrors
+	case "FolderDeletionHold":
+		return FolderDeletionHold
+	case "ConflictDetected":
+		return ConflictDetected
+	case "CorruptionDetected":
+		return CorruptionDetected
 	case "DevicePaused":
 		return DevicePaused
 	case "DeviceResumed":
@@ -329,6 +344,7 @@ func (l *logger) sendEvent(e Event) {
 	dl.Debugln("log", l.nextGlobalID, e.Type, e.Data)
 
 	e.GlobalID = l.nextGlobalID
+	metricEvents.WithLabelValues(e.Type.String(), metricEventStateCreated).Inc()
 
 	for i, s := range l.subs {
 		if s.mask&e.Type != 0 {
@@ -340,9 +356,11 @@ func (l *logger) sendEvent(e Event) {
 
 			select {
 			case s.events <- e:
+				metricEvents.WithLabelValues(e.Type.String(), metricEventStateDelivered).Inc()
 			case <-l.timeout.C:
 				// if s.events is not ready, drop the event
 				timedOut = true
+				metricEvents.WithLabelValues(e.Type.String(), metricEventStateDropped).Inc()
 			}
 
 			// If stop returns false it already sent something to the
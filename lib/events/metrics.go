@@ -0,0 +1,29 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var metricEvents = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "syncthing",
+		Subsystem: "events",
+		Name:      "total",
+		Help:      "Total number of created/delivered/dropped events.",
+	}, []string{"event", "state"})
+
+const (
+	metricEventStateCreated   = "created"
+	metricEventStateDelivered = "delivered"
+	metricEventStateDropped   = "dropped"
+)
+
+func init() {
+	prometheus.MustRegister(metricEvents)
+}
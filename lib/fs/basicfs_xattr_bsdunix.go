@@ -0,0 +1,15 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build darwin || freebsd || netbsd
+// +build darwin freebsd netbsd
+
+package fs
+
+import "golang.org/x/sys/unix"
+
+// errNoAttr is returned when asking for an attribute that doesn't exist.
+var errNoAttr = unix.ENOATTR
@@ -0,0 +1,175 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux || darwin || freebsd || netbsd
+// +build linux darwin freebsd netbsd
+
+package fs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"golang.org/x/sys/unix"
+)
+
+func (f *BasicFilesystem) GetXattr(path string, xattrFilter XattrFilter) ([]protocol.Xattr, error) {
+	path, err := f.rooted(path)
+	if err != nil {
+		return nil, fmt.Errorf("get xattr %s: %w", path, err)
+	}
+
+	attrs, err := listXattr(path)
+	if err != nil {
+		return nil, fmt.Errorf("get xattr %s: %w", path, err)
+	}
+
+	res := make([]protocol.Xattr, 0, len(attrs))
+	var val, buf []byte
+	var totSize int
+	for _, attr := range attrs {
+		if !xattrFilter.Permit(attr) {
+			l.Debugf("get xattr %s: skipping attribute %q denied by filter", path, attr)
+			continue
+		}
+		val, buf, err = getXattr(path, attr, buf)
+		if errors.Is(err, errNoAttr) {
+			// The attribute was removed after we listed it.
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("get xattr %s: %w", path, err)
+		}
+		if max := xattrFilter.GetMaxSingleEntrySize(); max > 0 && len(attr)+len(val) > max {
+			l.Debugf("get xattr %s: attribute %q exceeds max size", path, attr)
+			continue
+		}
+		totSize += len(attr) + len(val)
+		if max := xattrFilter.GetMaxTotalSize(); max > 0 && totSize > max {
+			l.Debugf("get xattr %s: attribute %q would cause max size to be exceeded", path, attr)
+			continue
+		}
+		res = append(res, protocol.Xattr{
+			Name:  attr,
+			Value: val,
+		})
+	}
+	return res, nil
+}
+
+func (f *BasicFilesystem) SetXattr(path string, xattrs []protocol.Xattr, xattrFilter XattrFilter) error {
+	path, err := f.rooted(path)
+	if err != nil {
+		return fmt.Errorf("set xattrs %s: %w", path, err)
+	}
+
+	// Index the new attribute set
+	xattrsIdx := make(map[string]int, len(xattrs))
+	for i, xa := range xattrs {
+		xattrsIdx[xa.Name] = i
+	}
+
+	// Remove any existing attributes that are permitted by the filter but
+	// not part of the new set. Attributes denied by the filter are none of
+	// our business and are left alone.
+	current, err := listXattr(path)
+	if err != nil {
+		return fmt.Errorf("set xattrs %s: %w", path, err)
+	}
+	for _, attr := range current {
+		if _, ok := xattrsIdx[attr]; ok || !xattrFilter.Permit(attr) {
+			continue
+		}
+		if err := unix.Lremovexattr(path, attr); err != nil && !errors.Is(err, errNoAttr) {
+			return fmt.Errorf("set xattrs %s: remove %q: %w", path, attr, err)
+		}
+	}
+
+	// Set the new attributes, skipping those that are already correct
+	var val, buf []byte
+	for _, xa := range xattrs {
+		if !xattrFilter.Permit(xa.Name) {
+			l.Debugf("set xattr %s: skipping attribute %q denied by filter", path, xa.Name)
+			continue
+		}
+		val, buf, err = getXattr(path, xa.Name, buf)
+		if err == nil && bytes.Equal(val, xa.Value) {
+			continue
+		}
+		if err := unix.Lsetxattr(path, xa.Name, xa.Value, 0); err != nil {
+			return fmt.Errorf("set xattrs %s: set %q: %w", path, xa.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// listXattr returns the sorted names of the extended attributes of the
+// given path, not following symlinks.
+func listXattr(path string) ([]string, error) {
+	buf := make([]byte, 1024)
+	for {
+		size, err := unix.Llistxattr(path, buf)
+		if errors.Is(err, syscall.ERANGE) {
+			// Buffer is too small. Try again with a zero sized buffer to
+			// get the size, then allocate a buffer of the correct size.
+			size, err = unix.Llistxattr(path, nil)
+			if err != nil {
+				return nil, err
+			}
+			buf = make([]byte, size)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		buf = buf[:size]
+		break
+	}
+
+	var attrs []string
+	for _, attr := range strings.Split(string(buf), "\x00") {
+		if attr != "" {
+			attrs = append(attrs, attr)
+		}
+	}
+	sort.Strings(attrs)
+	return attrs, nil
+}
+
+// getXattr returns the value of the given attribute, and the (possibly
+// reallocated) buffer that was used to read it, for reuse.
+func getXattr(path, name string, buf []byte) (val []byte, rest []byte, err error) {
+	if len(buf) == 0 {
+		buf = make([]byte, 1024)
+	}
+	size, err := unix.Lgetxattr(path, name, buf)
+	if errors.Is(err, syscall.ERANGE) {
+		// Buffer was too small. Figure out how large it needs to be, and
+		// allocate.
+		size, err = unix.Lgetxattr(path, name, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if size > len(buf) {
+			buf = make([]byte, size)
+		}
+		size, err = unix.Lgetxattr(path, name, buf)
+	}
+	if err != nil {
+		return nil, buf, err
+	}
+	// Copy the value out of the buffer, as the buffer is reused for the
+	// next attribute.
+	val = make([]byte, size)
+	copy(val, buf[:size])
+	return val, buf, nil
+}
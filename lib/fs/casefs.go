@@ -16,6 +16,8 @@ import (
 
 	lru "github.com/hashicorp/golang-lru"
 	"golang.org/x/text/unicode/norm"
+
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 const (
@@ -154,6 +156,20 @@ func (f *caseFilesystem) Lchown(name string, uid, gid int) error {
 	return f.Filesystem.Lchown(name, uid, gid)
 }
 
+func (f *caseFilesystem) GetXattr(name string, xattrFilter XattrFilter) ([]protocol.Xattr, error) {
+	if err := f.checkCase(name); err != nil {
+		return nil, err
+	}
+	return f.Filesystem.GetXattr(name, xattrFilter)
+}
+
+func (f *caseFilesystem) SetXattr(name string, xattrs []protocol.Xattr, xattrFilter XattrFilter) error {
+	if err := f.checkCase(name); err != nil {
+		return err
+	}
+	return f.Filesystem.SetXattr(name, xattrs, xattrFilter)
+}
+
 func (f *caseFilesystem) Chtimes(name string, atime time.Time, mtime time.Time) error {
 	if err := f.checkCase(name); err != nil {
 		return err
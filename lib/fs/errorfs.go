@@ -9,6 +9,8 @@ package fs
 import (
 	"context"
 	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 type errorFilesystem struct {
@@ -49,6 +51,12 @@ func (fs *errorFilesystem) Options() []Option {
 	return nil
 }
 func (fs *errorFilesystem) SameFile(fi1, fi2 FileInfo) bool { return false }
+func (fs *errorFilesystem) GetXattr(path string, xattrFilter XattrFilter) ([]protocol.Xattr, error) {
+	return nil, fs.err
+}
+func (fs *errorFilesystem) SetXattr(path string, xattrs []protocol.Xattr, xattrFilter XattrFilter) error {
+	return fs.err
+}
 func (fs *errorFilesystem) Watch(path string, ignore Matcher, ctx context.Context, ignorePerms bool) (<-chan Event, <-chan error, error) {
 	return nil, nil, fs.err
 }
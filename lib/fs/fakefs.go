@@ -16,11 +16,14 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 // see readShortAt()
@@ -178,6 +181,7 @@ type fakeEntry struct {
 	mtime     time.Time
 	children  map[string]*fakeEntry
 	content   []byte
+	xattrs    []protocol.Xattr
 }
 
 func (fs *fakeFS) entryForName(name string) *fakeEntry {
@@ -656,6 +660,48 @@ func (fs *fakeFS) SameFile(fi1, fi2 FileInfo) bool {
 	return ok && fi1.ModTime().Equal(fi2.ModTime()) && fi1.Mode() == fi2.Mode() && fi1.IsDir() == fi2.IsDir() && fi1.IsRegular() == fi2.IsRegular() && fi1.IsSymlink() == fi2.IsSymlink() && fi1.Owner() == fi2.Owner() && fi1.Group() == fi2.Group()
 }
 
+func (fs *fakeFS) GetXattr(name string, xattrFilter XattrFilter) ([]protocol.Xattr, error) {
+	fs.mut.Lock()
+	defer fs.mut.Unlock()
+	time.Sleep(fs.latency)
+	entry := fs.entryForName(name)
+	if entry == nil {
+		return nil, os.ErrNotExist
+	}
+	res := make([]protocol.Xattr, 0, len(entry.xattrs))
+	for _, xa := range entry.xattrs {
+		if xattrFilter.Permit(xa.Name) {
+			res = append(res, xa)
+		}
+	}
+	return res, nil
+}
+
+func (fs *fakeFS) SetXattr(name string, xattrs []protocol.Xattr, xattrFilter XattrFilter) error {
+	fs.mut.Lock()
+	defer fs.mut.Unlock()
+	time.Sleep(fs.latency)
+	entry := fs.entryForName(name)
+	if entry == nil {
+		return os.ErrNotExist
+	}
+	// Keep the attributes we're not allowed to touch, replace the rest.
+	var res []protocol.Xattr
+	for _, xa := range entry.xattrs {
+		if !xattrFilter.Permit(xa.Name) {
+			res = append(res, xa)
+		}
+	}
+	for _, xa := range xattrs {
+		if xattrFilter.Permit(xa.Name) {
+			res = append(res, xa)
+		}
+	}
+	sort.Slice(res, func(a, b int) bool { return res[a].Name < res[b].Name })
+	entry.xattrs = res
+	return nil
+}
+
 func (fs *fakeFS) underlying() (Filesystem, bool) {
 	return nil, false
 }
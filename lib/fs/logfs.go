@@ -12,6 +12,8 @@ import (
 	"path/filepath"
 	"runtime"
 	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 type logFilesystem struct {
@@ -164,6 +166,18 @@ func (fs *logFilesystem) Usage(name string) (Usage, error) {
 	return usage, err
 }
 
+func (fs *logFilesystem) GetXattr(name string, xattrFilter XattrFilter) ([]protocol.Xattr, error) {
+	attrs, err := fs.Filesystem.GetXattr(name, xattrFilter)
+	l.Debugln(getCaller(), fs.Type(), fs.URI(), "GetXattr", name, attrs, err)
+	return attrs, err
+}
+
+func (fs *logFilesystem) SetXattr(name string, xattrs []protocol.Xattr, xattrFilter XattrFilter) error {
+	err := fs.Filesystem.SetXattr(name, xattrs, xattrFilter)
+	l.Debugln(getCaller(), fs.Type(), fs.URI(), "SetXattr", name, xattrs, err)
+	return err
+}
+
 func (fs *logFilesystem) underlying() (Filesystem, bool) {
 	return fs.Filesystem, true
 }
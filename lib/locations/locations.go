@@ -28,6 +28,7 @@ const (
 	HTTPSCertFile LocationEnum = "httpsCertFile"
 	HTTPSKeyFile  LocationEnum = "httpsKeyFile"
 	Database      LocationEnum = "database"
+	DatabaseBolt  LocationEnum = "databaseBolt"
 	LogFile       LocationEnum = "logFile"
 	CsrfTokens    LocationEnum = "csrfTokens"
 	PanicLog      LocationEnum = "panicLog"
@@ -35,6 +36,7 @@ const (
 	GUIAssets     LocationEnum = "GUIAssets"
 	DefFolder     LocationEnum = "defFolder"
 	FailuresFile  LocationEnum = "FailuresFile"
+	OnDemandCache LocationEnum = "onDemandCache"
 )
 
 type BaseDirEnum string
@@ -47,6 +49,7 @@ const (
 	UserHomeBaseDir BaseDirEnum = "userHome"
 
 	LevelDBDir = "index-v0.14.0.db"
+	BoltFile   = "index-v0.14.0.bolt"
 )
 
 // Platform dependent directories
@@ -98,6 +101,7 @@ var locationTemplates = map[LocationEnum]string{
 	HTTPSCertFile: "${config}/https-cert.pem",
 	HTTPSKeyFile:  "${config}/https-key.pem",
 	Database:      "${data}/" + LevelDBDir,
+	DatabaseBolt:  "${data}/" + BoltFile,
 	LogFile:       "${data}/syncthing.log", // --logfile on Windows
 	CsrfTokens:    "${data}/csrftokens.txt",
 	PanicLog:      "${data}/panic-${timestamp}.log",
@@ -105,6 +109,7 @@ var locationTemplates = map[LocationEnum]string{
 	GUIAssets:     "${config}/gui",
 	DefFolder:     "${userHome}/Sync",
 	FailuresFile:  "${data}/failures-unreported.txt",
+	OnDemandCache: "${data}/ondemand-cache",
 }
 
 var locations = make(map[LocationEnum]string)
@@ -0,0 +1,162 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// A Conflict is a file with one or more conflict copies next to it.
+type Conflict struct {
+	Name     string            `json:"name"`
+	Versions []ConflictVersion `json:"versions"`
+}
+
+// A ConflictVersion is one of the competing versions of a conflicted file,
+// that is the file itself or one of its conflict copies.
+type ConflictVersion struct {
+	Name       string          `json:"name"`
+	ModifiedBy string          `json:"modifiedBy"`
+	ModTime    time.Time       `json:"modTime"`
+	Size       int64           `json:"size"`
+	Version    protocol.Vector `json:"version"`
+}
+
+func newConflictVersion(f protocol.FileIntf) ConflictVersion {
+	return ConflictVersion{
+		Name:       f.FileName(),
+		ModifiedBy: f.FileModifiedBy().String(),
+		ModTime:    f.ModTime(),
+		Size:       f.FileSize(),
+		Version:    f.FileVersion(),
+	}
+}
+
+// conflictCopyExp matches the names created by conflictName.
+var conflictCopyExp = regexp.MustCompile(`^(.*)\.sync-conflict-\d{8}-\d{6}-[A-Z0-9]*(\.[^.\\/]*)?$`)
+
+// conflictOriginal returns the name of the file the given conflict copy
+// was made of, or false if it isn't a conflict copy.
+func conflictOriginal(name string) (string, bool) {
+	m := conflictCopyExp.FindStringSubmatch(name)
+	if m == nil {
+		return "", false
+	}
+	return m[1] + m[2], true
+}
+
+func (m *model) FolderConflicts(folder string) ([]Conflict, error) {
+	snap, err := m.DBSnapshot(folder)
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+
+	copies := make(map[string][]ConflictVersion)
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(f protocol.FileIntf) bool {
+		if f.IsDeleted() || f.IsInvalid() || f.IsDirectory() {
+			return true
+		}
+		if orig, ok := conflictOriginal(f.FileName()); ok {
+			copies[orig] = append(copies[orig], newConflictVersion(f))
+		}
+		return true
+	})
+
+	conflicts := make([]Conflict, 0, len(copies))
+	for name, versions := range copies {
+		c := Conflict{Name: name}
+		if f, ok := snap.Get(protocol.LocalDeviceID, name); ok && !f.IsDeleted() && !f.IsInvalid() {
+			c.Versions = append(c.Versions, newConflictVersion(f))
+		}
+		sort.Slice(versions, func(a, b int) bool {
+			return versions[a].Name < versions[b].Name
+		})
+		c.Versions = append(c.Versions, versions...)
+		conflicts = append(conflicts, c)
+	}
+	sort.Slice(conflicts, func(a, b int) bool {
+		return conflicts[a].Name < conflicts[b].Name
+	})
+	return conflicts, nil
+}
+
+// ResolveConflict resolves the conflict on the given file by keeping the
+// version with the given name, which is either the file itself or one of
+// its conflict copies. The other versions are archived if the folder has a
+// versioner, and removed otherwise.
+func (m *model) ResolveConflict(folder, name, keep string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return runner.ResolveConflict(name, keep)
+}
+
+// ResolveConflict runs the conflict resolution in the folder's serve loop,
+// so that it can't race with the puller writing the same files.
+func (f *folder) ResolveConflict(name, keep string) error {
+	<-f.initialScanFinished
+	return f.doInSync(func() error { return f.resolveConflict(name, keep) })
+}
+
+func (f *folder) resolveConflict(name, keep string) error {
+	copies := existingConflicts(name, f.mtimefs)
+	if len(copies) == 0 {
+		return fmt.Errorf("%q has no conflicts", name)
+	}
+	// Only ever replace the file with a conflict copy that actually
+	// exists, so that a bad request can't make us remove the original.
+	if keep != name {
+		found := false
+		for _, c := range copies {
+			if c == keep {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%q is not a conflict copy of %q", keep, name)
+		}
+	}
+
+	discard := func(name string) error {
+		if f.versioner != nil {
+			return f.versioner.Archive(name)
+		}
+		return f.mtimefs.Remove(name)
+	}
+
+	if keep != name {
+		if err := discard(name); err != nil && !fs.IsNotExist(err) {
+			return err
+		}
+		if err := osutil.RenameOrCopy(f.CopyRangeMethod, f.mtimefs, f.mtimefs, keep, name); err != nil {
+			return err
+		}
+	}
+	for _, c := range copies {
+		if c == keep {
+			continue
+		}
+		if err := discard(c); err != nil && !fs.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return f.scanSubdirs(append([]string{name}, copies...))
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var errNoDeletionHold = errors.New("folder has no deletions on hold")
+
+// A DeletionHold describes remote deletions exceeding the folder's mass
+// deletion threshold. While it exists the folder doesn't pull, until an
+// operator approves or rejects the deletions.
+type DeletionHold struct {
+	Device      protocol.DeviceID `json:"device"`
+	Deletes     int               `json:"deletes"`
+	GlobalItems int               `json:"globalItems"`
+	Since       time.Time         `json:"since"`
+}
+
+// The hold is kept in the database, so that it survives restarts.
+func deletionHoldKey(folder string) string {
+	return "deletionHold-" + folder
+}
+
+func loadDeletionHold(kv *db.NamespacedKV, folder string) (DeletionHold, bool) {
+	bs, ok, err := kv.Bytes(deletionHoldKey(folder))
+	if err != nil || !ok {
+		return DeletionHold{}, false
+	}
+	var hold DeletionHold
+	if err := json.Unmarshal(bs, &hold); err != nil {
+		l.Debugf("Discarding invalid deletion hold for %v: %v", folder, err)
+		return DeletionHold{}, false
+	}
+	return hold, true
+}
+
+func (f *folder) deletionHoldKV() *db.NamespacedKV {
+	return db.NewMiscDataNamespace(f.model.db)
+}
+
+func (f *folder) deletionHold() (DeletionHold, bool) {
+	return loadDeletionHold(f.deletionHoldKV(), f.ID)
+}
+
+// checkRemoteDeletions is called after an index update from the given
+// device was applied, and puts the folder on hold if the resulting pending
+// deletions exceed the configured threshold.
+func (f *folder) checkRemoteDeletions(device protocol.DeviceID) {
+	if f.Type == config.FolderTypeSendOnly || (f.MaxRemoteDeletes == 0 && f.MaxRemoteDeletesPct == 0) {
+		return
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return
+	}
+	deletes := snap.NeedSizeIn(protocol.LocalDeviceID, f.selection).Deleted
+	global := snap.GlobalSizeIn(f.selection)
+	snap.Release()
+
+	// The global counts already reflect the deletions, add them back to
+	// get the number of items before they happened.
+	total := global.Files + global.Directories + global.Symlinks + deletes
+	if !f.ExceedsRemoteDeletes(deletes, total) {
+		return
+	}
+
+	f.holdMut.Lock()
+	defer f.holdMut.Unlock()
+
+	hold, held := f.deletionHold()
+	if !held {
+		hold = DeletionHold{
+			Device: device,
+			Since:  time.Now().Truncate(time.Second),
+		}
+	}
+	hold.Deletes = deletes
+	hold.GlobalItems = total
+	bs, err := json.Marshal(hold)
+	if err != nil {
+		return
+	}
+	if err := f.deletionHoldKV().PutBytes(deletionHoldKey(f.ID), bs); err != nil {
+		l.Warnf("Failed to store deletion hold for folder %v: %v", f.Description(), err)
+		return
+	}
+	if held {
+		return
+	}
+
+	l.Warnf("Folder %v: device %v deleted %d of %d items, holding deletions until approved", f.Description(), device.Short(), deletes, total)
+	f.evLogger.Log(events.FolderDeletionHold, map[string]interface{}{
+		"folder":      f.ID,
+		"device":      device.String(),
+		"deletes":     deletes,
+		"globalItems": total,
+	})
+}
+
+func (f *folder) clearDeletionHold() error {
+	f.holdMut.Lock()
+	defer f.holdMut.Unlock()
+	if _, ok := f.deletionHold(); !ok {
+		return errNoDeletionHold
+	}
+	return f.deletionHoldKV().Delete(deletionHoldKey(f.ID))
+}
+
+// ApproveDeletions lifts the deletion hold, letting the pending deletions
+// be applied with the next pull.
+func (f *folder) ApproveDeletions() error {
+	if err := f.clearDeletionHold(); err != nil {
+		return err
+	}
+	l.Infof("Folder %v: held deletions approved", f.Description())
+	f.SchedulePull()
+	return nil
+}
+
+// RejectDeletions lifts the deletion hold after restoring the deleted items
+// cluster wide, by making our versions of them the newest.
+func (f *folder) RejectDeletions() error {
+	if _, ok := f.deletionHold(); !ok {
+		return errNoDeletionHold
+	}
+	if err := f.doInSync(f.rejectRemoteDeletions); err != nil {
+		return err
+	}
+	if err := f.clearDeletionHold(); err != nil {
+		return err
+	}
+	l.Infof("Folder %v: held deletions rejected", f.Description())
+	f.SchedulePull()
+	return nil
+}
+
+func (f *folder) rejectRemoteDeletions() error {
+	batch := db.NewFileInfoBatch(func(files []protocol.FileInfo) error {
+		f.updateLocals(files)
+		return nil
+	})
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	snap.WithNeed(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		need := fi.(protocol.FileInfo)
+		if !need.IsDeleted() || !f.selection.Contains(need.Name) {
+			return true
+		}
+		_ = batch.FlushIfFull()
+
+		have, ok := snap.Get(protocol.LocalDeviceID, need.Name)
+		if !ok || have.IsDeleted() || have.IsInvalid() {
+			return true
+		}
+		have.Version = have.Version.Merge(need.Version).Update(f.shortID)
+		have.Sequence = 0
+		batch.Append(have)
+		return true
+	})
+	return batch.Flush()
+}
@@ -46,9 +46,11 @@ type folder struct {
 	shortID       protocol.ShortID
 	fset          *db.FileSet
 	ignores       *ignore.Matcher
+	selection     db.PathSelection // the selected paths, with selective sync
 	mtimefs       fs.Filesystem
 	modTimeWindow time.Duration
 	ctx           context.Context // used internally, only accessible on serve lifetime
+	pullCtx       context.Context // ctx, or limited to the sync window while pulling
 	done          chan struct{}   // used externally, accessible regardless of serve
 
 	scanInterval           time.Duration
@@ -59,10 +61,21 @@ type folder struct {
 	versionCleanupInterval time.Duration
 	versionCleanupTimer    *time.Timer
 
+	scrubInterval  time.Duration
+	scrubTimer     *time.Timer
+	scrubScheduled chan struct{}
+	scrubProgress  *scrubProgress // of the running scrub, if any
+
 	pullScheduled chan struct{}
 	pullPause     time.Duration
 	pullFailTimer *time.Timer
 
+	syncWindowTimer   *time.Timer // fires when the next sync window opens
+	syncWindowWaiting bool        // a pull is due once the sync window opens
+	scanDeferred      bool        // a scan is due once the sync window opens
+
+	holdMut sync.Mutex // serializes changes to the deletion hold
+
 	scanErrors []FileError
 	pullErrors []FileError
 	errorsMut  sync.Mutex
@@ -103,6 +116,7 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 		shortID:       model.shortID,
 		fset:          fset,
 		ignores:       ignores,
+		selection:     db.NewPathSelection(selectedPaths(cfg)),
 		mtimefs:       fset.MtimeFS(cfg.Filesystem()),
 		modTimeWindow: cfg.ModTimeWindow(),
 		done:          make(chan struct{}),
@@ -115,8 +129,13 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 		versionCleanupInterval: time.Duration(cfg.Versioning.CleanupIntervalS) * time.Second,
 		versionCleanupTimer:    time.NewTimer(time.Duration(cfg.Versioning.CleanupIntervalS) * time.Second),
 
+		scrubInterval:  time.Duration(cfg.ScrubIntervalS) * time.Second,
+		scrubScheduled: make(chan struct{}, 1),
+
 		pullScheduled: make(chan struct{}, 1), // This needs to be 1-buffered so that we queue a pull if we're busy when it comes.
 
+		holdMut: sync.NewMutex(),
+
 		errorsMut: sync.NewMutex(),
 
 		doInSyncChan: make(chan syncRequest),
@@ -134,6 +153,10 @@ func newFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg conf
 	f.pullPause = f.pullBasePause()
 	f.pullFailTimer = time.NewTimer(0)
 	<-f.pullFailTimer.C
+	f.syncWindowTimer = time.NewTimer(0)
+	<-f.syncWindowTimer.C
+	f.scrubTimer = time.NewTimer(0)
+	<-f.scrubTimer.C
 	return f
 }
 
@@ -142,6 +165,7 @@ func (f *folder) Serve(ctx context.Context) error {
 	defer atomic.AddInt32(&f.model.foldersRunning, -1)
 
 	f.ctx = ctx
+	f.pullCtx = ctx
 
 	l.Debugln(f, "starting")
 	defer l.Debugln(f, "exiting")
@@ -149,6 +173,8 @@ func (f *folder) Serve(ctx context.Context) error {
 	defer func() {
 		f.scanTimer.Stop()
 		f.versionCleanupTimer.Stop()
+		f.syncWindowTimer.Stop()
+		f.scrubTimer.Stop()
 		f.setState(FolderIdle)
 	}()
 
@@ -164,6 +190,10 @@ func (f *folder) Serve(ctx context.Context) error {
 		}
 	}
 
+	if next, ok := f.scrubDelay(); ok {
+		f.scrubTimer.Reset(next)
+	}
+
 	initialCompleted := f.initialScanFinished
 
 	for {
@@ -194,6 +224,9 @@ func (f *folder) Serve(ctx context.Context) error {
 			err = f.handleForcedRescans()
 
 		case <-f.scanTimer.C:
+			if f.deferScan() {
+				break
+			}
 			l.Debugln(f, "Scanning due to timer")
 			err = f.scanTimerFired()
 
@@ -211,9 +244,21 @@ func (f *folder) Serve(ctx context.Context) error {
 			f.scanTimer.Reset(0)
 
 		case fsEvents := <-f.watchChan:
+			if f.deferScan() {
+				break
+			}
 			l.Debugln(f, "Scan due to watcher")
 			err = f.scanSubdirs(fsEvents)
 
+		case <-f.syncWindowTimer.C:
+			l.Debugln(f, "Sync window opened")
+			f.syncWindowWaiting = false
+			if f.scanDeferred {
+				f.scanDeferred = false
+				f.scanTimer.Reset(0)
+			}
+			_, err = f.pull()
+
 		case <-f.restartWatchChan:
 			l.Debugln(f, "Restart watcher")
 			err = f.restartWatch()
@@ -221,6 +266,14 @@ func (f *folder) Serve(ctx context.Context) error {
 		case <-f.versionCleanupTimer.C:
 			l.Debugln(f, "Doing version cleanup")
 			f.versionCleanupTimerFired()
+
+		case <-f.scrubTimer.C:
+			l.Debugln(f, "Scrubbing due to timer")
+			err = f.scrubTimerFired()
+
+		case <-f.scrubScheduled:
+			l.Debugln(f, "Scrub was scheduled")
+			err = f.scrubRequested()
 		}
 
 		if err != nil {
@@ -306,6 +359,48 @@ func (f *folder) Reschedule() {
 	f.scanTimer.Reset(interval)
 }
 
+// outsideSyncWindow returns whether the folder is outside its sync windows,
+// in which case it's set to wait for the next one to open.
+func (f *folder) outsideSyncWindow() bool {
+	now := time.Now()
+	if f.InSyncWindow(now) {
+		f.syncWindowWaiting = false
+		return false
+	}
+	f.syncWindowTimer.Stop()
+	select {
+	case <-f.syncWindowTimer.C:
+	default:
+	}
+	if next := f.NextSyncWindow(now); !next.IsZero() {
+		f.syncWindowTimer.Reset(next.Sub(now))
+	}
+	f.syncWindowWaiting = true
+	f.setState(FolderSyncWindowWaiting)
+	return true
+}
+
+// setIdle sets the folder idle, or back to waiting for the sync window if
+// that's what it was doing.
+func (f *folder) setIdle() {
+	if f.syncWindowWaiting {
+		f.setState(FolderSyncWindowWaiting)
+		return
+	}
+	f.setState(FolderIdle)
+}
+
+// deferScan returns true if scans are restricted to the sync windows and
+// we're outside of them, remembering to scan once the next window opens.
+func (f *folder) deferScan() bool {
+	if !f.ScanInSyncWindowsOnly || !f.outsideSyncWindow() {
+		return false
+	}
+	l.Debugln(f, "deferring scan until the sync window opens")
+	f.scanDeferred = true
+	return true
+}
+
 func (f *folder) getHealthErrorAndLoadIgnores() error {
 	if err := f.getHealthErrorWithoutIgnores(); err != nil {
 		return err
@@ -327,6 +422,10 @@ func (f *folder) getHealthErrorWithoutIgnores() error {
 	}
 
 	dbPath := locations.Get(locations.Database)
+	if f.model.cfg.Options().DatabaseBackend == config.DatabaseBackendBolt {
+		// The bolt database is a single file, in the same data directory.
+		dbPath = filepath.Dir(locations.Get(locations.DatabaseBolt))
+	}
 	if usage, err := fs.NewFilesystem(fs.FilesystemTypeBasic, dbPath).Usage("."); err == nil {
 		if err = config.CheckFreeSpace(f.model.cfg.Options().MinHomeDiskFree, usage); err != nil {
 			return fmt.Errorf("insufficient space on disk for database (%v): %w", dbPath, err)
@@ -364,6 +463,9 @@ func (f *folder) pull() (success bool, err error) {
 		return false, err
 	}
 	snap.WithNeed(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
+		if !f.selection.Contains(intf.FileName()) {
+			return true
+		}
 		abort = false
 		return false
 	})
@@ -373,6 +475,29 @@ func (f *folder) pull() (success bool, err error) {
 		f.errorsMut.Lock()
 		f.pullErrors = nil
 		f.errorsMut.Unlock()
+		// Nothing is being deleted anymore either, so there is nothing
+		// left to hold.
+		if err := f.clearDeletionHold(); err == nil {
+			l.Infof("Folder %v: no deletions pending anymore, lifting deletion hold", f.Description())
+		}
+		if !f.scanDeferred {
+			// Only a scan would need the sync window.
+			f.syncWindowWaiting = false
+		}
+		if state, _, _ := f.getState(); (state == FolderSyncWindowWaiting && !f.syncWindowWaiting) || state == FolderDeletionHold {
+			f.setState(FolderIdle)
+		}
+		return true, nil
+	}
+
+	if _, ok := f.deletionHold(); ok {
+		l.Debugln(f, "deferring pull until held deletions are resolved")
+		f.setState(FolderDeletionHold)
+		return true, nil
+	}
+
+	if f.outsideSyncWindow() {
+		l.Debugln(f, "deferring pull until the sync window opens")
 		return true, nil
 	}
 
@@ -396,6 +521,10 @@ func (f *folder) pull() (success bool, err error) {
 	}
 
 	startTime := time.Now()
+	defer func() {
+		metricFolderPulls.WithLabelValues(f.ID).Inc()
+		metricFolderPullSeconds.WithLabelValues(f.ID).Add(time.Since(startTime).Seconds())
+	}()
 
 	// Check if the ignore patterns changed.
 	oldHash := f.ignores.Hash()
@@ -410,12 +539,27 @@ func (f *folder) pull() (success bool, err error) {
 		return false, err
 	}
 
+	// Stop pulling when the sync window closes.
+	if end := f.SyncWindowEnd(time.Now()); !end.IsZero() {
+		var cancel context.CancelFunc
+		f.pullCtx, cancel = context.WithDeadline(f.ctx, end)
+		defer func() {
+			cancel()
+			f.pullCtx = f.ctx
+		}()
+	}
+
 	success, err = f.puller.pull()
 
 	if success && err == nil {
 		return true, nil
 	}
 
+	if f.pullCtx.Err() != nil && f.ctx.Err() == nil && f.outsideSyncWindow() {
+		l.Debugln(f, "sync window closed while pulling")
+		return true, nil
+	}
+
 	// Pulling failed, try again later.
 	delay := f.pullPause + time.Since(startTime)
 	l.Infof("Folder %v isn't making sync progress - retrying in %v.", f.Description(), util.NiceDurationString(delay))
@@ -451,7 +595,7 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	}()
 
 	f.setState(FolderScanWaiting)
-	defer f.setState(FolderIdle)
+	defer f.setIdle()
 
 	if err := f.ioLimiter.TakeWithContext(f.ctx, 1); err != nil {
 		return err
@@ -487,6 +631,11 @@ func (f *folder) scanSubdirs(subDirs []string) error {
 	f.setState(FolderScanning)
 	f.clearScanErrors(subDirs)
 
+	defer func(t0 time.Time) {
+		metricFolderScans.WithLabelValues(f.ID).Inc()
+		metricFolderScanSeconds.WithLabelValues(f.ID).Add(time.Since(t0).Seconds())
+	}(time.Now())
+
 	batch := f.newScanBatch()
 
 	// Schedule a pull after scanning, but only if we actually detected any
@@ -601,7 +750,12 @@ func (b *scanBatch) Update(fi protocol.FileInfo, snap *db.Snapshot) bool {
 			b.Remove(fi.Name)
 			return true
 		}
-	case gf.IsEquivalentOptional(fi, b.f.modTimeWindow, false, false, protocol.FlagLocalReceiveOnly):
+	case gf.IsEquivalentOptional(fi, protocol.FileInfoComparison{
+		ModTimeWindow:   b.f.modTimeWindow,
+		IgnoreFlags:     protocol.FlagLocalReceiveOnly,
+		IgnoreOwnership: !b.f.SyncOwnership,
+		IgnoreXattrs:    !b.f.SyncXattrs,
+	}):
 		// What we have locally is equivalent to the global file.
 		l.Debugf("%v scanning: Merging identical locally changed item with global", b.f, fi)
 		fi = gf
@@ -624,20 +778,24 @@ func (f *folder) scanSubdirsChangedAndNew(subDirs []string, batch *scanBatch) (i
 	defer scanCancel()
 
 	scanConfig := scanner.Config{
-		Folder:                f.ID,
-		Subs:                  subDirs,
-		Matcher:               f.ignores,
-		TempLifetime:          time.Duration(f.model.cfg.Options().KeepTemporariesH) * time.Hour,
-		CurrentFiler:          cFiler{snap},
-		Filesystem:            f.mtimefs,
-		IgnorePerms:           f.IgnorePerms,
-		AutoNormalize:         f.AutoNormalize,
-		Hashers:               f.model.numHashers(f.ID),
-		ShortID:               f.shortID,
-		ProgressTickIntervalS: f.ScanProgressIntervalS,
-		LocalFlags:            f.localFlags,
-		ModTimeWindow:         f.modTimeWindow,
-		EventLogger:           f.evLogger,
+		Folder:                 f.ID,
+		Subs:                   subDirs,
+		Matcher:                f.ignores,
+		TempLifetime:           time.Duration(f.model.cfg.Options().KeepTemporariesH) * time.Hour,
+		CurrentFiler:           cFiler{snap},
+		Filesystem:             f.mtimefs,
+		IgnorePerms:            f.IgnorePerms,
+		AutoNormalize:          f.AutoNormalize,
+		Hashers:                f.model.numHashers(f.ID),
+		ShortID:                f.shortID,
+		ProgressTickIntervalS:  f.ScanProgressIntervalS,
+		LocalFlags:             f.localFlags,
+		ModTimeWindow:          f.modTimeWindow,
+		EventLogger:            f.evLogger,
+		ScanOwnership:          f.SyncOwnership,
+		ScanXattrs:             f.SyncXattrs,
+		XattrFilter:            f.XattrFilter,
+		ContentDefinedChunking: f.model.contentDefinedChunking(f.FolderConfiguration),
 	}
 	var fchan chan scanner.ScanResult
 	if f.Type == config.FolderTypeReceiveEncrypted {
@@ -912,7 +1070,7 @@ func (f *folder) scanTimerFired() error {
 
 func (f *folder) versionCleanupTimerFired() {
 	f.setState(FolderCleanWaiting)
-	defer f.setState(FolderIdle)
+	defer f.setIdle()
 
 	if err := f.ioLimiter.TakeWithContext(f.ctx, 1); err != nil {
 		return
@@ -0,0 +1,155 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/ignore"
+	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/util"
+	"github.com/syncthing/syncthing/lib/versioner"
+)
+
+// A failed mount is retried after this long.
+const onDemandMountRetryInterval = time.Minute
+
+func init() {
+	folderFactories[config.FolderTypeOnDemand] = newOnDemandFolder
+}
+
+// An onDemandFolder doesn't keep a copy of the files. Instead the global
+// index is mounted read-only at the folder path, and file contents are
+// pulled when read, keeping the blocks in a size limited cache. Nothing is
+// scanned or pulled otherwise, so the folder never has any local files.
+type onDemandFolder struct {
+	folder
+}
+
+func newOnDemandFolder(model *model, fset *db.FileSet, ignores *ignore.Matcher, cfg config.FolderConfiguration, _ versioner.Versioner, evLogger events.Logger, ioLimiter *util.Semaphore) service {
+	return &onDemandFolder{
+		folder: newFolder(model, fset, ignores, cfg, evLogger, ioLimiter, nil),
+	}
+}
+
+func (f *onDemandFolder) Serve(ctx context.Context) error {
+	atomic.AddInt32(&f.model.foldersRunning, 1)
+	defer atomic.AddInt32(&f.model.foldersRunning, -1)
+
+	f.ctx = ctx
+	f.pullCtx = ctx
+
+	l.Debugln(f, "starting")
+	defer l.Debugln(f, "exiting")
+
+	// There is nothing to scan.
+	close(f.initialScanFinished)
+
+	var unmount func() error
+	defer func() {
+		if unmount == nil {
+			return
+		}
+		if err := unmount(); err != nil {
+			l.Warnf("Failed to unmount on-demand folder %v: %v", f.Description(), err)
+		}
+	}()
+
+	mountTimer := time.NewTimer(0)
+	defer mountTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			close(f.done)
+			return nil
+
+		case <-mountTimer.C:
+			var err error
+			unmount, err = f.mount()
+			if err != nil {
+				l.Infof("Failed to mount on-demand folder %v: %v", f.Description(), err)
+				f.setError(err)
+				mountTimer.Reset(onDemandMountRetryInterval)
+				continue
+			}
+			l.Infof("Mounted on-demand folder %v at %s", f.Description(), f.Path)
+			f.setError(nil)
+
+		case req := <-f.doInSyncChan:
+			req.err <- req.fn()
+
+		// Changes to the index are visible in the mount right away, and
+		// there is no local data to scan.
+		case <-f.pullScheduled:
+		case <-f.scanScheduled:
+		case <-f.scanDelay:
+		case <-f.forcedRescanRequested:
+		}
+	}
+}
+
+// selectedPaths returns the paths of the folder that are kept locally, as
+// announced to other devices. An on-demand folder doesn't keep any files,
+// which is expressed as selecting only the folder marker, as that is never
+// part of the index. That way the folder doesn't look out of sync.
+func selectedPaths(cfg config.FolderConfiguration) []string {
+	if cfg.Type == config.FolderTypeOnDemand {
+		return []string{config.DefaultMarkerName}
+	}
+	return cfg.SelectedPaths
+}
+
+func (f *onDemandFolder) Scan(_ []string) error {
+	return nil
+}
+
+func (f *onDemandFolder) ScheduleForceRescan(_ string) {}
+
+func (f *onDemandFolder) mount() (func() error, error) {
+	cacheFs := fs.NewFilesystem(fs.FilesystemTypeBasic, f.cacheDir())
+	limit, err := f.cacheLimit(cacheFs)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := newBlockCache(cacheFs, limit)
+	if err != nil {
+		return nil, fmt.Errorf("block cache: %w", err)
+	}
+	return mountOnDemandFS(f.Path, newOnDemandFS(f.model, f.FolderConfiguration, f.fset, cache))
+}
+
+// cacheDir returns the directory of the block cache, one per folder.
+func (f *onDemandFolder) cacheDir() string {
+	return filepath.Join(locations.Get(locations.OnDemandCache), url.PathEscape(f.ID))
+}
+
+// cacheLimit returns the size limit of the block cache in bytes, with a
+// percentage relative to the size of the disk holding the cache.
+func (f *onDemandFolder) cacheLimit(cacheFs fs.Filesystem) (int64, error) {
+	size := f.OnDemandCacheSize
+	if !size.Percentage() {
+		return int64(size.BaseValue()), nil
+	}
+	if err := cacheFs.MkdirAll(".", 0700); err != nil {
+		return 0, err
+	}
+	usage, err := cacheFs.Usage(".")
+	if err != nil {
+		return 0, err
+	}
+	return int64(float64(usage.Total) * size.Value / 100), nil
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sha256"
+)
+
+func TestBlockCache(t *testing.T) {
+	cacheFs := fs.NewFilesystem(fs.FilesystemTypeFake, rand.String(32)+"?content=true")
+	cache, err := newBlockCache(cacheFs, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, aData := testCacheBlock("aaaa")
+	b, bData := testCacheBlock("bbbb")
+	c, cData := testCacheBlock("cccc")
+
+	cache.Put(a, aData)
+	cache.Put(b, bData)
+	// Makes b the least recently used block
+	if data, ok := cache.Get(a); !ok || !bytes.Equal(data, aData) {
+		t.Fatal("block a not cached")
+	}
+	// Exceeds the limit
+	cache.Put(c, cData)
+
+	if _, ok := cache.Get(b); ok {
+		t.Error("block b should have been evicted")
+	}
+	if _, err := cacheFs.Lstat(blockCacheName(b.Hash)); !fs.IsNotExist(err) {
+		t.Error("block b should have been removed from disk, got", err)
+	}
+
+	// Another cache on the same directory picks up the cached blocks.
+	cache, err = newBlockCache(cacheFs, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range []protocol.BlockInfo{a, c} {
+		if _, ok := cache.Get(block); !ok {
+			t.Errorf("block %x not cached", block.Hash)
+		}
+	}
+}
+
+func testCacheBlock(data string) (protocol.BlockInfo, []byte) {
+	hash := sha256.Sum256([]byte(data))
+	return protocol.BlockInfo{Hash: hash[:], Size: len(data)}, []byte(data)
+}
+
+func TestOnDemandFSRead(t *testing.T) {
+	m, fc, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	contents := []byte("on-demand contents")
+	fc.addFile("dir", 0755, protocol.FileInfoTypeDirectory, nil)
+	fc.addFile("dir/file", 0644, protocol.FileInfoTypeFile, contents)
+	fc.sendIndexUpdate()
+
+	cache, err := newBlockCache(fs.NewFilesystem(fs.FilesystemTypeFake, rand.String(32)+"?content=true"), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.fmut.RLock()
+	odfs := newOnDemandFS(m.model, fcfg, m.folderFiles[fcfg.ID], cache)
+	m.fmut.RUnlock()
+
+	if children, err := odfs.readDir(""); err != nil || len(children) != 1 || children[0].Name != "dir" {
+		t.Fatalf("unexpected root listing %v (%v)", children, err)
+	}
+	if children, err := odfs.readDir("dir"); err != nil || len(children) != 1 || children[0].Name != "dir/file" {
+		t.Fatalf("unexpected directory listing %v (%v)", children, err)
+	}
+	if _, ok := odfs.lookup("dir/nonexistent"); ok {
+		t.Error("unexpected lookup of nonexistent file")
+	}
+
+	file, ok := odfs.open("dir/file")
+	if !ok {
+		t.Fatal("file not found")
+	}
+	buf := make([]byte, 100)
+	n, err := odfs.readAt(context.Background(), file, buf, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf[:n], contents[3:]) {
+		t.Errorf("read %q, expected %q", buf[:n], contents[3:])
+	}
+
+	// Once read, the data is served from the cache.
+	fc.RequestCalls(func(context.Context, string, string, int, int64, int, []byte, uint32, bool) ([]byte, error) {
+		return nil, errors.New("unavailable")
+	})
+	n, err = odfs.readAt(context.Background(), file, buf, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf[:n], contents) {
+		t.Errorf("read %q, expected %q", buf[:n], contents)
+	}
+}
+
+func TestOnDemandSelectedPaths(t *testing.T) {
+	// Create the folder, but don't start it, as that mounts it.
+	w, fcfg, wCancel := tmpDefaultWrapper()
+	defer wCancel()
+	fcfg.Type = config.FolderTypeOnDemand
+	setFolder(t, w, fcfg)
+	m := newModel(t, w, myID, "syncthing", "dev", nil)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	cc, _ := m.generateClusterConfig(device1)
+	if len(cc.Folders) != 1 {
+		t.Fatalf("Expected 1 folder in CC, got %v", len(cc.Folders))
+	}
+	sel := db.NewPathSelection(cc.Folders[0].SelectedPaths)
+	if sel.IsEmpty() || sel.Contains("foo") || sel.Contains("dir/foo") {
+		t.Errorf("On-demand folder announced selection %v, expected nothing selected", sel)
+	}
+}
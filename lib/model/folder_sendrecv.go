@@ -177,8 +177,8 @@ func (f *sendReceiveFolder) pull() (bool, error) {
 	var err error
 	for tries := 0; tries < maxPullerIterations; tries++ {
 		select {
-		case <-f.ctx.Done():
-			return false, f.ctx.Err()
+		case <-f.pullCtx.Done():
+			return false, f.pullCtx.Err()
 		default:
 		}
 
@@ -307,6 +307,7 @@ func (f *sendReceiveFolder) pullerIteration(scanChan chan<- string) (int, error)
 	updateWg.Wait()
 
 	f.queue.Reset()
+	metricFolderQueuedFiles.WithLabelValues(f.ID).Set(0)
 
 	return changed, err
 }
@@ -323,7 +324,7 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 	// pile.
 	snap.WithNeed(protocol.LocalDeviceID, func(intf protocol.FileIntf) bool {
 		select {
-		case <-f.ctx.Done():
+		case <-f.pullCtx.Done():
 			return false
 		default:
 		}
@@ -333,6 +334,11 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 			return true
 		}
 
+		if !f.selection.Contains(intf.FileName()) {
+			l.Debugln(f, "skipping unselected file", intf.FileName())
+			return true
+		}
+
 		changed++
 
 		file := intf.(protocol.FileInfo)
@@ -383,7 +389,13 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 
 		case file.Type == protocol.FileInfoTypeFile:
 			curFile, hasCurFile := snap.Get(protocol.LocalDeviceID, file.Name)
-			if hasCurFile && file.BlocksEqual(curFile) {
+			if hasCurFile && f.localWinsConflict(curFile, file) {
+				// Our version is to be kept, there is nothing to pull.
+				// Make it win on the other devices too.
+				f.emitConflict(curFile, file, conflictLocalWins)
+				curFile.Version = curFile.Version.Merge(file.Version).Update(f.shortID)
+				dbUpdateChan <- dbUpdateJob{curFile, dbUpdateHandleFile}
+			} else if hasCurFile && file.BlocksEqual(curFile) {
 				// We are supposed to copy the entire file, and then fetch nothing. We
 				// are only updating metadata, so we don't actually *need* to make the
 				// copy.
@@ -423,8 +435,8 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 	})
 
 	select {
-	case <-f.ctx.Done():
-		return changed, nil, nil, f.ctx.Err()
+	case <-f.pullCtx.Done():
+		return changed, nil, nil, f.pullCtx.Err()
 	default:
 	}
 
@@ -450,8 +462,8 @@ func (f *sendReceiveFolder) processNeeded(snap *db.Snapshot, dbUpdateChan chan<-
 nextFile:
 	for {
 		select {
-		case <-f.ctx.Done():
-			return changed, fileDeletions, dirDeletions, f.ctx.Err()
+		case <-f.pullCtx.Done():
+			return changed, fileDeletions, dirDeletions, f.pullCtx.Err()
 		default:
 		}
 
@@ -459,6 +471,7 @@ nextFile:
 		if !ok {
 			break
 		}
+		metricFolderQueuedFiles.WithLabelValues(f.ID).Set(float64(f.queue.lenQueued()))
 
 		fi, ok := snap.GetGlobal(fileName)
 		if !ok {
@@ -528,7 +541,7 @@ func popCandidate(buckets map[string][]protocol.FileInfo, key string) (protocol.
 func (f *sendReceiveFolder) processDeletions(fileDeletions map[string]protocol.FileInfo, dirDeletions []protocol.FileInfo, snap *db.Snapshot, dbUpdateChan chan<- dbUpdateJob, scanChan chan<- string) {
 	for _, file := range fileDeletions {
 		select {
-		case <-f.ctx.Done():
+		case <-f.pullCtx.Done():
 			return
 		default:
 		}
@@ -539,7 +552,7 @@ func (f *sendReceiveFolder) processDeletions(fileDeletions map[string]protocol.F
 	// Process in reverse order to delete depth first
 	for i := range dirDeletions {
 		select {
-		case <-f.ctx.Done():
+		case <-f.pullCtx.Done():
 			return
 		default:
 		}
@@ -605,7 +618,7 @@ func (f *sendReceiveFolder) handleDir(file protocol.FileInfo, snap *db.Snapshot,
 			// Symlinks aren't checked for conflicts.
 
 			err = f.inWritableDir(func(name string) error {
-				return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
+				return f.handleConflict(name, curFile, file, scanChan)
 			}, curFile.Name)
 		} else {
 			err = f.deleteItemOnDisk(curFile, snap, scanChan)
@@ -623,24 +636,33 @@ func (f *sendReceiveFolder) handleDir(file protocol.FileInfo, snap *db.Snapshot,
 		// not MkdirAll because the parent should already exist.
 		mkdir := func(path string) error {
 			err = f.mtimefs.Mkdir(path, mode)
-			if err != nil || f.IgnorePerms || file.NoPermissions {
+			if err != nil {
 				return err
 			}
 
-			// Copy the parent owner and group, if we are supposed to do that.
-			if err := f.maybeCopyOwner(path); err != nil {
-				return err
-			}
+			if !f.IgnorePerms && !file.NoPermissions {
+				// Copy the parent owner and group, if we are supposed to do that.
+				if err := f.maybeCopyOwner(path); err != nil {
+					return err
+				}
 
-			// Stat the directory so we can check its permissions.
-			info, err := f.mtimefs.Lstat(path)
-			if err != nil {
-				return err
+				// Stat the directory so we can check its permissions.
+				info, err := f.mtimefs.Lstat(path)
+				if err != nil {
+					return err
+				}
+
+				// Mask for the bits we want to preserve and add them in to the
+				// directories permissions.
+				if err := f.mtimefs.Chmod(path, mode|(info.Mode()&retainBits)); err != nil {
+					return err
+				}
 			}
 
-			// Mask for the bits we want to preserve and add them in to the
-			// directories permissions.
-			return f.mtimefs.Chmod(path, mode|(info.Mode()&retainBits))
+			// Set ownership and extended attributes, if we are supposed to
+			// do that. Last, so that the synced owner takes precedence
+			// over the one copied from the parent.
+			return f.setPlatformData(&file, path)
 		}
 
 		if err = f.inWritableDir(mkdir, file.Name); err == nil {
@@ -665,6 +687,10 @@ func (f *sendReceiveFolder) handleDir(file protocol.FileInfo, snap *db.Snapshot,
 			return
 		}
 	}
+	if err := f.setPlatformData(&file, file.Name); err != nil {
+		f.newPullError(file.Name, err)
+		return
+	}
 	dbUpdateChan <- dbUpdateJob{file, dbUpdateHandleDir}
 }
 
@@ -754,7 +780,10 @@ func (f *sendReceiveFolder) handleSymlink(file protocol.FileInfo, snap *db.Snaps
 		if err := f.mtimefs.CreateSymlink(file.SymlinkTarget, path); err != nil {
 			return err
 		}
-		return f.maybeCopyOwner(path)
+		if err := f.maybeCopyOwner(path); err != nil {
+			return err
+		}
+		return f.setPlatformData(&file, path)
 	}
 
 	if err = f.inWritableDir(createLink, file.Name); err == nil {
@@ -787,7 +816,7 @@ func (f *sendReceiveFolder) handleSymlinkCheckExisting(file protocol.FileInfo, s
 		// Directories and symlinks aren't checked for conflicts.
 
 		return f.inWritableDir(func(name string) error {
-			return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
+			return f.handleConflict(name, curFile, file, scanChan)
 		}, curFile.Name)
 	} else {
 		return f.deleteItemOnDisk(curFile, snap, scanChan)
@@ -989,7 +1018,14 @@ func (f *sendReceiveFolder) renameFile(cur, source, target protocol.FileInfo, sn
 	default:
 		var fi protocol.FileInfo
 		if fi, err = scanner.CreateFileInfo(stat, target.Name, f.mtimefs); err == nil {
-			if !fi.IsEquivalentOptional(curTarget, f.modTimeWindow, f.IgnorePerms, true, protocol.LocalAllFlags) {
+			if !fi.IsEquivalentOptional(curTarget, protocol.FileInfoComparison{
+				ModTimeWindow:   f.modTimeWindow,
+				IgnorePerms:     f.IgnorePerms,
+				IgnoreBlocks:    true,
+				IgnoreFlags:     protocol.LocalAllFlags,
+				IgnoreOwnership: true,
+				IgnoreXattrs:    true,
+			}) {
 				// Target changed
 				scanChan <- target.Name
 				err = errModified
@@ -1120,14 +1156,18 @@ func (f *sendReceiveFolder) handleFile(file protocol.FileInfo, snap *db.Snapshot
 }
 
 func (f *sendReceiveFolder) reuseBlocks(blocks []protocol.BlockInfo, reused []int, file protocol.FileInfo, tempName string) ([]protocol.BlockInfo, []int) {
+	if file.HasVariableBlocks() {
+		return f.reuseVariableBlocks(blocks, reused, file, tempName)
+	}
+
 	// Check for an old temporary file which might have some blocks we could
 	// reuse.
-	tempBlocks, err := scanner.HashFile(f.ctx, f.mtimefs, tempName, file.BlockSize(), nil, false)
+	tempBlocks, err := scanner.HashFile(f.pullCtx, f.mtimefs, tempName, file.BlockSize(), nil, false)
 	if err != nil {
 		var caseErr *fs.ErrCaseConflict
 		if errors.As(err, &caseErr) {
 			if rerr := f.mtimefs.Rename(caseErr.Real, tempName); rerr == nil {
-				tempBlocks, err = scanner.HashFile(f.ctx, f.mtimefs, tempName, file.BlockSize(), nil, false)
+				tempBlocks, err = scanner.HashFile(f.pullCtx, f.mtimefs, tempName, file.BlockSize(), nil, false)
 			}
 		}
 	}
@@ -1158,6 +1198,35 @@ func (f *sendReceiveFolder) reuseBlocks(blocks []protocol.BlockInfo, reused []in
 	return blocks, reused
 }
 
+// reuseVariableBlocks is reuseBlocks for files with content defined blocks.
+// Rehashing the temporary file wouldn't reproduce the block boundaries, as
+// it has holes where blocks are missing, so instead each block is checked
+// at its offset.
+func (f *sendReceiveFolder) reuseVariableBlocks(blocks []protocol.BlockInfo, reused []int, file protocol.FileInfo, tempName string) ([]protocol.BlockInfo, []int) {
+	fd, err := f.mtimefs.Open(tempName)
+	if err != nil {
+		return blocks, reused
+	}
+	defer fd.Close()
+
+	buf := protocol.BufferPool.Get(protocol.MinBlockSize)
+	defer func() {
+		protocol.BufferPool.Put(buf)
+	}()
+
+	blocks = blocks[:0]
+	for i, block := range file.Blocks {
+		buf = protocol.BufferPool.Upgrade(buf, block.Size)
+		if _, err := fd.ReadAt(buf, block.Offset); err == nil && verifyBuffer(buf, block) == nil {
+			reused = append(reused, i)
+		} else {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks, reused
+}
+
 // blockDiff returns lists of common and missing (to transform src into tgt)
 // blocks. Both block lists must have been created with the same block size.
 func blockDiff(src, tgt []protocol.BlockInfo) ([]protocol.BlockInfo, []protocol.BlockInfo) {
@@ -1227,6 +1296,11 @@ func (f *sendReceiveFolder) shortcutFile(file protocol.FileInfo, dbUpdateChan ch
 		}
 	}
 
+	if err = f.setPlatformData(&file, file.Name); err != nil {
+		f.newPullError(file.Name, err)
+		return
+	}
+
 	// Still need to re-write the trailer with the new encrypted fileinfo.
 	if f.Type == config.FolderTypeReceiveEncrypted {
 		err = inWritableDir(func(path string) error {
@@ -1252,6 +1326,11 @@ func (f *sendReceiveFolder) shortcutFile(file protocol.FileInfo, dbUpdateChan ch
 	dbUpdateChan <- dbUpdateJob{file, dbUpdateShortcutFile}
 }
 
+// blockSource is a file that blocks are copied from.
+type blockSource struct {
+	folder, name string
+}
+
 // copierRoutine reads copierStates until the in channel closes and performs
 // the relevant copies when possible, or passes it to the puller routine.
 func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan chan<- pullBlockState, out chan<- *sharedPullerState) {
@@ -1261,10 +1340,14 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 	}()
 
 	folderFilesystems := make(map[string]fs.Filesystem)
+	// Folders where the offset of a block can't be derived from its index,
+	// as files may have content defined blocks.
+	variableBlocks := make(map[string]bool)
 	// Hope that it's usually in the same folder, so start with that one.
 	folders := []string{f.folderID}
 	for folder, cfg := range f.model.cfg.Folders() {
 		folderFilesystems[folder] = cfg.Filesystem()
+		variableBlocks[folder] = f.model.mayHaveVariableBlocks(cfg)
 		if folder != f.folderID {
 			folders = append(folders, folder)
 		}
@@ -1290,12 +1373,15 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 		}
 
 		weakHashFinder, file := f.initWeakHashFinder(state)
+		// Blocks of the files we copy from, in folders with variable
+		// blocks, looked up once per file.
+		srcBlocks := make(map[blockSource][]protocol.BlockInfo)
 
 	blocks:
 		for _, block := range state.blocks {
 			select {
-			case <-f.ctx.Done():
-				state.fail(errors.Wrap(f.ctx.Err(), "folder stopped"))
+			case <-f.pullCtx.Done():
+				state.fail(errors.Wrap(f.pullCtx.Err(), "pulling stopped"))
 				break blocks
 			default:
 			}
@@ -1318,7 +1404,7 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 			var found bool
 			if f.Type != config.FolderTypeReceiveEncrypted {
 				found, err = weakHashFinder.Iterate(block.WeakHash, buf, func(offset int64) bool {
-					if f.verifyBuffer(buf, block) != nil {
+					if verifyBuffer(buf, block) != nil {
 						return true
 					}
 
@@ -1349,6 +1435,19 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 					defer fd.Close()
 
 					srcOffset := int64(state.file.BlockSize()) * int64(index)
+					if variableBlocks[folder] {
+						src := blockSource{folder, path}
+						blocks, ok := srcBlocks[src]
+						if !ok {
+							file, _, _ := f.model.CurrentFolderFile(folder, path)
+							blocks = file.Blocks
+							srcBlocks[src] = blocks
+						}
+						if int(index) >= len(blocks) {
+							return false
+						}
+						srcOffset = blocks[index].Offset
+					}
 					_, err = fd.ReadAt(buf, srcOffset)
 					if err != nil {
 						return false
@@ -1358,7 +1457,7 @@ func (f *sendReceiveFolder) copierRoutine(in <-chan copyBlocksState, pullChan ch
 					// case we can't verify the block integrity so we'll take it on
 					// trust. (The other side can and will verify.)
 					if f.Type != config.FolderTypeReceiveEncrypted {
-						if err := f.verifyBuffer(buf, block); err != nil {
+						if err := verifyBuffer(buf, block); err != nil {
 							l.Debugln("Finder failed to verify buffer", err)
 							return false
 						}
@@ -1414,6 +1513,13 @@ func (f *sendReceiveFolder) initWeakHashFinder(state copyBlocksState) (*weakhash
 		return nil, nil
 	}
 
+	if state.file.HasVariableBlocks() {
+		// Weak hashes are rolled over windows of the block size, they
+		// can't find blocks of other sizes.
+		l.Debugf("not weak hashing %s. file has content defined blocks", state.file.Name)
+		return nil, nil
+	}
+
 	blocksPercentChanged := 0
 	if tot := len(state.file.Blocks); tot > 0 {
 		blocksPercentChanged = (tot - state.have) * 100 / tot
@@ -1442,7 +1548,7 @@ func (f *sendReceiveFolder) initWeakHashFinder(state copyBlocksState) (*weakhash
 		return nil, nil
 	}
 
-	weakHashFinder, err := weakhash.NewFinder(f.ctx, file, state.file.BlockSize(), hashesToFind)
+	weakHashFinder, err := weakhash.NewFinder(f.pullCtx, file, state.file.BlockSize(), hashesToFind)
 	if err != nil {
 		l.Debugln("weak hasher", err)
 		return nil, file
@@ -1450,7 +1556,7 @@ func (f *sendReceiveFolder) initWeakHashFinder(state copyBlocksState) (*weakhash
 	return weakHashFinder, file
 }
 
-func (f *sendReceiveFolder) verifyBuffer(buf []byte, block protocol.BlockInfo) error {
+func verifyBuffer(buf []byte, block protocol.BlockInfo) error {
 	if len(buf) != int(block.Size) {
 		return fmt.Errorf("length mismatch %d != %d", len(buf), block.Size)
 	}
@@ -1482,7 +1588,7 @@ func (f *sendReceiveFolder) pullerRoutine(snap *db.Snapshot, in <-chan pullBlock
 		state := state
 		bytes := int(state.block.Size)
 
-		if err := requestLimiter.TakeWithContext(f.ctx, bytes); err != nil {
+		if err := requestLimiter.TakeWithContext(f.pullCtx, bytes); err != nil {
 			state.fail(err)
 			out <- state.sharedPullerState
 			continue
@@ -1523,8 +1629,8 @@ func (f *sendReceiveFolder) pullBlock(state pullBlockState, snap *db.Snapshot, o
 loop:
 	for {
 		select {
-		case <-f.ctx.Done():
-			state.fail(errors.Wrap(f.ctx.Err(), "folder stopped"))
+		case <-f.pullCtx.Done():
+			state.fail(errors.Wrap(f.pullCtx.Err(), "pulling stopped"))
 			break loop
 		default:
 		}
@@ -1550,8 +1656,8 @@ loop:
 		// leastBusy can select another device when someone else asks.
 		activity.using(selected)
 		var buf []byte
-		blockNo := int(state.block.Offset / int64(state.file.BlockSize()))
-		buf, lastError = f.model.requestGlobal(f.ctx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary)
+		blockNo := state.file.BlockIndex(state.block.Offset)
+		buf, lastError = f.model.requestGlobal(f.pullCtx, selected.ID, f.folderID, state.file.Name, blockNo, state.block.Offset, int(state.block.Size), state.block.Hash, state.block.WeakHash, selected.FromTemporary)
 		activity.done(selected)
 		if lastError != nil {
 			l.Debugln("request:", f.folderID, state.file.Name, state.block.Offset, state.block.Size, selected.ID.Short(), "returned error:", lastError)
@@ -1565,7 +1671,7 @@ loop:
 		// integrity so we'll take it on trust. (The other side can and
 		// will verify.)
 		if f.Type != config.FolderTypeReceiveEncrypted {
-			lastError = f.verifyBuffer(buf, state.block)
+			lastError = verifyBuffer(buf, state.block)
 		}
 		if lastError != nil {
 			l.Debugln("request:", f.folderID, state.file.Name, state.block.Offset, state.block.Size, "hash mismatch")
@@ -1597,6 +1703,11 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 		return err
 	}
 
+	// Set extended attributes, if we are supposed to do that.
+	if err := f.setPlatformData(&file, tempName); err != nil {
+		return err
+	}
+
 	if stat, err := f.mtimefs.Lstat(file.Name); err == nil {
 		// There is an old file or directory already in place. We need to
 		// handle that.
@@ -1612,7 +1723,7 @@ func (f *sendReceiveFolder) performFinish(file, curFile protocol.FileInfo, hasCu
 			// Directories and symlinks aren't checked for conflicts.
 
 			err = f.inWritableDir(func(name string) error {
-				return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
+				return f.handleConflict(name, curFile, file, scanChan)
 			}, curFile.Name)
 		} else {
 			err = f.deleteItemOnDisk(curFile, snap, scanChan)
@@ -1806,6 +1917,101 @@ func (f *sendReceiveFolder) inConflict(current, replacement protocol.Vector) boo
 	return false
 }
 
+type conflictOutcome int
+
+const (
+	conflictKeepBoth conflictOutcome = iota
+	conflictRemoteWins
+	conflictLocalWins
+)
+
+func (o conflictOutcome) String() string {
+	switch o {
+	case conflictRemoteWins:
+		return "remote"
+	case conflictLocalWins:
+		return "local"
+	default:
+		return "keepBoth"
+	}
+}
+
+// conflictOutcome decides which side of a conflict between our current
+// version and the incoming replacement is kept, according to the folder's
+// conflict resolution policy.
+func (f *sendReceiveFolder) conflictOutcome(cur, file protocol.FileInfo) conflictOutcome {
+	switch f.ConflictResolution {
+	case config.ConflictResolutionNewest:
+		if cur.ModTime().After(file.ModTime()) {
+			return conflictLocalWins
+		}
+		return conflictRemoteWins
+	case config.ConflictResolutionPreferLocal:
+		return conflictLocalWins
+	case config.ConflictResolutionPreferDevice:
+		preferred := f.ConflictPreferredDevice.Short()
+		switch {
+		case preferred == f.shortID:
+			return conflictLocalWins
+		case file.ModifiedBy == preferred:
+			return conflictRemoteWins
+		case cur.ModifiedBy == preferred:
+			return conflictLocalWins
+		}
+	}
+	return conflictKeepBoth
+}
+
+// localWinsConflict returns true if the replacement is in conflict with
+// our current version of a file, and the latter is to be kept.
+func (f *sendReceiveFolder) localWinsConflict(cur, file protocol.FileInfo) bool {
+	// Local changes aren't sent out from receive only folders, so they
+	// can't win.
+	if f.Type == config.FolderTypeReceiveOnly || cur.IsDeleted() || cur.IsInvalid() || cur.IsDirectory() || cur.IsSymlink() {
+		return false
+	}
+	return f.inConflict(cur.Version, file.Version) && f.conflictOutcome(cur, file) == conflictLocalWins
+}
+
+func (f *sendReceiveFolder) emitConflict(cur, file protocol.FileInfo, outcome conflictOutcome) {
+	f.evLogger.Log(events.ConflictDetected, map[string]interface{}{
+		"folder":           f.folderID,
+		"item":             file.Name,
+		"resolution":       outcome.String(),
+		"localVersion":     cur.Version,
+		"localModifiedBy":  cur.ModifiedBy.String(),
+		"remoteVersion":    file.Version,
+		"remoteModifiedBy": file.ModifiedBy.String(),
+	})
+}
+
+// handleConflict gets the conflicting current item out of the way of the
+// replacement, either by keeping it as a conflict copy or, if the
+// replacement wins outright, by archiving it with the versioner.
+func (f *sendReceiveFolder) handleConflict(name string, cur, file protocol.FileInfo, scanChan chan<- string) error {
+	outcome := f.conflictOutcome(cur, file)
+	if outcome == conflictLocalWins {
+		// We only get here if the local item changed after we decided to
+		// pull, so better keep both.
+		outcome = conflictKeepBoth
+	}
+	f.emitConflict(cur, file, outcome)
+
+	if outcome == conflictKeepBoth {
+		return f.moveForConflict(name, file.ModifiedBy.String(), scanChan)
+	}
+	var err error
+	if f.versioner != nil {
+		err = f.versioner.Archive(name)
+	} else {
+		err = f.mtimefs.Remove(name)
+	}
+	if err != nil && !fs.IsNotExist(err) {
+		return errors.Wrap(err, contextRemovingOldItem)
+	}
+	return nil
+}
+
 func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan chan<- string) error {
 	if isConflict(name) {
 		l.Infoln("Conflict for", name, "which is already a conflict copy; not copying again.")
@@ -1849,8 +2055,8 @@ func (f *sendReceiveFolder) moveForConflict(name, lastModBy string, scanChan cha
 }
 
 func (f *sendReceiveFolder) newPullError(path string, err error) {
-	if errors.Cause(err) == f.ctx.Err() {
-		// Error because the folder stopped - no point logging/tracking
+	if errors.Cause(err) == f.pullCtx.Err() {
+		// Error because the folder or pulling stopped - no point logging/tracking
 		return
 	}
 
@@ -1972,7 +2178,14 @@ func (f *sendReceiveFolder) deleteDirOnDiskHandleChildren(dir string, snap *db.S
 			hasToBeScanned = true
 			return nil
 		}
-		if !cf.IsEquivalentOptional(diskFile, f.modTimeWindow, f.IgnorePerms, true, protocol.LocalAllFlags) {
+		if !cf.IsEquivalentOptional(diskFile, protocol.FileInfoComparison{
+			ModTimeWindow:   f.modTimeWindow,
+			IgnorePerms:     f.IgnorePerms,
+			IgnoreBlocks:    true,
+			IgnoreFlags:     protocol.LocalAllFlags,
+			IgnoreOwnership: true,
+			IgnoreXattrs:    true,
+		}) {
 			// File on disk changed compared to what we have in db
 			// -> schedule scan.
 			scanChan <- path
@@ -2041,7 +2254,14 @@ func (f *sendReceiveFolder) scanIfItemChanged(name string, stat fs.FileInfo, ite
 		return errors.Wrap(err, "comparing item on disk to db")
 	}
 
-	if !statItem.IsEquivalentOptional(item, f.modTimeWindow, f.IgnorePerms, true, protocol.LocalAllFlags) {
+	if !statItem.IsEquivalentOptional(item, protocol.FileInfoComparison{
+		ModTimeWindow:   f.modTimeWindow,
+		IgnorePerms:     f.IgnorePerms,
+		IgnoreBlocks:    true,
+		IgnoreFlags:     protocol.LocalAllFlags,
+		IgnoreOwnership: true,
+		IgnoreXattrs:    true,
+	}) {
 		return errModified
 	}
 
@@ -2094,6 +2314,50 @@ func (f *sendReceiveFolder) maybeCopyOwner(path string) error {
 	return nil
 }
 
+// setPlatformData applies the platform specific metadata of the file, such
+// as ownership and extended attributes, to the item at path, if we are
+// supposed to do that.
+func (f *sendReceiveFolder) setPlatformData(file *protocol.FileInfo, path string) error {
+	if f.SyncOwnership {
+		if err := f.syncOwnership(file, path); err != nil {
+			return err
+		}
+	}
+	if f.SyncXattrs {
+		if err := f.mtimefs.SetXattr(path, file.Platform.Xattrs(), f.XattrFilter); errors.Is(err, fs.ErrXattrsNotSupported) {
+			l.Debugf("%v: cannot set xattrs on %q: %v", f, file.Name, err)
+		} else if err != nil {
+			return errors.Wrap(err, "set xattrs")
+		}
+	}
+	return nil
+}
+
+// syncOwnership sets the owner and group of the item at path to those of
+// the file. Names take precedence over numeric IDs, as the same user may
+// well have a different ID on this device.
+func (f *sendReceiveFolder) syncOwnership(file *protocol.FileInfo, path string) error {
+	if runtime.GOOS == "windows" || file.Platform.Unix == nil {
+		// Can't do anything, or nothing to do.
+		return nil
+	}
+
+	unix := file.Platform.Unix
+	uid, ok := osutil.LookupOwner(unix.OwnerName)
+	if !ok {
+		uid = unix.UID
+	}
+	gid, ok := osutil.LookupGroup(unix.GroupName)
+	if !ok {
+		gid = unix.GID
+	}
+
+	if err := f.mtimefs.Lchown(path, uid, gid); err != nil {
+		return errors.Wrap(err, "sync ownership")
+	}
+	return nil
+}
+
 func (f *sendReceiveFolder) inWritableDir(fn func(string) error, path string) error {
 	return inWritableDir(fn, f.mtimefs, path, f.IgnorePerms)
 }
@@ -2106,7 +2370,7 @@ func (f *sendReceiveFolder) limitedWriteAt(fd io.WriterAt, data []byte, offset i
 }
 
 func (f *sendReceiveFolder) withLimiter(fn func() error) error {
-	if err := f.writeLimiter.TakeWithContext(f.ctx, 1); err != nil {
+	if err := f.writeLimiter.TakeWithContext(f.pullCtx, 1); err != nil {
 		return err
 	}
 	defer f.writeLimiter.Give(1)
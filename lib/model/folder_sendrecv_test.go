@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -20,6 +21,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
@@ -27,6 +30,7 @@ import (
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/scanner"
 	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/versioner"
 )
 
 var blocks = []protocol.BlockInfo{
@@ -97,6 +101,7 @@ func setupSendReceiveFolder(t testing.TB, files ...protocol.FileInfo) (*testMode
 	f := model.folderRunners[fcfg.ID].(*sendReceiveFolder)
 	f.tempPullErrors = make(map[string]string)
 	f.ctx = context.Background()
+	f.pullCtx = f.ctx
 
 	// Update index
 	if files != nil {
@@ -426,6 +431,76 @@ func TestWeakHash(t *testing.T) {
 	}
 }
 
+func TestCopierContentDefinedBlocks(t *testing.T) {
+	model, fo, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(fo, model, wcfgCancel)
+	ffs := fo.Filesystem()
+
+	const blockSize = 1 << 20
+	data := make([]byte, 4<<20)
+	rand.Read(data)
+	writeFile(t, ffs, "chunked", data)
+	info, err := ffs.Lstat("chunked")
+	must(t, err)
+
+	// The desired file has some data inserted in the middle, which only
+	// changes the blocks around it.
+	edited := append(append(append([]byte{}, data[:len(data)/2]...), make([]byte, 1000)...), data[len(data)/2:]...)
+	existing, err := scanner.ChunkedBlocks(context.TODO(), bytes.NewReader(data), blockSize, -1, nil, true)
+	must(t, err)
+	desired, err := scanner.ChunkedBlocks(context.TODO(), bytes.NewReader(edited), blockSize, -1, nil, true)
+	must(t, err)
+
+	existingFile := protocol.FileInfo{
+		Name:         "chunked",
+		Blocks:       existing,
+		Size:         int64(len(data)),
+		RawBlockSize: blockSize,
+		ModifiedS:    info.ModTime().Unix(),
+		ModifiedNs:   info.ModTime().Nanosecond(),
+	}
+	desiredFile := protocol.FileInfo{
+		Name:         "chunked",
+		Blocks:       desired,
+		Size:         int64(len(edited)),
+		RawBlockSize: blockSize,
+		ModifiedS:    info.ModTime().Unix() + 1,
+	}
+	if !desiredFile.HasVariableBlocks() {
+		t.Fatal("expected variable blocks")
+	}
+	fo.updateLocalsFromScanning([]protocol.FileInfo{existingFile})
+
+	// The file came from a device that chunks the folder.
+	model.pmut.Lock()
+	model.remoteChunking[device1] = map[string]struct{}{fo.ID: {}}
+	model.pmut.Unlock()
+
+	copyChan := make(chan copyBlocksState)
+	pullChan := make(chan pullBlockState, len(desired))
+	finisherChan := make(chan *sharedPullerState, 1)
+
+	go fo.copierRoutine(copyChan, pullChan, finisherChan)
+	defer close(copyChan)
+
+	fo.handleFile(desiredFile, fsetSnapshot(t, fo.fset), copyChan)
+
+	var finish *sharedPullerState
+	select {
+	case finish = <-finisherChan:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the copier")
+	}
+	defer cleanupSharedPullerState(finish)
+
+	if pulls := len(pullChan); pulls > 2 {
+		t.Errorf("pulling %d of %d blocks, expected at most 2", pulls, len(desired))
+	}
+	if finish.copyOrigin+len(pullChan) != len(desired) {
+		t.Errorf("copied %d of %d blocks from the original file", finish.copyOrigin, len(desired))
+	}
+}
+
 // Test that updating a file removes its old blocks from the blockmap
 func TestCopierCleanup(t *testing.T) {
 	iterFn := func(folder, file string, index int32) bool {
@@ -900,6 +975,88 @@ func TestCopyOwner(t *testing.T) {
 	}
 }
 
+func TestSyncOwnership(t *testing.T) {
+	// Verifies that owner and group are taken from the remote file info,
+	// by name when the name exists locally and by ID otherwise.
+
+	if runtime.GOOS == "windows" {
+		t.Skip("syncing ownership not supported on Windows")
+	}
+
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.folder.FolderConfiguration = newFolderConfiguration(m.cfg, f.ID, f.Label, fs.FilesystemTypeFake, "/TestSyncOwnership")
+	f.folder.FolderConfiguration.SyncOwnership = true
+	// The synced ownership takes precedence.
+	f.folder.FolderConfiguration.CopyOwnershipFromParent = true
+
+	f.fset = newFileSet(t, f.ID, m.db)
+	f.mtimefs = f.fset.MtimeFS(f.Filesystem())
+
+	// A parent directory with another owner, to copy from.
+	must(t, f.mtimefs.Mkdir("parent", 0755))
+	must(t, f.mtimefs.Lchown("parent", 42, 43))
+
+	cases := []struct {
+		name     string
+		unix     protocol.UnixData
+		expOwner int
+		expGroup int
+	}{
+		{
+			name:     "byid",
+			unix:     protocol.UnixData{OwnerName: "syncthing-test-nonexistent", UID: 1234, GID: 5678},
+			expOwner: 1234,
+			expGroup: 5678,
+		},
+		{
+			name:     "byname",
+			unix:     protocol.UnixData{OwnerName: "root", UID: 1234, GID: 5678},
+			expOwner: 0,
+			expGroup: 5678,
+		},
+		{
+			name:     "parent/child",
+			unix:     protocol.UnixData{OwnerName: "syncthing-test-nonexistent", UID: 1234, GID: 5678},
+			expOwner: 1234,
+			expGroup: 5678,
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.unix.OwnerName == "root" {
+			if _, ok := osutil.LookupOwner("root"); !ok {
+				continue
+			}
+		}
+
+		unix := tc.unix
+		dir := protocol.FileInfo{
+			Name:        tc.name,
+			Type:        protocol.FileInfoTypeDirectory,
+			Permissions: 0755,
+			Platform:    protocol.PlatformData{Unix: &unix},
+		}
+
+		dbUpdateChan := make(chan dbUpdateJob, 1)
+		scanChan := make(chan string)
+		f.handleDir(dir, fsetSnapshot(t, f.fset), dbUpdateChan, scanChan)
+		select {
+		case <-dbUpdateChan:
+		case toScan := <-scanChan:
+			t.Fatal("Unexpected receive on scanChan:", toScan)
+		}
+
+		info, err := f.mtimefs.Lstat(tc.name)
+		if err != nil {
+			t.Fatal("Unexpected error:", err)
+		}
+		if info.Owner() != tc.expOwner || info.Group() != tc.expGroup {
+			t.Errorf("%s: expected owner/group to be %d/%d, not %d/%d", tc.name, tc.expOwner, tc.expGroup, info.Owner(), info.Group())
+		}
+	}
+}
+
 // TestSRConflictReplaceFileByDir checks that a conflict is created when an existing file
 // is replaced with a directory and versions are conflicting
 func TestSRConflictReplaceFileByDir(t *testing.T) {
@@ -965,6 +1122,196 @@ func TestSRConflictReplaceFileByLink(t *testing.T) {
 	}
 }
 
+// TestSRConflictPreferDevice checks that no conflict copy is kept when the
+// replacement comes from the preferred device
+func TestSRConflictPreferDevice(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	f.ConflictResolution = config.ConflictResolutionPreferDevice
+	f.ConflictPreferredDevice = device1
+
+	name := "foo"
+
+	// create local file
+	file := createEmptyFileInfo(t, name, ffs)
+	file.Version = protocol.Vector{}.Update(myID.Short())
+	f.updateLocalsFromScanning([]protocol.FileInfo{file})
+
+	// Simulate remote creating a dir with the same name
+	file.Type = protocol.FileInfoTypeDirectory
+	rem := device1.Short()
+	file.Version = protocol.Vector{}.Update(rem)
+	file.ModifiedBy = rem
+
+	dbUpdateChan := make(chan dbUpdateJob, 1)
+	scanChan := make(chan string, 1)
+
+	f.handleDir(file, fsetSnapshot(t, f.fset), dbUpdateChan, scanChan)
+
+	if confls := existingConflicts(name, ffs); len(confls) != 0 {
+		t.Fatal("Expected no conflicts, got", len(confls))
+	}
+	if info, err := ffs.Lstat(name); err != nil || !info.IsDir() {
+		t.Fatal("Expected directory to replace the file, got", info, err)
+	}
+}
+
+// TestSRConflictVersioned checks that the losing version of a conflict is
+// archived by the versioner instead of kept as a conflict copy.
+func TestSRConflictVersioned(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	f.ConflictResolution = config.ConflictResolutionPreferDevice
+	f.ConflictPreferredDevice = device1
+	ver := &fakeVersioner{fs: ffs}
+	f.versioner = ver
+
+	name := "foo"
+
+	// create local file
+	file := createEmptyFileInfo(t, name, ffs)
+	file.Version = protocol.Vector{}.Update(myID.Short())
+	f.updateLocalsFromScanning([]protocol.FileInfo{file})
+
+	// Simulate remote creating a dir with the same name
+	file.Type = protocol.FileInfoTypeDirectory
+	rem := device1.Short()
+	file.Version = protocol.Vector{}.Update(rem)
+	file.ModifiedBy = rem
+
+	dbUpdateChan := make(chan dbUpdateJob, 1)
+	scanChan := make(chan string, 1)
+
+	f.handleDir(file, fsetSnapshot(t, f.fset), dbUpdateChan, scanChan)
+
+	if len(ver.archived) != 1 || ver.archived[0] != name {
+		t.Errorf("Expected %v to be archived, got %v", name, ver.archived)
+	}
+	if confls := existingConflicts(name, ffs); len(confls) != 0 {
+		t.Fatal("Expected no conflicts, got", len(confls))
+	}
+	if info, err := ffs.Lstat(name); err != nil || !info.IsDir() {
+		t.Fatal("Expected directory to replace the file, got", info, err)
+	}
+}
+
+func TestConflictOutcome(t *testing.T) {
+	f := &sendReceiveFolder{folder: folder{shortID: myID.Short()}}
+
+	now := time.Now()
+	local := protocol.FileInfo{ModifiedBy: myID.Short(), ModifiedS: now.Unix()}
+	newer := protocol.FileInfo{ModifiedBy: device1.Short(), ModifiedS: now.Unix() + 1}
+	older := protocol.FileInfo{ModifiedBy: device1.Short(), ModifiedS: now.Unix() - 1}
+	same := protocol.FileInfo{ModifiedBy: device1.Short(), ModifiedS: now.Unix()}
+	other := protocol.FileInfo{ModifiedBy: device2.Short(), ModifiedS: now.Unix() + 1}
+
+	cases := []struct {
+		resolution config.ConflictResolution
+		preferred  protocol.DeviceID
+		cur, file  protocol.FileInfo
+		expected   conflictOutcome
+	}{
+		{config.ConflictResolutionKeepBoth, protocol.EmptyDeviceID, local, newer, conflictKeepBoth},
+		{config.ConflictResolutionNewest, protocol.EmptyDeviceID, local, newer, conflictRemoteWins},
+		{config.ConflictResolutionNewest, protocol.EmptyDeviceID, local, older, conflictLocalWins},
+		// With equal modification times, the remote wins.
+		{config.ConflictResolutionNewest, protocol.EmptyDeviceID, local, same, conflictRemoteWins},
+		{config.ConflictResolutionPreferLocal, protocol.EmptyDeviceID, local, newer, conflictLocalWins},
+		{config.ConflictResolutionPreferDevice, myID, local, newer, conflictLocalWins},
+		{config.ConflictResolutionPreferDevice, device1, local, older, conflictRemoteWins},
+		{config.ConflictResolutionPreferDevice, device1, newer, other, conflictLocalWins},
+		{config.ConflictResolutionPreferDevice, device2, local, newer, conflictKeepBoth},
+	}
+
+	for i, tc := range cases {
+		f.ConflictResolution = tc.resolution
+		f.ConflictPreferredDevice = tc.preferred
+		if outcome := f.conflictOutcome(tc.cur, tc.file); outcome != tc.expected {
+			t.Errorf("case %d (%v): expected %v, got %v", i, tc.resolution, tc.expected, outcome)
+		}
+	}
+}
+
+// fakeVersioner records the archived files and removes them, like moving
+// them to the versions directory would.
+type fakeVersioner struct {
+	fs       fs.Filesystem
+	archived []string
+}
+
+func (v *fakeVersioner) Archive(filePath string) error {
+	v.archived = append(v.archived, filePath)
+	return v.fs.Remove(filePath)
+}
+
+func (*fakeVersioner) GetVersions() (map[string][]versioner.FileVersion, error) {
+	return nil, nil
+}
+
+func (*fakeVersioner) Restore(string, time.Time) error {
+	return nil
+}
+
+func (*fakeVersioner) Clean(context.Context) error {
+	return nil
+}
+
+// TestSRConflictPreferLocal checks that our version of a conflicting file is
+// kept and made to win, without pulling the remote one.
+func TestSRConflictPreferLocal(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	f.ConflictResolution = config.ConflictResolutionPreferLocal
+
+	name := "foo"
+
+	// create local file
+	file := createEmptyFileInfo(t, name, ffs)
+	file.Version = protocol.Vector{}.Update(myID.Short())
+	f.updateLocalsFromScanning([]protocol.FileInfo{file})
+
+	// Simulate remote changing the file concurrently, newer than ours
+	remote := file
+	remote.Version = protocol.Vector{}.Update(device1.Short())
+	remote.ModifiedBy = device1.Short()
+	remote.Size = 1
+	remote.Blocks = blocks[1:2]
+	remote.ModifiedS++
+	f.fset.Update(device1, []protocol.FileInfo{remote})
+
+	dbUpdateChan := make(chan dbUpdateJob, 1)
+	copyChan := make(chan copyBlocksState)
+	scanChan := make(chan string, 1)
+
+	if _, _, _, err := f.processNeeded(fsetSnapshot(t, f.fset), dbUpdateChan, copyChan, scanChan); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case job := <-dbUpdateChan:
+		if job.file.Name != name || job.jobType != dbUpdateHandleFile {
+			t.Fatalf("unexpected db update %v", job)
+		}
+		if !job.file.Version.GreaterEqual(remote.Version) || !job.file.Version.GreaterEqual(file.Version) {
+			t.Errorf("version %v doesn't win over %v and %v", job.file.Version, remote.Version, file.Version)
+		}
+		if !job.file.BlocksEqual(file) {
+			t.Error("local file content was replaced")
+		}
+	default:
+		t.Fatal("expected a db update for the local version")
+	}
+	if f.queue.lenProgress()+f.queue.lenQueued() != 0 {
+		t.Error("conflicting file was queued for pulling")
+	}
+}
+
 // TestDeleteBehindSymlink checks that we don't delete or schedule a scan
 // when trying to delete a file behind a symlink.
 func TestDeleteBehindSymlink(t *testing.T) {
@@ -1026,7 +1373,7 @@ func TestPullCtxCancel(t *testing.T) {
 	finisherChan := make(chan *sharedPullerState)
 
 	var cancel context.CancelFunc
-	f.ctx, cancel = context.WithCancel(context.Background())
+	f.pullCtx, cancel = context.WithCancel(context.Background())
 
 	go f.pullerRoutine(fsetSnapshot(t, f.fset), pullChan, finisherChan)
 	defer close(pullChan)
@@ -1397,3 +1744,297 @@ func startCopier(f *sendReceiveFolder, pullChan chan<- pullBlockState, finisherC
 	}()
 	return copyChan, wg
 }
+
+func TestPullDirXattrs(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+	f.SyncXattrs = true
+
+	attrs := []protocol.Xattr{{Name: "user.test", Value: []byte("value")}}
+	file := protocol.FileInfo{
+		Name:        "dir",
+		Type:        protocol.FileInfoTypeDirectory,
+		Permissions: 0755,
+		Version:     protocol.Vector{}.Update(device1.Short()),
+	}
+	file.Platform.SetXattrs(attrs)
+	if file.Platform.Xattrs() == nil {
+		t.Skip("xattrs not supported on", runtime.GOOS)
+	}
+
+	snap := dbSnapshot(t, m, f.ID)
+	defer snap.Release()
+	scanChan := make(chan string, 1)
+	dbUpdateChan := make(chan dbUpdateJob, 1)
+	f.handleDir(file, snap, dbUpdateChan, scanChan)
+
+	if err, ok := f.tempPullErrors[file.Name]; ok {
+		if strings.Contains(err, "not supported") {
+			t.Skip("xattrs not supported by the filesystem:", err)
+		}
+		t.Fatal(err)
+	}
+	select {
+	case <-dbUpdateChan:
+	default:
+		t.Fatal("no db update received")
+	}
+
+	got, err := ffs.GetXattr(file.Name, f.XattrFilter)
+	must(t, err)
+	if len(got) != 1 || got[0].Name != attrs[0].Name || !bytes.Equal(got[0].Value, attrs[0].Value) {
+		t.Errorf("unexpected xattrs %v, expected %v", got, attrs)
+	}
+}
+
+func TestPullOutsideSyncWindow(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	// A window that only opens tomorrow
+	tomorrow := time.Now().Add(24 * time.Hour).Weekday()
+	f.SyncWindows = []config.SyncWindow{{Days: []string{tomorrow.String()}}}
+
+	file := protocol.FileInfo{
+		Name:        "dir",
+		Type:        protocol.FileInfoTypeDirectory,
+		Permissions: 0755,
+		Version:     protocol.Vector{}.Update(device1.Short()),
+	}
+	f.fset.Update(device1, []protocol.FileInfo{file})
+
+	if _, err := f.folder.pull(); err != nil {
+		t.Fatal(err)
+	}
+	if state, _, _ := f.getState(); state != FolderSyncWindowWaiting {
+		t.Errorf("state is %v, expected %v", state, FolderSyncWindowWaiting)
+	}
+	if _, err := ffs.Lstat(file.Name); !fs.IsNotExist(err) {
+		t.Error("directory was created outside of the sync window")
+	}
+
+	// Scanning meanwhile doesn't make us forget that we're waiting.
+	must(t, f.scanSubdirs(nil))
+	if state, _, _ := f.getState(); state != FolderSyncWindowWaiting {
+		t.Errorf("state is %v after scanning, expected %v", state, FolderSyncWindowWaiting)
+	}
+
+	f.SyncWindows = nil
+	if _, err := f.folder.pull(); err != nil {
+		t.Fatal(err)
+	}
+	if state, _, _ := f.getState(); state != FolderIdle {
+		t.Errorf("state is %v, expected %v", state, FolderIdle)
+	}
+	if _, err := ffs.Lstat(file.Name); err != nil {
+		t.Error("directory was not created:", err)
+	}
+}
+
+func TestPullDeletionHold(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	f.MaxRemoteDeletes = 2
+
+	var locals, deletes []protocol.FileInfo
+	for _, name := range []string{"a", "b", "c", "d"} {
+		must(t, ffs.Mkdir(name, 0755))
+		file := protocol.FileInfo{
+			Name:        name,
+			Type:        protocol.FileInfoTypeDirectory,
+			Permissions: 0755,
+			Version:     protocol.Vector{}.Update(myID.Short()),
+		}
+		locals = append(locals, file)
+		if name != "d" {
+			file.Version = file.Version.Update(device1.Short())
+			file.Deleted = true
+			deletes = append(deletes, file)
+		}
+	}
+	f.updateLocalsFromScanning(locals)
+	f.fset.Update(device1, deletes)
+
+	f.checkRemoteDeletions(device1)
+	hold, ok := f.deletionHold()
+	if !ok {
+		t.Fatal("expected deletions to be held")
+	}
+	if hold.Device != device1 || hold.Deletes != 3 || hold.GlobalItems != 4 {
+		t.Errorf("unexpected hold %+v", hold)
+	}
+
+	if _, err := f.folder.pull(); err != nil {
+		t.Fatal(err)
+	}
+	if state, _, _ := f.getState(); state != FolderDeletionHold {
+		t.Errorf("state is %v, expected %v", state, FolderDeletionHold)
+	}
+	if _, err := ffs.Lstat("a"); err != nil {
+		t.Error("directory was deleted while on hold:", err)
+	}
+
+	// Rejecting makes our versions win again.
+	must(t, f.rejectRemoteDeletions())
+	snap := dbSnapshot(t, m, f.ID)
+	if need := snap.NeedSize(protocol.LocalDeviceID); need.Deleted != 0 {
+		t.Errorf("still need %d deletions after rejecting", need.Deleted)
+	}
+	snap.Release()
+
+	// Approving a newer round of deletions applies them.
+	for i := range deletes {
+		deletes[i].Version = deletes[i].Version.Update(myID.Short()).Update(device1.Short())
+	}
+	f.fset.Update(device1, deletes)
+	must(t, f.ApproveDeletions())
+	if _, ok := f.deletionHold(); ok {
+		t.Fatal("deletions still held after approving")
+	}
+	if _, err := f.folder.pull(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ffs.Lstat("a"); !fs.IsNotExist(err) {
+		t.Error("directory wasn't deleted after approving")
+	}
+}
+
+func TestScrubCorruption(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	writeFile(t, f.mtimefs, "intact", []byte("intact data"))
+	writeFile(t, f.mtimefs, "corrupt", []byte("good data"))
+	must(t, f.scanSubdirs(nil))
+
+	// Change the contents without touching the size or modification time,
+	// like bit rot would.
+	file, ok := m.testCurrentFolderFile(f.ID, "corrupt")
+	if !ok {
+		t.Fatal("file missing")
+	}
+	writeFile(t, f.mtimefs, "corrupt", []byte("bad! data"))
+	must(t, f.mtimefs.Chtimes("corrupt", file.ModTime(), file.ModTime()))
+
+	f.setState(FolderSyncWaiting)
+	scrubAll(t, &f.folder)
+	if state, _, _ := f.getState(); state != FolderSyncWaiting {
+		t.Errorf("state is %v after scrubbing, expected it to be restored", state)
+	}
+
+	res, ok, err := m.FolderScrub(f.ID)
+	must(t, err)
+	if !ok {
+		t.Fatal("expected a scrub result")
+	}
+	if res.Files != 2 {
+		t.Errorf("scrubbed %d files, expected 2", res.Files)
+	}
+	if len(res.Corrupted) != 1 || res.Corrupted[0].Name != "corrupt" || len(res.Corrupted[0].Blocks) != 1 {
+		t.Errorf("unexpected corruptions %+v", res.Corrupted)
+	}
+}
+
+func TestScrubContinues(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+
+	for _, name := range []string{"a", "b", "c"} {
+		writeFile(t, f.mtimefs, name, []byte("data of "+name))
+	}
+	must(t, f.scanSubdirs(nil))
+
+	// Pretend a scrub got through the first file before a restart.
+	started := time.Now().Add(-time.Hour).Truncate(time.Second)
+	bs, err := json.Marshal(scrubProgress{
+		Position: "a",
+		Result:   ScrubResult{Started: started, Files: 1, Bytes: 6, Corrupted: []CorruptFile{}},
+	})
+	must(t, err)
+	kv := db.NewMiscDataNamespace(m.db)
+	must(t, kv.PutBytes(scrubProgressKey(f.ID), bs))
+	if next, ok := f.scrubDelay(); !ok || next != 0 {
+		t.Errorf("unfinished scrub should continue right away, got %v, %v", next, ok)
+	}
+
+	must(t, f.scrubChunk())
+	if f.scrubProgress != nil {
+		t.Fatal("scrub should have finished")
+	}
+	res, ok, err := m.FolderScrub(f.ID)
+	must(t, err)
+	if !ok {
+		t.Fatal("expected a scrub result")
+	}
+	if res.Files != 3 || !res.Started.Equal(started) {
+		t.Errorf("unexpected result %+v, expected to continue with two more files", res)
+	}
+	if _, ok := loadScrubProgress(kv, f.ID); ok {
+		t.Error("progress should be removed once the scrub finished")
+	}
+}
+
+func TestScrubRepair(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	f.ScrubRepair = true
+	fc := addFakeConn(m, device1, f.ID)
+
+	good := []byte("good data")
+	writeFile(t, f.mtimefs, "corrupt", good)
+	must(t, f.scanSubdirs(nil))
+	file, ok := m.testCurrentFolderFile(f.ID, "corrupt")
+	if !ok {
+		t.Fatal("file missing")
+	}
+
+	// The other device has the same version.
+	fc.mut.Lock()
+	fc.fileData = map[string][]byte{"corrupt": good}
+	fc.mut.Unlock()
+	must(t, m.Index(device1, f.ID, []protocol.FileInfo{prepareFileInfoForIndex(file)}))
+
+	writeFile(t, f.mtimefs, "corrupt", []byte("bad! data"))
+	must(t, f.mtimefs.Chtimes("corrupt", file.ModTime(), file.ModTime()))
+
+	scrubAll(t, &f.folder)
+
+	res, _, err := m.FolderScrub(f.ID)
+	must(t, err)
+	if len(res.Corrupted) != 1 || !res.Corrupted[0].Repaired {
+		t.Fatalf("unexpected corruptions %+v, expected a repaired file", res.Corrupted)
+	}
+	fd, err := f.mtimefs.Open("corrupt")
+	must(t, err)
+	defer fd.Close()
+	bs, err := io.ReadAll(fd)
+	must(t, err)
+	if !bytes.Equal(bs, good) {
+		t.Errorf("file contains %q after repair, expected %q", bs, good)
+	}
+}
+
+// scrubAll runs a scrub of the folder to completion.
+func scrubAll(t *testing.T, f *folder) {
+	t.Helper()
+	must(t, f.scrubChunk())
+	for f.scrubProgress != nil {
+		must(t, f.scrubChunk())
+	}
+}
@@ -87,7 +87,8 @@ func (c *folderSummaryService) Summary(folder string) (map[string]interface{}, e
 		if snap, err = c.model.DBSnapshot(folder); err == nil {
 			global = snap.GlobalSize()
 			local = snap.LocalSize()
-			need = snap.NeedSize(protocol.LocalDeviceID)
+			fcfg, _ := c.cfg.Folder(folder)
+			need = snap.NeedSizeIn(protocol.LocalDeviceID, db.NewPathSelection(selectedPaths(fcfg)))
 			ro = snap.ReceiveOnlyChangedSize()
 			ourSeq = snap.Sequence(protocol.LocalDeviceID)
 			remoteSeq = snap.Sequence(protocol.GlobalDeviceID)
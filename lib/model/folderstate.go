@@ -24,6 +24,9 @@ const (
 	FolderSyncing
 	FolderCleaning
 	FolderCleanWaiting
+	FolderSyncWindowWaiting
+	FolderDeletionHold
+	FolderScrubbing
 	FolderError
 )
 
@@ -45,6 +48,12 @@ func (s folderState) String() string {
 		return "cleaning"
 	case FolderCleanWaiting:
 		return "clean-waiting"
+	case FolderSyncWindowWaiting:
+		return "sync-window-waiting"
+	case FolderDeletionHold:
+		return "deletion-hold"
+	case FolderScrubbing:
+		return "scrubbing"
 	case FolderError:
 		return "error"
 	default:
@@ -335,6 +335,7 @@ func (s *indexHandler) receive(fs []protocol.FileInfo, update bool, op string) e
 		fs[i].VersionHash = nil
 	}
 	fset.Update(deviceID, fs)
+	runner.checkRemoteDeletions(deviceID)
 
 	seq := fset.Sequence(deviceID)
 	s.evLogger.Log(events.RemoteIndexUpdated, map[string]interface{}{
@@ -0,0 +1,57 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	metricFolderScans = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "model",
+			Name:      "folder_scans_total",
+			Help:      "Total number of folder scans.",
+		}, []string{"folder"})
+	metricFolderScanSeconds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "model",
+			Name:      "folder_scan_seconds_total",
+			Help:      "Total time spent scanning folders.",
+		}, []string{"folder"})
+
+	metricFolderPulls = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "model",
+			Name:      "folder_pulls_total",
+			Help:      "Total number of folder pull iterations.",
+		}, []string{"folder"})
+	metricFolderPullSeconds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "model",
+			Name:      "folder_pull_seconds_total",
+			Help:      "Total time spent pulling folders.",
+		}, []string{"folder"})
+
+	metricFolderQueuedFiles = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "syncthing",
+			Subsystem: "model",
+			Name:      "folder_puller_queued_files",
+			Help:      "Number of files waiting in the puller queue.",
+		}, []string{"folder"})
+)
+
+func init() {
+	prometheus.MustRegister(metricFolderScans, metricFolderScanSeconds,
+		metricFolderPulls, metricFolderPullSeconds,
+		metricFolderQueuedFiles)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestFolderMetrics(t *testing.T) {
+	m, f, wcfgCancel := setupSendReceiveFolder(t)
+	defer cleanupSRFolder(f, m, wcfgCancel)
+	ffs := f.Filesystem()
+
+	select {
+	case <-f.initialScanFinished:
+	default:
+		close(f.initialScanFinished)
+	}
+
+	// The metrics are process wide, so look at the change.
+	scans := testutil.ToFloat64(metricFolderScans.WithLabelValues(f.ID))
+	pulls := testutil.ToFloat64(metricFolderPulls.WithLabelValues(f.ID))
+
+	writeFile(t, ffs, "file", []byte("data"))
+	must(t, f.scanSubdirs(nil))
+
+	if n := testutil.ToFloat64(metricFolderScans.WithLabelValues(f.ID)); n != scans+1 {
+		t.Errorf("%v scans counted, expected %v", n, scans+1)
+	}
+	if n := testutil.ToFloat64(metricFolderScanSeconds.WithLabelValues(f.ID)); n <= 0 {
+		t.Errorf("%v seconds of scanning counted, expected more than zero", n)
+	}
+
+	files := []protocol.FileInfo{
+		{Name: "dir1", Type: protocol.FileInfoTypeDirectory, Permissions: 0755, Version: protocol.Vector{}.Update(device1.Short())},
+		{Name: "dir2", Type: protocol.FileInfoTypeDirectory, Permissions: 0755, Version: protocol.Vector{}.Update(device1.Short())},
+	}
+	f.fset.Update(device1, files)
+	if _, err := f.folder.pull(); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := testutil.ToFloat64(metricFolderPulls.WithLabelValues(f.ID)); n != pulls+1 {
+		t.Errorf("%v pulls counted, expected %v", n, pulls+1)
+	}
+	if n := testutil.ToFloat64(metricFolderPullSeconds.WithLabelValues(f.ID)); n <= 0 {
+		t.Errorf("%v seconds of pulling counted, expected more than zero", n)
+	}
+	if n := testutil.ToFloat64(metricFolderQueuedFiles.WithLabelValues(f.ID)); n != 0 {
+		t.Errorf("%v files queued after pulling, expected none", n)
+	}
+}
@@ -23,6 +23,17 @@ type Model struct {
 		arg1 protocol.Connection
 		arg2 protocol.Hello
 	}
+	ApproveDeletionsStub        func(string) error
+	approveDeletionsMutex       sync.RWMutex
+	approveDeletionsArgsForCall []struct {
+		arg1 string
+	}
+	approveDeletionsReturns struct {
+		result1 error
+	}
+	approveDeletionsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	AvailabilityStub        func(string, protocol.FileInfo, protocol.BlockInfo) ([]model.Availability, error)
 	availabilityMutex       sync.RWMutex
 	availabilityArgsForCall []struct {
@@ -165,6 +176,21 @@ type Model struct {
 		arg1 string
 		arg2 time.Duration
 	}
+	DeletionHoldStub        func(string) (model.DeletionHold, bool, error)
+	deletionHoldMutex       sync.RWMutex
+	deletionHoldArgsForCall []struct {
+		arg1 string
+	}
+	deletionHoldReturns struct {
+		result1 model.DeletionHold
+		result2 bool
+		result3 error
+	}
+	deletionHoldReturnsOnCall map[int]struct {
+		result1 model.DeletionHold
+		result2 bool
+		result3 error
+	}
 	DeviceStatisticsStub        func() (map[protocol.DeviceID]stats.DeviceStatistics, error)
 	deviceStatisticsMutex       sync.RWMutex
 	deviceStatisticsArgsForCall []struct {
@@ -213,6 +239,19 @@ type Model struct {
 	downloadProgressReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FolderConflictsStub        func(string) ([]model.Conflict, error)
+	folderConflictsMutex       sync.RWMutex
+	folderConflictsArgsForCall []struct {
+		arg1 string
+	}
+	folderConflictsReturns struct {
+		result1 []model.Conflict
+		result2 error
+	}
+	folderConflictsReturnsOnCall map[int]struct {
+		result1 []model.Conflict
+		result2 error
+	}
 	FolderErrorsStub        func(string) ([]model.FileError, error)
 	folderErrorsMutex       sync.RWMutex
 	folderErrorsArgsForCall []struct {
@@ -237,6 +276,21 @@ type Model struct {
 	folderProgressBytesCompletedReturnsOnCall map[int]struct {
 		result1 int64
 	}
+	FolderScrubStub        func(string) (model.ScrubResult, bool, error)
+	folderScrubMutex       sync.RWMutex
+	folderScrubArgsForCall []struct {
+		arg1 string
+	}
+	folderScrubReturns struct {
+		result1 model.ScrubResult
+		result2 bool
+		result3 error
+	}
+	folderScrubReturnsOnCall map[int]struct {
+		result1 model.ScrubResult
+		result2 bool
+		result3 error
+	}
 	FolderStatisticsStub        func() (map[string]stats.FolderStatistics, error)
 	folderStatisticsMutex       sync.RWMutex
 	folderStatisticsArgsForCall []struct {
@@ -431,6 +485,17 @@ type Model struct {
 		result1 map[string]db.PendingFolder
 		result2 error
 	}
+	RejectDeletionsStub        func(string) error
+	rejectDeletionsMutex       sync.RWMutex
+	rejectDeletionsArgsForCall []struct {
+		arg1 string
+	}
+	rejectDeletionsReturns struct {
+		result1 error
+	}
+	rejectDeletionsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoteNeedFolderFilesStub        func(string, protocol.DeviceID, int, int) ([]db.FileInfoTruncated, error)
 	remoteNeedFolderFilesMutex       sync.RWMutex
 	remoteNeedFolderFilesArgsForCall []struct {
@@ -479,6 +544,19 @@ type Model struct {
 	resetFolderReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResolveConflictStub        func(string, string, string) error
+	resolveConflictMutex       sync.RWMutex
+	resolveConflictArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	resolveConflictReturns struct {
+		result1 error
+	}
+	resolveConflictReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RestoreFolderVersionsStub        func(string, map[string]time.Time) (map[string]error, error)
 	restoreFolderVersionsMutex       sync.RWMutex
 	restoreFolderVersionsArgsForCall []struct {
@@ -531,6 +609,17 @@ type Model struct {
 	scanFoldersReturnsOnCall map[int]struct {
 		result1 map[string]error
 	}
+	ScrubFolderStub        func(string) error
+	scrubFolderMutex       sync.RWMutex
+	scrubFolderArgsForCall []struct {
+		arg1 string
+	}
+	scrubFolderReturns struct {
+		result1 error
+	}
+	scrubFolderReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ServeStub        func(context.Context) error
 	serveMutex       sync.RWMutex
 	serveArgsForCall []struct {
@@ -629,6 +718,67 @@ func (fake *Model) AddConnectionArgsForCall(i int) (protocol.Connection, protoco
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *Model) ApproveDeletions(arg1 string) error {
+	fake.approveDeletionsMutex.Lock()
+	ret, specificReturn := fake.approveDeletionsReturnsOnCall[len(fake.approveDeletionsArgsForCall)]
+	fake.approveDeletionsArgsForCall = append(fake.approveDeletionsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ApproveDeletionsStub
+	fakeReturns := fake.approveDeletionsReturns
+	fake.recordInvocation("ApproveDeletions", []interface{}{arg1})
+	fake.approveDeletionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ApproveDeletionsCallCount() int {
+	fake.approveDeletionsMutex.RLock()
+	defer fake.approveDeletionsMutex.RUnlock()
+	return len(fake.approveDeletionsArgsForCall)
+}
+
+func (fake *Model) ApproveDeletionsCalls(stub func(string) error) {
+	fake.approveDeletionsMutex.Lock()
+	defer fake.approveDeletionsMutex.Unlock()
+	fake.ApproveDeletionsStub = stub
+}
+
+func (fake *Model) ApproveDeletionsArgsForCall(i int) string {
+	fake.approveDeletionsMutex.RLock()
+	defer fake.approveDeletionsMutex.RUnlock()
+	argsForCall := fake.approveDeletionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ApproveDeletionsReturns(result1 error) {
+	fake.approveDeletionsMutex.Lock()
+	defer fake.approveDeletionsMutex.Unlock()
+	fake.ApproveDeletionsStub = nil
+	fake.approveDeletionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ApproveDeletionsReturnsOnCall(i int, result1 error) {
+	fake.approveDeletionsMutex.Lock()
+	defer fake.approveDeletionsMutex.Unlock()
+	fake.ApproveDeletionsStub = nil
+	if fake.approveDeletionsReturnsOnCall == nil {
+		fake.approveDeletionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveDeletionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) Availability(arg1 string, arg2 protocol.FileInfo, arg3 protocol.BlockInfo) ([]model.Availability, error) {
 	fake.availabilityMutex.Lock()
 	ret, specificReturn := fake.availabilityReturnsOnCall[len(fake.availabilityArgsForCall)]
@@ -1305,6 +1455,73 @@ func (fake *Model) DelayScanArgsForCall(i int) (string, time.Duration) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *Model) DeletionHold(arg1 string) (model.DeletionHold, bool, error) {
+	fake.deletionHoldMutex.Lock()
+	ret, specificReturn := fake.deletionHoldReturnsOnCall[len(fake.deletionHoldArgsForCall)]
+	fake.deletionHoldArgsForCall = append(fake.deletionHoldArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeletionHoldStub
+	fakeReturns := fake.deletionHoldReturns
+	fake.recordInvocation("DeletionHold", []interface{}{arg1})
+	fake.deletionHoldMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *Model) DeletionHoldCallCount() int {
+	fake.deletionHoldMutex.RLock()
+	defer fake.deletionHoldMutex.RUnlock()
+	return len(fake.deletionHoldArgsForCall)
+}
+
+func (fake *Model) DeletionHoldCalls(stub func(string) (model.DeletionHold, bool, error)) {
+	fake.deletionHoldMutex.Lock()
+	defer fake.deletionHoldMutex.Unlock()
+	fake.DeletionHoldStub = stub
+}
+
+func (fake *Model) DeletionHoldArgsForCall(i int) string {
+	fake.deletionHoldMutex.RLock()
+	defer fake.deletionHoldMutex.RUnlock()
+	argsForCall := fake.deletionHoldArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) DeletionHoldReturns(result1 model.DeletionHold, result2 bool, result3 error) {
+	fake.deletionHoldMutex.Lock()
+	defer fake.deletionHoldMutex.Unlock()
+	fake.DeletionHoldStub = nil
+	fake.deletionHoldReturns = struct {
+		result1 model.DeletionHold
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *Model) DeletionHoldReturnsOnCall(i int, result1 model.DeletionHold, result2 bool, result3 error) {
+	fake.deletionHoldMutex.Lock()
+	defer fake.deletionHoldMutex.Unlock()
+	fake.DeletionHoldStub = nil
+	if fake.deletionHoldReturnsOnCall == nil {
+		fake.deletionHoldReturnsOnCall = make(map[int]struct {
+			result1 model.DeletionHold
+			result2 bool
+			result3 error
+		})
+	}
+	fake.deletionHoldReturnsOnCall[i] = struct {
+		result1 model.DeletionHold
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *Model) DeviceStatistics() (map[protocol.DeviceID]stats.DeviceStatistics, error) {
 	fake.deviceStatisticsMutex.Lock()
 	ret, specificReturn := fake.deviceStatisticsReturnsOnCall[len(fake.deviceStatisticsArgsForCall)]
@@ -1552,6 +1769,70 @@ func (fake *Model) DownloadProgressReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) FolderConflicts(arg1 string) ([]model.Conflict, error) {
+	fake.folderConflictsMutex.Lock()
+	ret, specificReturn := fake.folderConflictsReturnsOnCall[len(fake.folderConflictsArgsForCall)]
+	fake.folderConflictsArgsForCall = append(fake.folderConflictsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderConflictsStub
+	fakeReturns := fake.folderConflictsReturns
+	fake.recordInvocation("FolderConflicts", []interface{}{arg1})
+	fake.folderConflictsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Model) FolderConflictsCallCount() int {
+	fake.folderConflictsMutex.RLock()
+	defer fake.folderConflictsMutex.RUnlock()
+	return len(fake.folderConflictsArgsForCall)
+}
+
+func (fake *Model) FolderConflictsCalls(stub func(string) ([]model.Conflict, error)) {
+	fake.folderConflictsMutex.Lock()
+	defer fake.folderConflictsMutex.Unlock()
+	fake.FolderConflictsStub = stub
+}
+
+func (fake *Model) FolderConflictsArgsForCall(i int) string {
+	fake.folderConflictsMutex.RLock()
+	defer fake.folderConflictsMutex.RUnlock()
+	argsForCall := fake.folderConflictsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) FolderConflictsReturns(result1 []model.Conflict, result2 error) {
+	fake.folderConflictsMutex.Lock()
+	defer fake.folderConflictsMutex.Unlock()
+	fake.FolderConflictsStub = nil
+	fake.folderConflictsReturns = struct {
+		result1 []model.Conflict
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Model) FolderConflictsReturnsOnCall(i int, result1 []model.Conflict, result2 error) {
+	fake.folderConflictsMutex.Lock()
+	defer fake.folderConflictsMutex.Unlock()
+	fake.FolderConflictsStub = nil
+	if fake.folderConflictsReturnsOnCall == nil {
+		fake.folderConflictsReturnsOnCall = make(map[int]struct {
+			result1 []model.Conflict
+			result2 error
+		})
+	}
+	fake.folderConflictsReturnsOnCall[i] = struct {
+		result1 []model.Conflict
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Model) FolderErrors(arg1 string) ([]model.FileError, error) {
 	fake.folderErrorsMutex.Lock()
 	ret, specificReturn := fake.folderErrorsReturnsOnCall[len(fake.folderErrorsArgsForCall)]
@@ -1677,6 +1958,73 @@ func (fake *Model) FolderProgressBytesCompletedReturnsOnCall(i int, result1 int6
 	}{result1}
 }
 
+func (fake *Model) FolderScrub(arg1 string) (model.ScrubResult, bool, error) {
+	fake.folderScrubMutex.Lock()
+	ret, specificReturn := fake.folderScrubReturnsOnCall[len(fake.folderScrubArgsForCall)]
+	fake.folderScrubArgsForCall = append(fake.folderScrubArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.FolderScrubStub
+	fakeReturns := fake.folderScrubReturns
+	fake.recordInvocation("FolderScrub", []interface{}{arg1})
+	fake.folderScrubMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *Model) FolderScrubCallCount() int {
+	fake.folderScrubMutex.RLock()
+	defer fake.folderScrubMutex.RUnlock()
+	return len(fake.folderScrubArgsForCall)
+}
+
+func (fake *Model) FolderScrubCalls(stub func(string) (model.ScrubResult, bool, error)) {
+	fake.folderScrubMutex.Lock()
+	defer fake.folderScrubMutex.Unlock()
+	fake.FolderScrubStub = stub
+}
+
+func (fake *Model) FolderScrubArgsForCall(i int) string {
+	fake.folderScrubMutex.RLock()
+	defer fake.folderScrubMutex.RUnlock()
+	argsForCall := fake.folderScrubArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) FolderScrubReturns(result1 model.ScrubResult, result2 bool, result3 error) {
+	fake.folderScrubMutex.Lock()
+	defer fake.folderScrubMutex.Unlock()
+	fake.FolderScrubStub = nil
+	fake.folderScrubReturns = struct {
+		result1 model.ScrubResult
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *Model) FolderScrubReturnsOnCall(i int, result1 model.ScrubResult, result2 bool, result3 error) {
+	fake.folderScrubMutex.Lock()
+	defer fake.folderScrubMutex.Unlock()
+	fake.FolderScrubStub = nil
+	if fake.folderScrubReturnsOnCall == nil {
+		fake.folderScrubReturnsOnCall = make(map[int]struct {
+			result1 model.ScrubResult
+			result2 bool
+			result3 error
+		})
+	}
+	fake.folderScrubReturnsOnCall[i] = struct {
+		result1 model.ScrubResult
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *Model) FolderStatistics() (map[string]stats.FolderStatistics, error) {
 	fake.folderStatisticsMutex.Lock()
 	ret, specificReturn := fake.folderStatisticsReturnsOnCall[len(fake.folderStatisticsArgsForCall)]
@@ -2599,6 +2947,67 @@ func (fake *Model) PendingFoldersReturnsOnCall(i int, result1 map[string]db.Pend
 	}{result1, result2}
 }
 
+func (fake *Model) RejectDeletions(arg1 string) error {
+	fake.rejectDeletionsMutex.Lock()
+	ret, specificReturn := fake.rejectDeletionsReturnsOnCall[len(fake.rejectDeletionsArgsForCall)]
+	fake.rejectDeletionsArgsForCall = append(fake.rejectDeletionsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RejectDeletionsStub
+	fakeReturns := fake.rejectDeletionsReturns
+	fake.recordInvocation("RejectDeletions", []interface{}{arg1})
+	fake.rejectDeletionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) RejectDeletionsCallCount() int {
+	fake.rejectDeletionsMutex.RLock()
+	defer fake.rejectDeletionsMutex.RUnlock()
+	return len(fake.rejectDeletionsArgsForCall)
+}
+
+func (fake *Model) RejectDeletionsCalls(stub func(string) error) {
+	fake.rejectDeletionsMutex.Lock()
+	defer fake.rejectDeletionsMutex.Unlock()
+	fake.RejectDeletionsStub = stub
+}
+
+func (fake *Model) RejectDeletionsArgsForCall(i int) string {
+	fake.rejectDeletionsMutex.RLock()
+	defer fake.rejectDeletionsMutex.RUnlock()
+	argsForCall := fake.rejectDeletionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) RejectDeletionsReturns(result1 error) {
+	fake.rejectDeletionsMutex.Lock()
+	defer fake.rejectDeletionsMutex.Unlock()
+	fake.RejectDeletionsStub = nil
+	fake.rejectDeletionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) RejectDeletionsReturnsOnCall(i int, result1 error) {
+	fake.rejectDeletionsMutex.Lock()
+	defer fake.rejectDeletionsMutex.Unlock()
+	fake.RejectDeletionsStub = nil
+	if fake.rejectDeletionsReturnsOnCall == nil {
+		fake.rejectDeletionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rejectDeletionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) RemoteNeedFolderFiles(arg1 string, arg2 protocol.DeviceID, arg3 int, arg4 int) ([]db.FileInfoTruncated, error) {
 	fake.remoteNeedFolderFilesMutex.Lock()
 	ret, specificReturn := fake.remoteNeedFolderFilesReturnsOnCall[len(fake.remoteNeedFolderFilesArgsForCall)]
@@ -2804,6 +3213,69 @@ func (fake *Model) ResetFolderReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Model) ResolveConflict(arg1 string, arg2 string, arg3 string) error {
+	fake.resolveConflictMutex.Lock()
+	ret, specificReturn := fake.resolveConflictReturnsOnCall[len(fake.resolveConflictArgsForCall)]
+	fake.resolveConflictArgsForCall = append(fake.resolveConflictArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResolveConflictStub
+	fakeReturns := fake.resolveConflictReturns
+	fake.recordInvocation("ResolveConflict", []interface{}{arg1, arg2, arg3})
+	fake.resolveConflictMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ResolveConflictCallCount() int {
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
+	return len(fake.resolveConflictArgsForCall)
+}
+
+func (fake *Model) ResolveConflictCalls(stub func(string, string, string) error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = stub
+}
+
+func (fake *Model) ResolveConflictArgsForCall(i int) (string, string, string) {
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
+	argsForCall := fake.resolveConflictArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *Model) ResolveConflictReturns(result1 error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = nil
+	fake.resolveConflictReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ResolveConflictReturnsOnCall(i int, result1 error) {
+	fake.resolveConflictMutex.Lock()
+	defer fake.resolveConflictMutex.Unlock()
+	fake.ResolveConflictStub = nil
+	if fake.resolveConflictReturnsOnCall == nil {
+		fake.resolveConflictReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resolveConflictReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) RestoreFolderVersions(arg1 string, arg2 map[string]time.Time) (map[string]error, error) {
 	fake.restoreFolderVersionsMutex.Lock()
 	ret, specificReturn := fake.restoreFolderVersionsReturnsOnCall[len(fake.restoreFolderVersionsArgsForCall)]
@@ -3082,6 +3554,67 @@ func (fake *Model) ScanFoldersReturnsOnCall(i int, result1 map[string]error) {
 	}{result1}
 }
 
+func (fake *Model) ScrubFolder(arg1 string) error {
+	fake.scrubFolderMutex.Lock()
+	ret, specificReturn := fake.scrubFolderReturnsOnCall[len(fake.scrubFolderArgsForCall)]
+	fake.scrubFolderArgsForCall = append(fake.scrubFolderArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ScrubFolderStub
+	fakeReturns := fake.scrubFolderReturns
+	fake.recordInvocation("ScrubFolder", []interface{}{arg1})
+	fake.scrubFolderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Model) ScrubFolderCallCount() int {
+	fake.scrubFolderMutex.RLock()
+	defer fake.scrubFolderMutex.RUnlock()
+	return len(fake.scrubFolderArgsForCall)
+}
+
+func (fake *Model) ScrubFolderCalls(stub func(string) error) {
+	fake.scrubFolderMutex.Lock()
+	defer fake.scrubFolderMutex.Unlock()
+	fake.ScrubFolderStub = stub
+}
+
+func (fake *Model) ScrubFolderArgsForCall(i int) string {
+	fake.scrubFolderMutex.RLock()
+	defer fake.scrubFolderMutex.RUnlock()
+	argsForCall := fake.scrubFolderArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *Model) ScrubFolderReturns(result1 error) {
+	fake.scrubFolderMutex.Lock()
+	defer fake.scrubFolderMutex.Unlock()
+	fake.ScrubFolderStub = nil
+	fake.scrubFolderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Model) ScrubFolderReturnsOnCall(i int, result1 error) {
+	fake.scrubFolderMutex.Lock()
+	defer fake.scrubFolderMutex.Unlock()
+	fake.ScrubFolderStub = nil
+	if fake.scrubFolderReturnsOnCall == nil {
+		fake.scrubFolderReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scrubFolderReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Model) Serve(arg1 context.Context) error {
 	fake.serveMutex.Lock()
 	ret, specificReturn := fake.serveReturnsOnCall[len(fake.serveArgsForCall)]
@@ -3409,6 +3942,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addConnectionMutex.RLock()
 	defer fake.addConnectionMutex.RUnlock()
+	fake.approveDeletionsMutex.RLock()
+	defer fake.approveDeletionsMutex.RUnlock()
 	fake.availabilityMutex.RLock()
 	defer fake.availabilityMutex.RUnlock()
 	fake.bringToFrontMutex.RLock()
@@ -3433,6 +3968,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.dBSnapshotMutex.RUnlock()
 	fake.delayScanMutex.RLock()
 	defer fake.delayScanMutex.RUnlock()
+	fake.deletionHoldMutex.RLock()
+	defer fake.deletionHoldMutex.RUnlock()
 	fake.deviceStatisticsMutex.RLock()
 	defer fake.deviceStatisticsMutex.RUnlock()
 	fake.dismissPendingDeviceMutex.RLock()
@@ -3441,10 +3978,14 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.dismissPendingFolderMutex.RUnlock()
 	fake.downloadProgressMutex.RLock()
 	defer fake.downloadProgressMutex.RUnlock()
+	fake.folderConflictsMutex.RLock()
+	defer fake.folderConflictsMutex.RUnlock()
 	fake.folderErrorsMutex.RLock()
 	defer fake.folderErrorsMutex.RUnlock()
 	fake.folderProgressBytesCompletedMutex.RLock()
 	defer fake.folderProgressBytesCompletedMutex.RUnlock()
+	fake.folderScrubMutex.RLock()
+	defer fake.folderScrubMutex.RUnlock()
 	fake.folderStatisticsMutex.RLock()
 	defer fake.folderStatisticsMutex.RUnlock()
 	fake.getFolderVersionsMutex.RLock()
@@ -3475,12 +4016,16 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.pendingDevicesMutex.RUnlock()
 	fake.pendingFoldersMutex.RLock()
 	defer fake.pendingFoldersMutex.RUnlock()
+	fake.rejectDeletionsMutex.RLock()
+	defer fake.rejectDeletionsMutex.RUnlock()
 	fake.remoteNeedFolderFilesMutex.RLock()
 	defer fake.remoteNeedFolderFilesMutex.RUnlock()
 	fake.requestMutex.RLock()
 	defer fake.requestMutex.RUnlock()
 	fake.resetFolderMutex.RLock()
 	defer fake.resetFolderMutex.RUnlock()
+	fake.resolveConflictMutex.RLock()
+	defer fake.resolveConflictMutex.RUnlock()
 	fake.restoreFolderVersionsMutex.RLock()
 	defer fake.restoreFolderVersionsMutex.RUnlock()
 	fake.revertMutex.RLock()
@@ -3491,6 +4036,8 @@ func (fake *Model) Invocations() map[string][][]interface{} {
 	defer fake.scanFolderSubdirsMutex.RUnlock()
 	fake.scanFoldersMutex.RLock()
 	defer fake.scanFoldersMutex.RUnlock()
+	fake.scrubFolderMutex.RLock()
+	defer fake.scrubFolderMutex.RUnlock()
 	fake.serveMutex.RLock()
 	defer fake.serveMutex.RUnlock()
 	fake.setIgnoresMutex.RLock()
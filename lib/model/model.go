@@ -30,6 +30,7 @@ import (
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/connections"
 	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/discover"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
@@ -58,8 +59,13 @@ type service interface {
 	WatchError() error
 	ScheduleForceRescan(path string)
 	GetStatistics() (stats.FolderStatistics, error)
+	ApproveDeletions() error
+	RejectDeletions() error
+	ResolveConflict(name, keep string) error
+	Scrub()
 
 	getState() (folderState, time.Time, error)
+	checkRemoteDeletions(device protocol.DeviceID)
 }
 
 type Availability struct {
@@ -82,6 +88,13 @@ type Model interface {
 	WatchError(folder string) error
 	Override(folder string)
 	Revert(folder string)
+	DeletionHold(folder string) (DeletionHold, bool, error)
+	ApproveDeletions(folder string) error
+	RejectDeletions(folder string) error
+	FolderScrub(folder string) (ScrubResult, bool, error)
+	ScrubFolder(folder string) error
+	FolderConflicts(folder string) ([]Conflict, error)
+	ResolveConflict(folder, name, keep string) error
 	BringToFront(folder, file string)
 	LoadIgnores(folder string) ([]string, []string, error)
 	CurrentIgnores(folder string) ([]string, []string, error)
@@ -127,6 +140,7 @@ type model struct {
 	db             *db.Lowlevel
 	protectedFiles []string
 	evLogger       events.Logger
+	peers          *discover.PeerExchange
 
 	// constant or concurrency safe fields
 	finder          *db.BlockFinder
@@ -161,7 +175,9 @@ type model struct {
 	closed              map[protocol.DeviceID]chan struct{}
 	helloMessages       map[protocol.DeviceID]protocol.Hello
 	deviceDownloads     map[protocol.DeviceID]*deviceDownloadState
-	remotePausedFolders map[protocol.DeviceID]map[string]struct{} // deviceID -> folders
+	remotePausedFolders map[protocol.DeviceID]map[string]struct{}         // deviceID -> folders
+	remoteSelections    map[protocol.DeviceID]map[string]db.PathSelection // deviceID -> folder -> selected paths
+	remoteChunking      map[protocol.DeviceID]map[string]struct{}         // deviceID -> folders with content defined chunking
 	indexHandlers       map[protocol.DeviceID]*indexHandlerRegistry
 
 	// for testing only
@@ -206,7 +222,7 @@ var (
 // NewModel creates and starts a new model. The model starts in read-only mode,
 // where it sends index information to connected peers and responds to requests
 // for file data without altering the local folder in any way.
-func NewModel(cfg config.Wrapper, id protocol.DeviceID, clientName, clientVersion string, ldb *db.Lowlevel, protectedFiles []string, evLogger events.Logger) Model {
+func NewModel(cfg config.Wrapper, id protocol.DeviceID, clientName, clientVersion string, ldb *db.Lowlevel, protectedFiles []string, evLogger events.Logger, peers *discover.PeerExchange) Model {
 	spec := svcutil.SpecWithDebugLogger(l)
 	m := &model{
 		Supervisor: suture.New("model", spec),
@@ -219,6 +235,7 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, clientName, clientVersio
 		db:             ldb,
 		protectedFiles: protectedFiles,
 		evLogger:       evLogger,
+		peers:          peers,
 
 		// constant or concurrency safe fields
 		finder:               db.NewBlockFinder(ldb),
@@ -249,6 +266,8 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, clientName, clientVersio
 		helloMessages:       make(map[protocol.DeviceID]protocol.Hello),
 		deviceDownloads:     make(map[protocol.DeviceID]*deviceDownloadState),
 		remotePausedFolders: make(map[protocol.DeviceID]map[string]struct{}),
+		remoteSelections:    make(map[protocol.DeviceID]map[string]db.PathSelection),
+		remoteChunking:      make(map[protocol.DeviceID]map[string]struct{}),
 		indexHandlers:       make(map[protocol.DeviceID]*indexHandlerRegistry),
 	}
 	for devID := range cfg.Devices() {
@@ -256,6 +275,7 @@ func NewModel(cfg config.Wrapper, id protocol.DeviceID, clientName, clientVersio
 	}
 	m.Add(m.progressEmitter)
 	m.Add(svcutil.AsService(m.serve, m.String()))
+	m.Add(svcutil.AsService(m.peerExchange, fmt.Sprintf("%s/peerExchange", m)))
 
 	return m
 }
@@ -384,6 +404,9 @@ func (m *model) addAndStartFolderLockedWithIgnores(cfg config.FolderConfiguratio
 
 		if err := cfg.CreateRoot(); err != nil {
 			l.Warnln("Failed to create folder root directory", err)
+		} else if cfg.Type == config.FolderTypeOnDemand {
+			// The root is the mount point, the marker is provided by the
+			// mounted filesystem.
 		} else if err = cfg.CreateMarker(); err != nil {
 			l.Warnln("Failed to create folder marker:", err)
 		}
@@ -497,6 +520,9 @@ func (m *model) removeFolder(cfg config.FolderConfiguration) {
 
 	// Remove it from the database
 	db.DropFolder(m.db, cfg.ID)
+	_ = db.NewMiscDataNamespace(m.db).Delete(deletionHoldKey(cfg.ID))
+	_ = db.NewMiscDataNamespace(m.db).Delete(scrubResultKey(cfg.ID))
+	_ = db.NewMiscDataNamespace(m.db).Delete(scrubProgressKey(cfg.ID))
 }
 
 // Need to hold lock on m.fmut when calling this.
@@ -712,6 +738,9 @@ type ConnectionInfo struct {
 	ClientVersion string `json:"clientVersion"`
 	Type          string `json:"type"`
 	Crypto        string `json:"crypto"`
+	// The name of the device's bandwidth schedule entry currently in
+	// effect, if any.
+	BandwidthProfile string `json:"bandwidthProfile"`
 }
 
 // NumConnections returns the current number of active connected devices.
@@ -727,6 +756,7 @@ func (m *model) ConnectionStats() map[string]interface{} {
 	defer m.pmut.RUnlock()
 
 	res := make(map[string]interface{})
+	now := time.Now()
 	devs := m.cfg.Devices()
 	conns := make(map[string]ConnectionInfo, len(devs))
 	for device, deviceCfg := range devs {
@@ -735,9 +765,11 @@ func (m *model) ConnectionStats() map[string]interface{} {
 		if hello.ClientName != "syncthing" {
 			versionString = hello.ClientName + " " + hello.ClientVersion
 		}
+		_, _, profile := deviceCfg.BandwidthLimits(now)
 		ci := ConnectionInfo{
-			ClientVersion: strings.TrimSpace(versionString),
-			Paused:        deviceCfg.Paused,
+			ClientVersion:    strings.TrimSpace(versionString),
+			Paused:           deviceCfg.Paused,
+			BandwidthProfile: profile,
 		}
 		if conn, ok := m.conn[device]; ok {
 			ci.Type = conn.Type()
@@ -755,10 +787,12 @@ func (m *model) ConnectionStats() map[string]interface{} {
 	res["connections"] = conns
 
 	in, out := protocol.TotalInOut()
+	_, _, profile := m.cfg.Options().BandwidthLimits(now)
 	res["total"] = map[string]interface{}{
-		"at":            time.Now().Truncate(time.Second),
-		"inBytesTotal":  in,
-		"outBytesTotal": out,
+		"at":               now.Truncate(time.Second),
+		"inBytesTotal":     in,
+		"outBytesTotal":    out,
+		"bandwidthProfile": profile,
 	}
 
 	return res
@@ -891,6 +925,7 @@ func (m *model) folderCompletion(device protocol.DeviceID, folder string) (Folde
 	m.fmut.RLock()
 	err := m.checkFolderRunningLocked(folder)
 	rf := m.folderFiles[folder]
+	cfg := m.folderCfgs[folder]
 	m.fmut.RUnlock()
 	if err != nil {
 		return FolderCompletion{}, err
@@ -904,16 +939,20 @@ func (m *model) folderCompletion(device protocol.DeviceID, folder string) (Folde
 
 	m.pmut.RLock()
 	downloaded := m.deviceDownloads[device].BytesDownloaded(folder)
+	sel := m.remoteSelections[device][folder]
 	m.pmut.RUnlock()
+	if device == protocol.LocalDeviceID {
+		sel = db.NewPathSelection(selectedPaths(cfg))
+	}
 
-	need := snap.NeedSize(device)
+	need := snap.NeedSizeIn(device, sel)
 	need.Bytes -= downloaded
 	// This might might be more than it really is, because some blocks can be of a smaller size.
 	if need.Bytes < 0 {
 		need.Bytes = 0
 	}
 
-	comp := newFolderCompletion(snap.GlobalSize(), need, snap.Sequence(device))
+	comp := newFolderCompletion(snap.GlobalSizeIn(sel), need, snap.Sequence(device))
 
 	l.Debugf("%v Completion(%s, %q): %v", m, device, folder, comp.Map())
 	return comp, nil
@@ -986,11 +1025,15 @@ func (m *model) NeedFolderFiles(folder string, page, perpage int) ([]db.FileInfo
 		p.toSkip -= skipped
 	}
 
+	sel := db.NewPathSelection(selectedPaths(cfg))
 	rest = make([]db.FileInfoTruncated, 0, perpage)
 	snap.WithNeedTruncated(protocol.LocalDeviceID, func(f protocol.FileIntf) bool {
 		if cfg.IgnoreDelete && f.IsDeleted() {
 			return true
 		}
+		if !sel.Contains(f.FileName()) {
+			return true
+		}
 
 		if p.skip() {
 			return true
@@ -1023,9 +1066,16 @@ func (m *model) RemoteNeedFolderFiles(folder string, device protocol.DeviceID, p
 	}
 	defer snap.Release()
 
+	m.pmut.RLock()
+	sel := m.remoteSelections[device][folder]
+	m.pmut.RUnlock()
+
 	files := make([]db.FileInfoTruncated, 0, perpage)
 	p := newPager(page, perpage)
 	snap.WithNeedTruncated(device, func(f protocol.FileIntf) bool {
+		if !sel.Contains(f.FileName()) {
+			return true
+		}
 		if p.skip() {
 			return true
 		}
@@ -1228,8 +1278,28 @@ func (m *model) ClusterConfig(deviceID protocol.DeviceID, cm protocol.ClusterCon
 		return err
 	}
 
+	m.recordPeerAddresses(deviceID, cm)
+
+	selections := make(map[string]db.PathSelection)
+	chunking := make(map[string]struct{})
+	for _, folder := range cm.Folders {
+		if sel := db.NewPathSelection(folder.SelectedPaths); !sel.IsEmpty() {
+			selections[folder.ID] = sel
+		}
+		if folder.ContentDefinedChunking {
+			chunking[folder.ID] = struct{}{}
+		} else if fcfg, ok := m.cfg.Folder(folder.ID); ok && fcfg.SharedWith(deviceID) && fcfg.ContentDefinedChunking {
+			l.Infof("Device %v doesn't support content defined chunking on folder %v, files are scanned with fixed size blocks", deviceID.Short(), fcfg.Description())
+		}
+	}
+
 	m.pmut.Lock()
 	m.remotePausedFolders[deviceID] = paused
+	// Unlike the paused folders, the selections are kept when the device
+	// disconnects, as they still apply to the completion of the index we
+	// have from it.
+	m.remoteSelections[deviceID] = selections
+	m.remoteChunking[deviceID] = chunking
 	m.pmut.Unlock()
 
 	if len(tempIndexFolders) > 0 {
@@ -1932,7 +2002,7 @@ func (m *model) recheckFile(deviceID protocol.DeviceID, folder, name string, off
 		return
 	}
 
-	blockIndex := int(offset / int64(cf.BlockSize()))
+	blockIndex := cf.BlockIndex(offset)
 	if blockIndex >= len(cf.Blocks) {
 		l.Debugf("%v recheckFile: %s: %q / %q i=%d: block index too far", m, deviceID, folder, name, blockIndex)
 		return
@@ -2159,16 +2229,20 @@ func (m *model) OnHello(remoteID protocol.DeviceID, addr net.Addr, hello protoco
 // GetHello is called when we are about to connect to some remote device.
 func (m *model) GetHello(id protocol.DeviceID) protocol.HelloIntf {
 	name := ""
-	if _, ok := m.cfg.Device(id); ok {
+	numConns := 1
+	if devCfg, ok := m.cfg.Device(id); ok {
 		// Set our name (from the config of our device ID) only if we already know about the other side device ID.
 		if myCfg, ok := m.cfg.Device(m.id); ok {
 			name = myCfg.Name
 		}
+		numConns = devCfg.NumConnections
 	}
 	return &protocol.Hello{
-		DeviceName:    name,
-		ClientName:    m.clientName,
-		ClientVersion: m.clientVersion,
+		DeviceName:     name,
+		ClientName:     m.clientName,
+		ClientVersion:  m.clientVersion,
+		Compressions:   protocol.SupportedCompressions(),
+		NumConnections: numConns,
 	}
 }
 
@@ -2411,6 +2485,12 @@ func (m *model) numHashers(folder string) int {
 func (m *model) generateClusterConfig(device protocol.DeviceID) (protocol.ClusterConfig, map[string]string) {
 	var message protocol.ClusterConfig
 
+	// Acquires pmut, so has to be done before fmut.
+	var observed map[protocol.DeviceID][]string
+	if m.peerExchangeTrusted(device) {
+		observed = m.observedAddresses()
+	}
+
 	m.fmut.RLock()
 	defer m.fmut.RUnlock()
 
@@ -2429,12 +2509,14 @@ func (m *model) generateClusterConfig(device protocol.DeviceID) (protocol.Cluste
 		}
 
 		protocolFolder := protocol.Folder{
-			ID:                 folderCfg.ID,
-			Label:              folderCfg.Label,
-			ReadOnly:           folderCfg.Type == config.FolderTypeSendOnly,
-			IgnorePermissions:  folderCfg.IgnorePerms,
-			IgnoreDelete:       folderCfg.IgnoreDelete,
-			DisableTempIndexes: folderCfg.DisableTempIndexes,
+			ID:                     folderCfg.ID,
+			Label:                  folderCfg.Label,
+			ReadOnly:               folderCfg.Type == config.FolderTypeSendOnly,
+			IgnorePermissions:      folderCfg.IgnorePerms,
+			IgnoreDelete:           folderCfg.IgnoreDelete,
+			DisableTempIndexes:     folderCfg.DisableTempIndexes,
+			SelectedPaths:          selectedPaths(folderCfg),
+			ContentDefinedChunking: folderCfg.ContentDefinedChunking,
 		}
 
 		fs := m.folderFiles[folderCfg.ID]
@@ -2456,6 +2538,9 @@ func (m *model) generateClusterConfig(device protocol.DeviceID) (protocol.Cluste
 				CertName:    deviceCfg.CertName,
 				Introducer:  deviceCfg.Introducer,
 			}
+			if deviceCfg.DeviceID != device {
+				protocolDevice.ObservedAddresses = observed[deviceCfg.DeviceID]
+			}
 
 			if deviceCfg.DeviceID == m.id && hasEncryptionToken {
 				protocolDevice.EncryptionPasswordToken = encryptionToken
@@ -2551,6 +2636,66 @@ func (m *model) Revert(folder string) {
 	runner.Revert()
 }
 
+// DeletionHold returns the deletions held back on the given folder, if any.
+func (m *model) DeletionHold(folder string) (DeletionHold, bool, error) {
+	m.fmut.RLock()
+	_, ok := m.folderCfgs[folder]
+	m.fmut.RUnlock()
+	if !ok {
+		return DeletionHold{}, false, ErrFolderMissing
+	}
+	hold, ok := loadDeletionHold(db.NewMiscDataNamespace(m.db), folder)
+	return hold, ok, nil
+}
+
+func (m *model) ApproveDeletions(folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return runner.ApproveDeletions()
+}
+
+func (m *model) RejectDeletions(folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+	return runner.RejectDeletions()
+}
+
+// FolderScrub returns the result of the last integrity scrub of the given
+// folder, if any.
+func (m *model) FolderScrub(folder string) (ScrubResult, bool, error) {
+	m.fmut.RLock()
+	_, ok := m.folderCfgs[folder]
+	m.fmut.RUnlock()
+	if !ok {
+		return ScrubResult{}, false, ErrFolderMissing
+	}
+	res, ok := loadScrubResult(db.NewMiscDataNamespace(m.db), folder)
+	return res, ok, nil
+}
+
+// ScrubFolder schedules an integrity scrub of the given folder.
+func (m *model) ScrubFolder(folder string) error {
+	m.fmut.RLock()
+	err := m.checkFolderRunningLocked(folder)
+	runner := m.folderRunners[folder]
+	m.fmut.RUnlock()
+	if err != nil {
+		return err
+	}
+	runner.Scrub()
+	return nil
+}
+
 type TreeEntry struct {
 	Name     string                `json:"name"`
 	ModTime  time.Time             `json:"modTime"`
@@ -2710,6 +2855,44 @@ func (m *model) Availability(folder string, file protocol.FileInfo, block protoc
 	return m.availabilityInSnapshotPRlocked(cfg, snap, file, block), nil
 }
 
+// contentDefinedChunking returns whether files in the folder are scanned
+// with content defined chunking. Besides being enabled on the folder, all
+// devices it's shared with must have announced support for it, as older
+// versions can't handle blocks of varying size.
+func (m *model) contentDefinedChunking(fcfg config.FolderConfiguration) bool {
+	if !fcfg.ContentDefinedChunking {
+		return false
+	}
+	m.pmut.RLock()
+	defer m.pmut.RUnlock()
+	for _, device := range fcfg.DeviceIDs() {
+		if device == m.id {
+			continue
+		}
+		if _, ok := m.remoteChunking[device][fcfg.ID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// mayHaveVariableBlocks returns whether files in the folder may have content
+// defined blocks, i.e. whether we or any device it's shared with announced
+// content defined chunking on it.
+func (m *model) mayHaveVariableBlocks(fcfg config.FolderConfiguration) bool {
+	if fcfg.ContentDefinedChunking {
+		return true
+	}
+	m.pmut.RLock()
+	defer m.pmut.RUnlock()
+	for _, device := range fcfg.DeviceIDs() {
+		if _, ok := m.remoteChunking[device][fcfg.ID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *model) availabilityInSnapshot(cfg config.FolderConfiguration, snap *db.Snapshot, file protocol.FileInfo, block protocol.BlockInfo) []Availability {
 	m.pmut.RLock()
 	defer m.pmut.RUnlock()
@@ -2732,7 +2915,7 @@ func (m *model) availabilityInSnapshotPRlocked(cfg config.FolderConfiguration, s
 	}
 
 	for _, device := range cfg.Devices {
-		if m.deviceDownloads[device.DeviceID].Has(cfg.ID, file.Name, file.Version, int(block.Offset/int64(file.BlockSize()))) {
+		if m.deviceDownloads[device.DeviceID].Has(cfg.ID, file.Name, file.Version, file.BlockIndex(block.Offset)) {
 			availabilities = append(availabilities, Availability{ID: device.DeviceID, FromTemporary: true})
 		}
 	}
@@ -2908,7 +3091,7 @@ func (m *model) CommitConfiguration(from, to config.Configuration) bool {
 	}
 	m.fmut.Unlock()
 
-	m.pmut.RLock()
+	m.pmut.Lock()
 	for _, id := range closeDevices {
 		delete(clusterConfigDevices, id)
 		if conn, ok := m.conn[id]; ok {
@@ -2917,11 +3100,13 @@ func (m *model) CommitConfiguration(from, to config.Configuration) bool {
 	}
 	for _, id := range removedDevices {
 		delete(clusterConfigDevices, id)
+		delete(m.remoteSelections, id)
+		delete(m.remoteChunking, id)
 		if conn, ok := m.conn[id]; ok {
 			go conn.Close(errDeviceRemoved)
 		}
 	}
-	m.pmut.RUnlock()
+	m.pmut.Unlock()
 	// Generating cluster-configs acquires fmut -> must happen outside of pmut.
 	m.sendClusterConfig(clusterConfigDevices.AsSlice())
 
@@ -13,8 +13,10 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"runtime/pprof"
 	"sort"
@@ -29,6 +31,7 @@ import (
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/db"
 	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/discover"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/ignore"
@@ -3188,7 +3191,7 @@ func TestConnCloseOnRestart(t *testing.T) {
 
 	br := &testutils.BlockingRW{}
 	nw := &testutils.NoopRW{}
-	m.AddConnection(protocol.NewConnection(device1, br, nw, testutils.NoopCloser{}, m, new(protocolmocks.ConnectionInfo), protocol.CompressionNever, nil), protocol.Hello{})
+	m.AddConnection(protocol.NewConnection(device1, br, nw, testutils.NoopCloser{}, m, new(protocolmocks.ConnectionInfo), protocol.CompressionNever, protocol.MessageCompressionLZ4, nil), protocol.Hello{})
 	m.pmut.RLock()
 	if len(m.closed) != 1 {
 		t.Fatalf("Expected just one conn (len(m.conn) == %v)", len(m.conn))
@@ -3970,6 +3973,68 @@ func TestCompletionEmptyGlobal(t *testing.T) {
 	}
 }
 
+func TestCompletionSelectedPaths(t *testing.T) {
+	m, _, fcfg, wcfgCancel := setupModelWithConnection(t)
+	defer wcfgCancel()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	version := protocol.Vector{}.Update(myID.Short())
+	files := []protocol.FileInfo{
+		{Name: "selected", Type: protocol.FileInfoTypeDirectory, Version: version},
+		{Name: "selected/foo", Size: 10, Version: version},
+		{Name: "other", Type: protocol.FileInfoTypeDirectory, Version: version},
+		{Name: "other/bar", Size: 20, Version: version},
+	}
+	localIndexUpdate(m, fcfg.ID, files)
+
+	cc := basicClusterConfig(myID, device1, fcfg.ID)
+	cc.Folders[0].SelectedPaths = []string{"selected"}
+	must(t, m.ClusterConfig(device1, cc))
+
+	remote := files[0]
+	remote.Sequence = 1
+	must(t, m.Index(device1, fcfg.ID, []protocol.FileInfo{remote}))
+
+	comp := m.testCompletion(device1, fcfg.ID)
+	if comp.NeedItems != 1 {
+		t.Error("Expected one needed item, got", comp.NeedItems)
+	}
+	if comp.GlobalItems != 2 {
+		t.Error("Expected two global items, got", comp.GlobalItems)
+	}
+}
+
+func TestContentDefinedChunkingAnnounced(t *testing.T) {
+	w, fcfg, wCancel := tmpDefaultWrapper()
+	defer wCancel()
+	fcfg.ContentDefinedChunking = true
+	setFolder(t, w, fcfg)
+	m, _ := setupModelWithConnectionFromWrapper(t, w)
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	if m.contentDefinedChunking(fcfg) {
+		t.Error("chunking before the device announced it")
+	}
+	if !m.mayHaveVariableBlocks(fcfg) {
+		t.Error("no variable blocks with chunking enabled")
+	}
+
+	cc := basicClusterConfig(myID, device1, fcfg.ID)
+	cc.Folders[0].ContentDefinedChunking = true
+	must(t, m.ClusterConfig(device1, cc))
+	if !m.contentDefinedChunking(fcfg) {
+		t.Error("not chunking after the device announced it")
+	}
+
+	fcfg.ContentDefinedChunking = false
+	if m.contentDefinedChunking(fcfg) {
+		t.Error("chunking while disabled")
+	}
+	if !m.mayHaveVariableBlocks(fcfg) {
+		t.Error("no variable blocks while the device chunks")
+	}
+}
+
 func TestNeedMetaAfterIndexReset(t *testing.T) {
 	w, fcfg, wCancel := tmpDefaultWrapper()
 	defer wCancel()
@@ -4307,3 +4372,183 @@ func equalStringsInAnyOrder(a, b []string) bool {
 	}
 	return true
 }
+
+func TestConflictOriginal(t *testing.T) {
+	cases := []struct {
+		name, orig string
+	}{
+		{"foo.sync-conflict-20220102-030405-ABCDEFG.txt", "foo.txt"},
+		{"foo.sync-conflict-20220102-030405-ABCDEFG", "foo"},
+		{filepath.Join("dir.d", "foo.sync-conflict-20220102-030405-ABCDEFG"), filepath.Join("dir.d", "foo")},
+		{"foo.tar.sync-conflict-20220102-030405-ABCDEFG.gz", "foo.tar.gz"},
+		{"foo.txt", ""},
+		{"foo.sync-conflict-2022-ABCDEFG.txt", ""},
+	}
+	for _, tc := range cases {
+		orig, ok := conflictOriginal(tc.name)
+		if ok != (tc.orig != "") || orig != tc.orig {
+			t.Errorf("conflictOriginal(%q) = %q, %v; expected %q", tc.name, orig, ok, tc.orig)
+		}
+	}
+}
+
+func TestResolveConflict(t *testing.T) {
+	w, wCancel := createTmpWrapper(defaultCfgWrapper.RawCopy())
+	defer wCancel()
+	fcfg := testFolderConfigTmp()
+	ffs := fcfg.Filesystem()
+	setFolder(t, w, fcfg)
+
+	name := "foo.txt"
+	copy1 := "foo.sync-conflict-20220102-030405-ABCDEFG.txt"
+	copy2 := "foo.sync-conflict-20220102-030406-ABCDEFG.txt"
+	writeFile(t, ffs, name, []byte("original"))
+	writeFile(t, ffs, copy1, []byte("first copy"))
+	writeFile(t, ffs, copy2, []byte("second copy"))
+
+	m := setupModel(t, w)
+	defer cleanupModelAndRemoveDir(m, ffs.URI())
+
+	conflicts, err := m.FolderConflicts(fcfg.ID)
+	must(t, err)
+	if len(conflicts) != 1 || conflicts[0].Name != name {
+		t.Fatalf("expected one conflict on %v, got %+v", name, conflicts)
+	}
+	var names []string
+	for _, v := range conflicts[0].Versions {
+		names = append(names, v.Name)
+	}
+	if expected := []string{name, copy1, copy2}; strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Fatalf("got versions %v, expected %v", names, expected)
+	}
+	if v := conflicts[0].Versions[1]; v.Size != int64(len("first copy")) || v.Version.IsEmpty() {
+		t.Errorf("unexpected version details %+v", v)
+	}
+
+	if err := m.ResolveConflict(fcfg.ID, name, "foo.txt.bak"); err == nil {
+		t.Error("expected error when keeping a file that isn't a version")
+	}
+	if err := m.ResolveConflict(fcfg.ID, name, "foo.sync-conflict-20220102-030407-ABCDEFG.txt"); err == nil {
+		t.Error("expected error when keeping a conflict copy that doesn't exist")
+	}
+	if _, err := ffs.Lstat(name); err != nil {
+		t.Errorf("original was touched by a rejected resolution: %v", err)
+	}
+
+	must(t, m.ResolveConflict(fcfg.ID, name, copy1))
+
+	fd, err := ffs.Open(name)
+	must(t, err)
+	bs, err := io.ReadAll(fd)
+	fd.Close()
+	must(t, err)
+	if string(bs) != "first copy" {
+		t.Errorf("file contains %q after resolving", bs)
+	}
+	for _, c := range []string{copy1, copy2} {
+		if _, err := ffs.Lstat(c); !fs.IsNotExist(err) {
+			t.Errorf("conflict copy %v still exists", c)
+		}
+	}
+	conflicts, err = m.FolderConflicts(fcfg.ID)
+	must(t, err)
+	if len(conflicts) != 0 {
+		t.Errorf("expected no conflicts after resolving, got %+v", conflicts)
+	}
+}
+
+func TestPeerExchange(t *testing.T) {
+	w, fcfg, wcfgCancel := tmpDefaultWrapper()
+	defer wcfgCancel()
+	untrusted := protocol.NewDeviceID([]byte("untrusted"))
+	waiter, err := w.Modify(func(cfg *config.Configuration) {
+		cfg.Options.PeerExchangeEnabled = true
+		cfg.SetDevice(newDeviceConfiguration(cfg.Defaults.Device, device2, "device2"))
+		untrustedCfg := newDeviceConfiguration(cfg.Defaults.Device, untrusted, "untrusted")
+		untrustedCfg.Untrusted = true
+		cfg.SetDevice(untrustedCfg)
+		fcfg.Devices = append(fcfg.Devices,
+			config.FolderDeviceConfiguration{DeviceID: device2},
+			config.FolderDeviceConfiguration{DeviceID: untrusted, EncryptionPassword: "secret"},
+		)
+		cfg.SetFolder(fcfg)
+	})
+	must(t, err)
+	waiter.Wait()
+
+	m := newModel(t, w, myID, "syncthing", "dev", nil)
+	peers := discover.NewPeerExchange(myID)
+	m.peers = peers
+	m.ServeBackground()
+	defer cleanupModelAndRemoveDir(m, fcfg.Filesystem().URI())
+
+	// We dialed device1, while device2 connected to us.
+	fc1 := newFakeConnection(device1, m)
+	fc1.TypeReturns("tcp-client")
+	fc1.RemoteAddrReturns(&net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 22000})
+	m.AddConnection(fc1, protocol.Hello{})
+	fc2 := newFakeConnection(device2, m)
+	fc2.TypeReturns("tcp-server")
+	fc2.RemoteAddrReturns(&net.TCPAddr{IP: net.ParseIP("192.0.2.2"), Port: 54321})
+	m.AddConnection(fc2, protocol.Hello{})
+
+	cm, _ := m.generateClusterConfig(device2)
+	if len(cm.Folders) != 1 {
+		t.Fatalf("expected one folder, got %d", len(cm.Folders))
+	}
+	for _, dev := range cm.Folders[0].Devices {
+		var expected []string
+		if dev.ID == device1 {
+			expected = []string{"tcp://192.0.2.1:22000"}
+		}
+		if !reflect.DeepEqual(dev.ObservedAddresses, expected) {
+			t.Errorf("observed addresses of %v are %v, expected %v", dev.ID, dev.ObservedAddresses, expected)
+		}
+	}
+
+	// Device2 tells us where it reaches device1, and about a device we
+	// don't know.
+	unknown := protocol.NewDeviceID([]byte("unknown"))
+	must(t, m.ClusterConfig(device2, protocol.ClusterConfig{
+		Folders: []protocol.Folder{
+			{
+				ID: fcfg.ID,
+				Devices: []protocol.Device{
+					{ID: myID},
+					{ID: device2},
+					{ID: device1, ObservedAddresses: []string{"tcp://198.51.100.1:22000"}},
+					{ID: unknown, ObservedAddresses: []string{"tcp://198.51.100.2:22000"}},
+				},
+			},
+		},
+	}))
+
+	addrs, _ := peers.Lookup(context.Background(), device1)
+	if !reflect.DeepEqual(addrs, []string{"tcp://198.51.100.1:22000"}) {
+		t.Errorf("unexpected addresses %v for device1", addrs)
+	}
+	if addrs, _ := peers.Lookup(context.Background(), unknown); len(addrs) != 0 {
+		t.Errorf("unexpected addresses %v for an unknown device", addrs)
+	}
+
+	// The untrusted device neither learns nor tells us where devices are.
+	cm, _ = m.generateClusterConfig(untrusted)
+	for _, dev := range cm.Folders[0].Devices {
+		if len(dev.ObservedAddresses) != 0 {
+			t.Errorf("observed addresses of %v are shared with an untrusted device", dev.ID)
+		}
+	}
+	m.recordPeerAddresses(untrusted, protocol.ClusterConfig{
+		Folders: []protocol.Folder{
+			{
+				ID: fcfg.ID,
+				Devices: []protocol.Device{
+					{ID: device2, ObservedAddresses: []string{"tcp://198.51.100.3:22000"}},
+				},
+			},
+		},
+	})
+	if addrs, _ := peers.Lookup(context.Background(), device2); len(addrs) != 0 {
+		t.Errorf("unexpected addresses %v from an untrusted device", addrs)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/hex"
+	"io"
+	"math"
+	"path/filepath"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// A blockCache keeps blocks on disk, named by their hash, for on-demand
+// folders. When the blocks take up more than the limit, the least recently
+// used ones are removed.
+type blockCache struct {
+	fs    fs.Filesystem
+	limit int64
+
+	mut  sync.Mutex
+	lru  *simplelru.LRU // block name -> size
+	size int64
+}
+
+func newBlockCache(filesystem fs.Filesystem, limit int64) (*blockCache, error) {
+	c := &blockCache{
+		fs:    filesystem,
+		limit: limit,
+		mut:   sync.NewMutex(),
+	}
+	var err error
+	// The limit is in bytes, not in the number of entries.
+	c.lru, err = simplelru.NewLRU(math.MaxInt32, c.evicted)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := filesystem.Lstat("."); fs.IsNotExist(err) {
+		err = filesystem.MkdirAll(".", 0700)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Pick up the blocks cached by earlier runs, in no particular order.
+	err = filesystem.Walk(".", func(name string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsRegular() {
+			return nil
+		}
+		if _, err := hex.DecodeString(filepath.Base(name)); err != nil {
+			// Leftover temporary file
+			return filesystem.Remove(name)
+		}
+		c.add(name, info.Size())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get returns the data of the block, if it's in the cache and intact.
+func (c *blockCache) Get(block protocol.BlockInfo) ([]byte, bool) {
+	name := blockCacheName(block.Hash)
+
+	c.mut.Lock()
+	_, ok := c.lru.Get(name)
+	c.mut.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	buf, err := c.read(name, block.Size)
+	if err == nil {
+		err = verifyBuffer(buf, block)
+	}
+	if err != nil {
+		l.Debugf("Dropping cached block %s: %v", name, err)
+		c.mut.Lock()
+		c.lru.Remove(name)
+		c.mut.Unlock()
+		return nil, false
+	}
+	return buf, true
+}
+
+// Put adds the data of the block to the cache, making room for it as
+// required.
+func (c *blockCache) Put(block protocol.BlockInfo, data []byte) {
+	if int64(len(data)) > c.limit {
+		return
+	}
+	name := blockCacheName(block.Hash)
+
+	c.mut.Lock()
+	_, ok := c.lru.Get(name)
+	c.mut.Unlock()
+	if ok {
+		return
+	}
+
+	if err := c.write(name, data); err != nil {
+		l.Debugf("Caching block %s: %v", name, err)
+		return
+	}
+
+	c.mut.Lock()
+	c.add(name, int64(len(data)))
+	c.mut.Unlock()
+}
+
+// add adds an entry for an existing block file, removing the oldest ones
+// to stay within the limit. It's called with the mutex held, or before the
+// cache is in use.
+func (c *blockCache) add(name string, size int64) {
+	if c.lru.Contains(name) {
+		return
+	}
+	c.lru.Add(name, size)
+	c.size += size
+	for c.size > c.limit && c.lru.Len() > 0 {
+		c.lru.RemoveOldest()
+	}
+}
+
+func (c *blockCache) evicted(key, value interface{}) {
+	c.size -= value.(int64)
+	if err := c.fs.Remove(key.(string)); err != nil && !fs.IsNotExist(err) {
+		l.Debugf("Removing cached block %s: %v", key, err)
+	}
+}
+
+func (c *blockCache) read(name string, size int) ([]byte, error) {
+	fd, err := c.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(fd, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// write writes the block file via a uniquely named temporary file, so that
+// an interrupted or concurrent write doesn't leave a partial block behind.
+func (c *blockCache) write(name string, data []byte) error {
+	if err := c.fs.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		return err
+	}
+	tempName := name + "." + rand.String(8) + ".tmp"
+	fd, err := c.fs.Create(tempName)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(data); err != nil {
+		fd.Close()
+		c.fs.Remove(tempName)
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		c.fs.Remove(tempName)
+		return err
+	}
+	return c.fs.Rename(tempName, name)
+}
+
+// blockCacheName returns the name of the file for the block with the given
+// hash, spread over subdirectories by the first byte.
+func blockCacheName(hash []byte) string {
+	name := hex.EncodeToString(hash)
+	if len(name) < 2 {
+		return name
+	}
+	return filepath.Join(name[:2], name)
+}
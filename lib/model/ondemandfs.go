@@ -0,0 +1,178 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The onDemandFS is the read-only view of the global index of an on-demand
+// folder. Names are slash separated and relative to the folder root, which
+// is the empty name.
+type onDemandFS struct {
+	model *model
+	cfg   config.FolderConfiguration
+	fset  *db.FileSet
+	cache *blockCache
+
+	mut  sync.Mutex
+	dirs map[string]onDemandDir // directory name -> children
+}
+
+// An onDemandDir is a cached directory listing, valid as long as the index
+// hasn't changed.
+type onDemandDir struct {
+	sequence int64
+	children []db.FileInfoTruncated
+}
+
+func newOnDemandFS(model *model, cfg config.FolderConfiguration, fset *db.FileSet, cache *blockCache) *onDemandFS {
+	return &onDemandFS{
+		model: model,
+		cfg:   cfg,
+		fset:  fset,
+		cache: cache,
+		mut:   sync.NewMutex(),
+		dirs:  make(map[string]onDemandDir),
+	}
+}
+
+// lookup returns the global file with the given name, if it exists.
+func (f *onDemandFS) lookup(name string) (db.FileInfoTruncated, bool) {
+	snap, err := f.fset.Snapshot()
+	if err != nil {
+		return db.FileInfoTruncated{}, false
+	}
+	defer snap.Release()
+	file, ok := snap.GetGlobalTruncated(name)
+	if !ok || !onDemandVisible(file) {
+		return db.FileInfoTruncated{}, false
+	}
+	return file, true
+}
+
+// open returns the global file with the given name, including the blocks,
+// for reading.
+func (f *onDemandFS) open(name string) (protocol.FileInfo, bool) {
+	snap, err := f.fset.Snapshot()
+	if err != nil {
+		return protocol.FileInfo{}, false
+	}
+	defer snap.Release()
+	file, ok := snap.GetGlobal(name)
+	if !ok || !onDemandVisible(file) || file.IsDirectory() || file.IsSymlink() {
+		return protocol.FileInfo{}, false
+	}
+	return file, true
+}
+
+// readDir returns the global files directly within the given directory.
+func (f *onDemandFS) readDir(dir string) ([]db.FileInfoTruncated, error) {
+	snap, err := f.fset.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snap.Release()
+
+	sequence := snap.Sequence(protocol.LocalDeviceID) + snap.RemoteSequence()
+	f.mut.Lock()
+	cached, ok := f.dirs[dir]
+	f.mut.Unlock()
+	if ok && cached.sequence == sequence {
+		return cached.children, nil
+	}
+
+	var children []db.FileInfoTruncated
+	snap.WithGlobalChildrenTruncated(dir, func(intf protocol.FileIntf) bool {
+		if file := intf.(db.FileInfoTruncated); onDemandVisible(file) {
+			children = append(children, file)
+		}
+		return true
+	})
+
+	f.mut.Lock()
+	f.dirs[dir] = onDemandDir{sequence: sequence, children: children}
+	f.mut.Unlock()
+	return children, nil
+}
+
+// readAt reads from the given file at the offset, pulling the blocks that
+// aren't in the cache from the devices that have them.
+func (f *onDemandFS) readAt(ctx context.Context, file protocol.FileInfo, buf []byte, offset int64) (int, error) {
+	if offset >= file.Size {
+		return 0, nil
+	}
+	if rest := file.Size - offset; int64(len(buf)) > rest {
+		buf = buf[:rest]
+	}
+
+	n := 0
+	for _, block := range file.Blocks {
+		end := block.Offset + int64(block.Size)
+		if end <= offset {
+			continue
+		}
+		if block.Offset >= offset+int64(len(buf)) {
+			break
+		}
+
+		data, ok := f.cache.Get(block)
+		if !ok {
+			var err error
+			if data, err = f.pullBlock(ctx, file, block); err != nil {
+				return n, err
+			}
+			f.cache.Put(block, data)
+		}
+
+		start := int64(0)
+		if offset > block.Offset {
+			start = offset - block.Offset
+		}
+		n += copy(buf[block.Offset+start-offset:], data[start:])
+	}
+	return n, nil
+}
+
+// pullBlock requests the block from the devices that have it, until one of
+// them returns the expected data.
+func (f *onDemandFS) pullBlock(ctx context.Context, file protocol.FileInfo, block protocol.BlockInfo) ([]byte, error) {
+	// Not using a snapshot, as that would be held for the duration of the
+	// requests.
+	availables, err := f.model.Availability(f.cfg.ID, file, block)
+	if err != nil {
+		return nil, errors.Wrap(err, "pull")
+	}
+	lastError := errNoDevice
+	blockNo := file.BlockIndex(block.Offset)
+	for _, available := range availables {
+		buf, err := f.model.requestGlobal(ctx, available.ID, f.cfg.ID, file.Name, blockNo, block.Offset, block.Size, block.Hash, block.WeakHash, available.FromTemporary)
+		if err == nil {
+			err = verifyBuffer(buf, block)
+		}
+		if err != nil {
+			l.Debugln("on-demand request:", f.cfg.ID, file.Name, block.Offset, block.Size, available.ID.Short(), "returned error:", err)
+			lastError = err
+			continue
+		}
+		return buf, nil
+	}
+	return nil, errors.Wrap(lastError, "pull")
+}
+
+// onDemandVisible returns whether the global file is part of the on-demand
+// view, i.e. it exists and isn't ignored somewhere.
+func onDemandVisible(file protocol.FileIntf) bool {
+	return !file.IsDeleted() && !file.IsInvalid()
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package model
+
+import (
+	"context"
+	"os"
+	"path"
+	"time"
+
+	"bazil.org/fuse"
+	fusefs "bazil.org/fuse/fs"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// How long the kernel may cache attributes and directory entries.
+const onDemandAttrValid = time.Second
+
+// mountOnDemandFS mounts the view at the given directory and serves it
+// until the returned function is called to unmount it.
+func mountOnDemandFS(dir string, odfs *onDemandFS) (func() error, error) {
+	conn, err := fuse.Mount(dir,
+		fuse.FSName("syncthing"),
+		fuse.Subtype("syncthing"),
+		fuse.ReadOnly(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	served := make(chan error, 1)
+	go func() {
+		served <- fusefs.Serve(conn, onDemandFUSE{odfs})
+	}()
+
+	<-conn.Ready
+	if err := conn.MountError; err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return func() error {
+		err := fuse.Unmount(dir)
+		if err == nil {
+			err = <-served
+		}
+		conn.Close()
+		return err
+	}, nil
+}
+
+type onDemandFUSE struct {
+	fs *onDemandFS
+}
+
+func (f onDemandFUSE) Root() (fusefs.Node, error) {
+	return onDemandNode{fs: f.fs, root: true}, nil
+}
+
+// An onDemandNode is a file, directory or symlink in the global index. The
+// root and the folder marker are directories without an index entry.
+type onDemandNode struct {
+	fs   *onDemandFS
+	root bool
+	file db.FileInfoTruncated
+}
+
+func (n onDemandNode) name() string {
+	if n.root {
+		return ""
+	}
+	return n.file.Name
+}
+
+func (n onDemandNode) Attr(_ context.Context, a *fuse.Attr) error {
+	a.Valid = onDemandAttrValid
+	if n.root || n.file.Name == "" {
+		a.Mode = os.ModeDir | 0555
+		return nil
+	}
+
+	perm := os.FileMode(0444)
+	if !n.file.NoPermissions && !n.fs.cfg.IgnorePerms {
+		perm = os.FileMode(n.file.Permissions) & 0555
+	}
+	switch {
+	case n.file.IsDirectory():
+		a.Mode = os.ModeDir | perm | 0500
+	case n.file.IsSymlink():
+		a.Mode = os.ModeSymlink | 0777
+	default:
+		a.Mode = perm | 0400
+		a.Size = uint64(n.file.Size)
+		a.Blocks = (a.Size + 511) / 512
+	}
+	a.Mtime = n.file.ModTime()
+	a.Ctime = a.Mtime
+	return nil
+}
+
+func (n onDemandNode) Lookup(_ context.Context, name string) (fusefs.Node, error) {
+	if n.root && name == n.fs.cfg.MarkerName {
+		// The marker of the folder, which can't exist in the mount point
+		// itself.
+		return onDemandNode{fs: n.fs}, nil
+	}
+	file, ok := n.fs.lookup(path.Join(n.name(), name))
+	if !ok {
+		return nil, fuse.ENOENT
+	}
+	return onDemandNode{fs: n.fs, file: file}, nil
+}
+
+func (n onDemandNode) ReadDirAll(_ context.Context) ([]fuse.Dirent, error) {
+	if !n.root && n.file.Name == "" {
+		return nil, nil
+	}
+	children, err := n.fs.readDir(n.name())
+	if err != nil {
+		return nil, err
+	}
+	dirents := make([]fuse.Dirent, len(children))
+	for i, child := range children {
+		dirents[i].Name = path.Base(child.Name)
+		switch {
+		case child.IsDirectory():
+			dirents[i].Type = fuse.DT_Dir
+		case child.IsSymlink():
+			dirents[i].Type = fuse.DT_Link
+		default:
+			dirents[i].Type = fuse.DT_File
+		}
+	}
+	return dirents, nil
+}
+
+func (n onDemandNode) Readlink(_ context.Context, _ *fuse.ReadlinkRequest) (string, error) {
+	if !n.file.IsSymlink() {
+		return "", fuse.ENOENT
+	}
+	return n.file.SymlinkTarget, nil
+}
+
+func (n onDemandNode) Open(_ context.Context, _ *fuse.OpenRequest, _ *fuse.OpenResponse) (fusefs.Handle, error) {
+	if n.root || n.file.IsDirectory() || n.file.Name == "" {
+		return n, nil
+	}
+	// The handle reads the version of the file that is current when it's
+	// opened.
+	file, ok := n.fs.open(n.file.Name)
+	if !ok {
+		return nil, fuse.ENOENT
+	}
+	return onDemandHandle{fs: n.fs, file: file}, nil
+}
+
+type onDemandHandle struct {
+	fs   *onDemandFS
+	file protocol.FileInfo
+}
+
+func (h onDemandHandle) Read(ctx context.Context, req *fuse.ReadRequest, resp *fuse.ReadResponse) error {
+	buf := make([]byte, req.Size)
+	n, err := h.fs.readAt(ctx, h.file, buf, req.Offset)
+	if err != nil {
+		l.Infof("Reading %q in on-demand folder %s: %v", h.file.Name, h.fs.cfg.Description(), err)
+		return fuse.EIO
+	}
+	resp.Data = buf[:n]
+	return nil
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build !linux && !darwin && !freebsd
+// +build !linux,!darwin,!freebsd
+
+package model
+
+import "errors"
+
+var errOnDemandUnsupported = errors.New("on-demand folders are not supported on this platform")
+
+func mountOnDemandFS(string, *onDemandFS) (func() error, error) {
+	return nil, errOnDemandUnsupported
+}
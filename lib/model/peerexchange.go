@@ -0,0 +1,140 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/discover"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/util"
+)
+
+const (
+	// How often we check whether the addresses we reach devices at have
+	// changed, sending new cluster configs to share them if so.
+	peerExchangeInterval = 5 * time.Minute
+	// Unchanged addresses are shared again this often, so they don't
+	// expire on the other side while still valid.
+	peerExchangeRefresh = discover.PeerExchangeLifeTime / 2
+	// The most addresses we take from a peer for any one device.
+	maxPeerExchangeAddresses = 16
+)
+
+// Only addresses we dialed ourselves are worth sharing; for incoming
+// connections the remote port is an ephemeral one, and relay and websocket
+// connections don't go straight to the device.
+var peerExchangeSchemes = map[string]string{
+	"tcp-client":  "tcp",
+	"quic-client": "quic",
+}
+
+// observedAddresses returns the addresses we are currently connected to
+// devices at, if we share those.
+func (m *model) observedAddresses() map[protocol.DeviceID][]string {
+	if !m.cfg.Options().PeerExchangeEnabled {
+		return nil
+	}
+
+	m.pmut.RLock()
+	defer m.pmut.RUnlock()
+	observed := make(map[protocol.DeviceID][]string)
+	for id, conn := range m.conn {
+		scheme, ok := peerExchangeSchemes[conn.Type()]
+		if !ok || conn.RemoteAddr() == nil {
+			continue
+		}
+		observed[id] = []string{scheme + "://" + conn.RemoteAddr().String()}
+	}
+	return observed
+}
+
+// peerExchange periodically resends cluster configs to all connected
+// devices when the addresses we would share have changed, or are due to be
+// refreshed.
+func (m *model) peerExchange(ctx context.Context) error {
+	ticker := time.NewTicker(peerExchangeInterval)
+	defer ticker.Stop()
+
+	var prev map[protocol.DeviceID][]string
+	var lastSent time.Time
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		cur := m.observedAddresses()
+		if reflect.DeepEqual(cur, prev) && (len(cur) == 0 || time.Since(lastSent) < peerExchangeRefresh) {
+			continue
+		}
+		prev = cur
+		lastSent = time.Now()
+
+		m.pmut.RLock()
+		ids := make([]protocol.DeviceID, 0, len(m.conn))
+		for id := range m.conn {
+			ids = append(ids, id)
+		}
+		m.pmut.RUnlock()
+		l.Debugln("Observed addresses changed, sending cluster config to", ids)
+		m.sendClusterConfig(ids)
+	}
+}
+
+// peerExchangeTrusted returns whether we exchange addresses with the
+// device. Untrusted devices, and those we share folders with encrypted,
+// should neither learn where our trusted devices are nor tell us where to
+// find them.
+func (m *model) peerExchangeTrusted(id protocol.DeviceID) bool {
+	devCfg, ok := m.cfg.Device(id)
+	if !ok || devCfg.Untrusted {
+		return false
+	}
+	for _, fcfg := range m.cfg.FolderList() {
+		for _, dev := range fcfg.Devices {
+			if dev.DeviceID == id && dev.EncryptionPassword != "" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// recordPeerAddresses hands the addresses the device tells us it reaches
+// our other devices at to the peer exchange finder, replacing what it told
+// us before.
+func (m *model) recordPeerAddresses(from protocol.DeviceID, cm protocol.ClusterConfig) {
+	if m.peers == nil || !m.cfg.Options().PeerExchangeEnabled || !m.peerExchangeTrusted(from) {
+		return
+	}
+
+	observed := make(map[protocol.DeviceID][]string)
+	for _, folder := range cm.Folders {
+		for _, dev := range folder.Devices {
+			if dev.ID == m.id || dev.ID == from {
+				continue
+			}
+			if _, ok := m.cfg.Device(dev.ID); !ok {
+				// Not a mutual peer, we have no use for its addresses.
+				continue
+			}
+			observed[dev.ID] = append(observed[dev.ID], dev.ObservedAddresses...)
+		}
+	}
+
+	for id, addrs := range observed {
+		addrs = util.UniqueTrimmedStrings(addrs)
+		if len(addrs) > maxPeerExchangeAddresses {
+			addrs = addrs[:maxPeerExchangeAddresses]
+		}
+		m.peers.Record(from, id, addrs)
+	}
+}
@@ -0,0 +1,428 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// A ScrubResult summarizes the last integrity scrub of a folder.
+type ScrubResult struct {
+	Started   time.Time     `json:"started"`
+	Finished  time.Time     `json:"finished"`
+	Files     int           `json:"files"`
+	Bytes     int64         `json:"bytes"`
+	Corrupted []CorruptFile `json:"corrupted"`
+}
+
+// A CorruptFile is a file whose contents on disk don't match the block
+// hashes recorded when it was last scanned or synced, while its size and
+// modification time didn't change.
+type CorruptFile struct {
+	Name     string `json:"name"`
+	Blocks   []int  `json:"blocks"`
+	Repaired bool   `json:"repaired"`
+}
+
+// A repairer can restore corrupted files from other devices.
+type repairer interface {
+	repair(files []protocol.FileInfo) map[string]bool
+}
+
+// scrubChunkSize is the amount of data verified at a time, before letting
+// the folder get on with pulls, scans and the like.
+const scrubChunkSize = 64 << 20
+
+// scrubProgress is the state of an unfinished scrub, which is persisted so
+// that it continues where it left off after a restart.
+type scrubProgress struct {
+	Position string      `json:"position"` // the last file checked
+	Result   ScrubResult `json:"result"`
+	names    []string    // the files left to check
+}
+
+func scrubResultKey(folder string) string {
+	return "scrubResult-" + folder
+}
+
+func scrubProgressKey(folder string) string {
+	return "scrubProgress-" + folder
+}
+
+func loadScrubResult(kv *db.NamespacedKV, folder string) (ScrubResult, bool) {
+	bs, ok, err := kv.Bytes(scrubResultKey(folder))
+	if err != nil || !ok {
+		return ScrubResult{}, false
+	}
+	var res ScrubResult
+	if err := json.Unmarshal(bs, &res); err != nil {
+		l.Debugf("Discarding invalid scrub result for %v: %v", folder, err)
+		return ScrubResult{}, false
+	}
+	return res, true
+}
+
+func loadScrubProgress(kv *db.NamespacedKV, folder string) (scrubProgress, bool) {
+	bs, ok, err := kv.Bytes(scrubProgressKey(folder))
+	if err != nil || !ok {
+		return scrubProgress{}, false
+	}
+	var p scrubProgress
+	if err := json.Unmarshal(bs, &p); err != nil {
+		l.Debugf("Discarding invalid scrub progress for %v: %v", folder, err)
+		return scrubProgress{}, false
+	}
+	return p, true
+}
+
+// scrubDelay returns the time until the next scrub, based on when the last
+// one finished, and whether there is one scheduled at all. An unfinished
+// scrub continues right away.
+func (f *folder) scrubDelay() (time.Duration, bool) {
+	kv := db.NewMiscDataNamespace(f.model.db)
+	if _, ok := loadScrubProgress(kv, f.ID); ok {
+		return 0, true
+	}
+	if f.scrubInterval <= 0 {
+		return 0, false
+	}
+	res, ok := loadScrubResult(kv, f.ID)
+	if !ok {
+		return f.scrubInterval, true
+	}
+	if next := time.Until(res.Finished.Add(f.scrubInterval)); next > 0 {
+		return next, true
+	}
+	return 0, true
+}
+
+func (f *folder) Scrub() {
+	// 1-buffered chan
+	select {
+	case f.scrubScheduled <- struct{}{}:
+	default:
+	}
+}
+
+func (f *folder) scrubTimerFired() error {
+	err := f.scrubChunk()
+	switch {
+	case f.scrubProgress != nil:
+		// Check the next files once other pending work got its turn.
+		f.scrubTimer.Reset(0)
+	case f.scrubInterval > 0:
+		f.scrubTimer.Reset(f.scrubInterval)
+	}
+	return err
+}
+
+// scrubRequested starts a scrub right away, unless one is running already.
+func (f *folder) scrubRequested() error {
+	if f.scrubProgress != nil {
+		return nil
+	}
+	if !f.scrubTimer.Stop() {
+		select {
+		case <-f.scrubTimer.C:
+		default:
+		}
+	}
+	return f.scrubTimerFired()
+}
+
+// scrubChunk rehashes the next scrubChunkSize bytes worth of files of the
+// running scrub, starting one if necessary, and compares the result to the
+// block hashes in the database. Files that differ without their size or
+// modification time having changed are corrupt, and are repaired from
+// other devices if enabled.
+func (f *folder) scrubChunk() error {
+	if f.Type == config.FolderTypeReceiveEncrypted {
+		// We only have hash tokens of the encrypted data.
+		return nil
+	}
+	if err := f.getHealthErrorWithoutIgnores(); err != nil {
+		l.Debugln("Skipping scrub of", f.Description(), "due to folder error:", err)
+		// Continue from the persisted progress once it's healthy again.
+		f.scrubProgress = nil
+		return err
+	}
+
+	if f.scrubProgress == nil {
+		if err := f.startScrub(); err != nil {
+			return err
+		}
+	}
+	p := f.scrubProgress
+
+	if err := f.ioLimiter.TakeWithContext(f.ctx, 1); err != nil {
+		return err
+	}
+	defer f.ioLimiter.Give(1)
+
+	prevState, _, prevErr := f.getState()
+	f.setState(FolderScrubbing)
+	defer func() {
+		if prevState == FolderError {
+			f.setError(prevErr)
+		} else {
+			f.setState(prevState)
+		}
+	}()
+
+	var corrupted []protocol.FileInfo
+	var checked int64
+	for len(p.names) > 0 && checked < scrubChunkSize {
+		name := p.names[0]
+		file, blocks, ok := f.scrubFile(name)
+		if err := f.ctx.Err(); err != nil {
+			return err
+		}
+		p.names = p.names[1:]
+		p.Position = name
+		if !ok {
+			continue
+		}
+		checked += file.Size
+		p.Result.Files++
+		p.Result.Bytes += file.Size
+		if len(blocks) == 0 {
+			continue
+		}
+
+		l.Warnf("Folder %v: data of %q doesn't match its hashes in %d blocks, the file is corrupt", f.Description(), name, len(blocks))
+		f.evLogger.Log(events.CorruptionDetected, map[string]interface{}{
+			"folder": f.ID,
+			"item":   name,
+			"blocks": blocks,
+		})
+		p.Result.Corrupted = append(p.Result.Corrupted, CorruptFile{Name: name, Blocks: blocks})
+		corrupted = append(corrupted, file)
+	}
+
+	if len(corrupted) > 0 && f.ScrubRepair {
+		if r, ok := f.puller.(repairer); ok {
+			repaired := r.repair(corrupted)
+			for i := range p.Result.Corrupted {
+				if repaired[p.Result.Corrupted[i].Name] {
+					p.Result.Corrupted[i].Repaired = true
+				}
+			}
+		}
+	}
+
+	kv := db.NewMiscDataNamespace(f.model.db)
+	if len(p.names) > 0 {
+		bs, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		return kv.PutBytes(scrubProgressKey(f.ID), bs)
+	}
+
+	f.scrubProgress = nil
+	p.Result.Finished = time.Now().Truncate(time.Second)
+	l.Infof("Verified %d files in folder %v, %d corrupt", p.Result.Files, f.Description(), len(p.Result.Corrupted))
+
+	bs, err := json.Marshal(p.Result)
+	if err != nil {
+		return err
+	}
+	if err := kv.PutBytes(scrubResultKey(f.ID), bs); err != nil {
+		return err
+	}
+	return kv.Delete(scrubProgressKey(f.ID))
+}
+
+// startScrub lists the files to check, continuing after the position of
+// an unfinished scrub if there is one.
+func (f *folder) startScrub() error {
+	p, ok := loadScrubProgress(db.NewMiscDataNamespace(f.model.db), f.ID)
+	if ok {
+		l.Infof("Continuing to verify the data of folder %v", f.Description())
+	} else {
+		p = scrubProgress{
+			Result: ScrubResult{
+				Started:   time.Now().Truncate(time.Second),
+				Corrupted: []CorruptFile{},
+			},
+		}
+		l.Infof("Verifying the data of folder %v", f.Description())
+	}
+
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	snap.WithHaveTruncated(protocol.LocalDeviceID, func(fi protocol.FileIntf) bool {
+		name := fi.FileName()
+		if name > p.Position && !fi.IsDeleted() && !fi.IsInvalid() && !fi.IsDirectory() && !fi.IsSymlink() && f.selection.Contains(name) {
+			p.names = append(p.names, name)
+		}
+		return true
+	})
+
+	f.scrubProgress = &p
+	return nil
+}
+
+// scrubFile checks the blocks of the named file against the database,
+// returning the indexes of those that don't match. The boolean is false if
+// the file couldn't be checked, for example due to it having changed since
+// the last scan.
+func (f *folder) scrubFile(name string) (protocol.FileInfo, []int, bool) {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return protocol.FileInfo{}, nil, false
+	}
+	file, ok := snap.Get(protocol.LocalDeviceID, name)
+	snap.Release()
+	if !ok || file.IsDeleted() || file.IsInvalid() {
+		return protocol.FileInfo{}, nil, false
+	}
+
+	changed := func() bool {
+		info, err := f.mtimefs.Lstat(name)
+		if err != nil || !info.IsRegular() || info.Size() != file.Size {
+			return true
+		}
+		diff := info.ModTime().Sub(file.ModTime())
+		return diff > f.modTimeWindow || diff < -f.modTimeWindow
+	}
+	if changed() {
+		// That's for the scanner to pick up.
+		return protocol.FileInfo{}, nil, false
+	}
+
+	fd, err := f.mtimefs.Open(name)
+	if err != nil {
+		return protocol.FileInfo{}, nil, false
+	}
+	defer fd.Close()
+
+	buf := protocol.BufferPool.Get(protocol.MinBlockSize)
+	defer func() {
+		protocol.BufferPool.Put(buf)
+	}()
+
+	var corrupt []int
+	for i, block := range file.Blocks {
+		select {
+		case <-f.ctx.Done():
+			return protocol.FileInfo{}, nil, false
+		default:
+		}
+		buf = protocol.BufferPool.Upgrade(buf, block.Size)
+		if _, err := fd.ReadAt(buf, block.Offset); err != nil {
+			return protocol.FileInfo{}, nil, false
+		}
+		if verifyBuffer(buf, block) != nil {
+			corrupt = append(corrupt, i)
+		}
+	}
+
+	if len(corrupt) > 0 && changed() {
+		// Modified while we were reading it.
+		return protocol.FileInfo{}, nil, false
+	}
+	return file, corrupt, true
+}
+
+// repair pulls the given corrupted files again, if other devices have the
+// same version. The intact blocks are copied from the file itself, so only
+// the corrupted ones are actually transferred.
+func (f *sendReceiveFolder) repair(files []protocol.FileInfo) map[string]bool {
+	snap, err := f.dbSnapshot()
+	if err != nil {
+		return nil
+	}
+	defer snap.Release()
+
+	var repairable []protocol.FileInfo
+	for _, file := range files {
+		global, ok := snap.GetGlobal(file.Name)
+		if !ok || !global.Version.Equal(file.Version) || len(file.Blocks) == 0 {
+			l.Infof("Folder %v: can't repair %q, we don't have the current version", f.Description(), file.Name)
+			continue
+		}
+		if len(f.model.availabilityInSnapshot(f.FolderConfiguration, snap, file, file.Blocks[0])) == 0 {
+			l.Infof("Folder %v: can't repair %q, no connected device has it", f.Description(), file.Name)
+			continue
+		}
+		repairable = append(repairable, file)
+	}
+	if len(repairable) == 0 {
+		return nil
+	}
+
+	scanChan := make(chan string)
+	go f.pullScannerRoutine(scanChan)
+	defer close(scanChan)
+
+	copyChan := make(chan copyBlocksState)
+	pullChan := make(chan pullBlockState)
+	finisherChan := make(chan *sharedPullerState)
+	dbUpdateChan := make(chan dbUpdateJob)
+
+	updateDone := make(chan struct{})
+	go func() {
+		f.dbUpdaterRoutine(dbUpdateChan)
+		close(updateDone)
+	}()
+	copyDone := make(chan struct{})
+	go func() {
+		f.copierRoutine(copyChan, pullChan, finisherChan)
+		close(copyDone)
+	}()
+	pullDone := make(chan struct{})
+	go func() {
+		f.pullerRoutine(snap, pullChan, finisherChan)
+		close(pullDone)
+	}()
+	finishDone := make(chan struct{})
+	go func() {
+		f.finisherRoutine(snap, finisherChan, dbUpdateChan, scanChan)
+		close(finishDone)
+	}()
+
+	f.errorsMut.Lock()
+	f.tempPullErrors = make(map[string]string)
+	f.errorsMut.Unlock()
+
+	for _, file := range repairable {
+		f.handleFile(file, snap, copyChan)
+	}
+
+	close(copyChan)
+	<-copyDone
+	close(pullChan)
+	<-pullDone
+	close(finisherChan)
+	<-finishDone
+	close(dbUpdateChan)
+	<-updateDone
+
+	f.errorsMut.Lock()
+	defer f.errorsMut.Unlock()
+	repaired := make(map[string]bool, len(repairable))
+	for _, file := range repairable {
+		if err, failed := f.tempPullErrors[file.Name]; failed {
+			l.Warnf("Folder %v: failed to repair %q: %s", f.Description(), file.Name, strings.TrimSpace(err))
+			continue
+		}
+		l.Infof("Folder %v: repaired %q", f.Description(), file.Name)
+		repaired[file.Name] = true
+	}
+	return repaired
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package osutil
+
+import (
+	"os/user"
+	"strconv"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Looking up users and groups may mean reading /etc/passwd or asking a
+// directory service, for every file scanned or pulled. We cache the answers
+// for a while, including negative ones.
+const ownershipCacheTTL = time.Minute
+
+var (
+	userNames  = newLookupCache(lookupUserName)
+	groupNames = newLookupCache(lookupGroupName)
+	userIDs    = newLookupCache(lookupUserID)
+	groupIDs   = newLookupCache(lookupGroupID)
+)
+
+// OwnerName returns the name of the user with the given uid, or the empty
+// string if it can't be resolved.
+func OwnerName(uid int) string {
+	name, _ := userNames.get(strconv.Itoa(uid))
+	return name
+}
+
+// GroupName returns the name of the group with the given gid, or the empty
+// string if it can't be resolved.
+func GroupName(gid int) string {
+	name, _ := groupNames.get(strconv.Itoa(gid))
+	return name
+}
+
+// LookupOwner returns the uid of the user with the given name, if there is
+// such a user.
+func LookupOwner(name string) (int, bool) {
+	return lookupID(userIDs, name)
+}
+
+// LookupGroup returns the gid of the group with the given name, if there
+// is such a group.
+func LookupGroup(name string) (int, bool) {
+	return lookupID(groupIDs, name)
+}
+
+func lookupID(c *lookupCache, name string) (int, bool) {
+	if name == "" {
+		return 0, false
+	}
+	val, ok := c.get(name)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.Atoi(val)
+	if err != nil {
+		// Not a numeric ID, i.e. not a Unix system.
+		return 0, false
+	}
+	return id, true
+}
+
+type lookupCache struct {
+	lookup  func(string) (string, error)
+	mut     sync.Mutex
+	entries map[string]lookupCacheEntry
+}
+
+type lookupCacheEntry struct {
+	val     string
+	ok      bool
+	expires time.Time
+}
+
+func newLookupCache(lookup func(string) (string, error)) *lookupCache {
+	return &lookupCache{
+		lookup:  lookup,
+		mut:     sync.NewMutex(),
+		entries: make(map[string]lookupCacheEntry),
+	}
+}
+
+func (c *lookupCache) get(key string) (string, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	now := time.Now()
+	if e, ok := c.entries[key]; ok && now.Before(e.expires) {
+		return e.val, e.ok
+	}
+
+	val, err := c.lookup(key)
+	e := lookupCacheEntry{
+		val:     val,
+		ok:      err == nil,
+		expires: now.Add(ownershipCacheTTL),
+	}
+	c.entries[key] = e
+	return e.val, e.ok
+}
+
+func lookupUserName(uid string) (string, error) {
+	u, err := user.LookupId(uid)
+	if err != nil {
+		return "", err
+	}
+	return u.Username, nil
+}
+
+func lookupGroupName(gid string) (string, error) {
+	g, err := user.LookupGroupId(gid)
+	if err != nil {
+		return "", err
+	}
+	return g.Name, nil
+}
+
+func lookupUserID(name string) (string, error) {
+	u, err := user.Lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return u.Uid, nil
+}
+
+func lookupGroupID(name string) (string, error) {
+	g, err := user.LookupGroup(name)
+	if err != nil {
+		return "", err
+	}
+	return g.Gid, nil
+}
@@ -0,0 +1,322 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package pcp implements the client side of the Port Control Protocol (RFC
+// 6887), the successor of NAT-PMP, and registers it as a NAT provider.
+package pcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+const (
+	ServerPort = 5351
+
+	version = 2
+
+	opAnnounce = 0
+	opMap      = 1
+	opResponse = 0x80
+
+	optThirdParty = 1
+
+	headerLen     = 24
+	mapPayloadLen = 36
+	maxPacketLen  = 1100
+
+	protoTCP = 6
+	protoUDP = 17
+
+	initialRetransmit = 250 * time.Millisecond
+)
+
+var (
+	errShortResponse = errors.New("short PCP response")
+	errNoExternalIP  = errors.New("no external address mapped yet")
+)
+
+// A ResultError is a result code other than SUCCESS returned by the server.
+type ResultError uint8
+
+const (
+	UnsuppVersion         ResultError = 1
+	NotAuthorized         ResultError = 2
+	MalformedRequest      ResultError = 3
+	UnsuppOpcode          ResultError = 4
+	UnsuppOption          ResultError = 5
+	MalformedOption       ResultError = 6
+	NetworkFailure        ResultError = 7
+	NoResources           ResultError = 8
+	UnsuppProtocol        ResultError = 9
+	UserExQuota           ResultError = 10
+	CannotProvideExternal ResultError = 11
+	AddressMismatch       ResultError = 12
+	ExcessiveRemotePeers  ResultError = 13
+)
+
+var resultNames = map[ResultError]string{
+	UnsuppVersion:         "UNSUPP_VERSION",
+	NotAuthorized:         "NOT_AUTHORIZED",
+	MalformedRequest:      "MALFORMED_REQUEST",
+	UnsuppOpcode:          "UNSUPP_OPCODE",
+	UnsuppOption:          "UNSUPP_OPTION",
+	MalformedOption:       "MALFORMED_OPTION",
+	NetworkFailure:        "NETWORK_FAILURE",
+	NoResources:           "NO_RESOURCES",
+	UnsuppProtocol:        "UNSUPP_PROTOCOL",
+	UserExQuota:           "USER_EX_QUOTA",
+	CannotProvideExternal: "CANNOT_PROVIDE_EXTERNAL",
+	AddressMismatch:       "ADDRESS_MISMATCH",
+	ExcessiveRemotePeers:  "EXCESSIVE_REMOTE_PEERS",
+}
+
+func (e ResultError) Error() string {
+	if name, ok := resultNames[e]; ok {
+		return "PCP error " + name
+	}
+	return fmt.Sprintf("PCP error %d", uint8(e))
+}
+
+// A MapRequest asks for an inbound mapping. Leaving InternalIP nil maps to
+// ourselves; any other address requests a third party mapping on behalf of
+// that host. The external port and address are suggestions the server may
+// ignore. Mapping an IPv6 address on an IPv6 router opens a pinhole in its
+// firewall, in which case the external address is the internal one.
+type MapRequest struct {
+	Protocol     nat.Protocol
+	InternalIP   net.IP
+	InternalPort int
+	ExternalPort int
+	ExternalIP   net.IP
+	Lifetime     time.Duration
+}
+
+// A MapResult is the mapping the server assigned.
+type MapResult struct {
+	ExternalIP   net.IP
+	ExternalPort int
+	Lifetime     time.Duration
+	// The address we sent the request from.
+	LocalIP net.IP
+}
+
+type mappingKey struct {
+	protocol     nat.Protocol
+	internalIP   string
+	internalPort int
+}
+
+// A Client talks to a single PCP server.
+type Client struct {
+	server  *net.UDPAddr
+	timeout time.Duration
+
+	// A mapping is renewed or deleted by repeating the request with the
+	// nonce it was created with.
+	nonces map[mappingKey][12]byte
+	mut    sync.Mutex
+}
+
+func NewClient(server *net.UDPAddr, timeout time.Duration) *Client {
+	return &Client{
+		server:  server,
+		timeout: timeout,
+		nonces:  make(map[mappingKey][12]byte),
+		mut:     sync.NewMutex(),
+	}
+}
+
+// Announce checks that the server speaks PCP, returning the address we
+// reach it from.
+func (c *Client) Announce(ctx context.Context) (net.IP, error) {
+	var localIP net.IP
+	_, err := c.roundTrip(ctx, func(local net.IP) []byte {
+		localIP = local
+		return requestHeader(opAnnounce, 0, local)
+	}, opAnnounce)
+	return localIP, err
+}
+
+// Map creates or renews the requested mapping. A zero lifetime deletes it.
+func (c *Client) Map(ctx context.Context, req MapRequest) (MapResult, error) {
+	var proto byte
+	switch req.Protocol {
+	case nat.TCP:
+		proto = protoTCP
+	case nat.UDP:
+		proto = protoUDP
+	default:
+		return MapResult{}, fmt.Errorf("unsupported protocol %q", req.Protocol)
+	}
+
+	key := mappingKey{req.Protocol, req.InternalIP.String(), req.InternalPort}
+	c.mut.Lock()
+	nonce, ok := c.nonces[key]
+	if !ok {
+		if _, err := rand.Read(nonce[:]); err != nil {
+			c.mut.Unlock()
+			return MapResult{}, err
+		}
+		c.nonces[key] = nonce
+	}
+	c.mut.Unlock()
+
+	var localIP net.IP
+	resp, err := c.roundTrip(ctx, func(local net.IP) []byte {
+		localIP = local
+		pkt := requestHeader(opMap, req.Lifetime, local)
+		pkt = append(pkt, nonce[:]...)
+		pkt = append(pkt, proto, 0, 0, 0)
+		pkt = appendUint16(pkt, uint16(req.InternalPort))
+		pkt = appendUint16(pkt, uint16(req.ExternalPort))
+		pkt = append(pkt, ipBytes(suggestedExternalIP(req.ExternalIP, local))...)
+		if req.InternalIP != nil {
+			pkt = append(pkt, optThirdParty, 0, 0, net.IPv6len)
+			pkt = append(pkt, ipBytes(req.InternalIP)...)
+		}
+		return pkt
+	}, opMap)
+	if err != nil {
+		return MapResult{}, err
+	}
+
+	if len(resp) < headerLen+mapPayloadLen {
+		return MapResult{}, errShortResponse
+	}
+	payload := resp[headerLen:]
+	if string(payload[:12]) != string(nonce[:]) {
+		return MapResult{}, errors.New("PCP response nonce mismatch")
+	}
+	if req.Lifetime == 0 {
+		c.mut.Lock()
+		delete(c.nonces, key)
+		c.mut.Unlock()
+	}
+	return MapResult{
+		ExternalIP:   parseIP(payload[20:36]),
+		ExternalPort: int(binary.BigEndian.Uint16(payload[18:20])),
+		Lifetime:     time.Duration(binary.BigEndian.Uint32(resp[4:8])) * time.Second,
+		LocalIP:      localIP,
+	}, nil
+}
+
+// roundTrip sends the request built for our local address, retransmitting
+// with exponential backoff until a response for the opcode arrives or the
+// timeout expires. A response with an error result code is returned as a
+// ResultError.
+func (c *Client) roundTrip(ctx context.Context, build func(local net.IP) []byte, op byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", c.server.String())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	go func() {
+		<-ctx.Done()
+		conn.SetReadDeadline(time.Now())
+	}()
+
+	req := build(conn.LocalAddr().(*net.UDPAddr).IP)
+
+	buf := make([]byte, maxPacketLen)
+	retransmit := initialRetransmit
+	for {
+		if _, err := conn.Write(req); err != nil {
+			return nil, err
+		}
+		resend := time.Now().Add(retransmit)
+		retransmit *= 2
+
+		for {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			conn.SetReadDeadline(resend)
+			n, err := conn.Read(buf)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if err != nil {
+				var nerr net.Error
+				if errors.As(err, &nerr) && nerr.Timeout() {
+					break
+				}
+				return nil, err
+			}
+			if n < 4 || buf[1] != opResponse|op {
+				// Not a response to our request.
+				continue
+			}
+			if n < headerLen {
+				// A NAT-PMP server answers with its own, shorter,
+				// unsupported version error.
+				if buf[0] != version && buf[3] != 0 {
+					return nil, UnsuppVersion
+				}
+				return nil, errShortResponse
+			}
+			if code := buf[3]; code != 0 {
+				return nil, ResultError(code)
+			}
+			return append([]byte(nil), buf[:n]...), nil
+		}
+	}
+}
+
+func requestHeader(op byte, lifetime time.Duration, local net.IP) []byte {
+	pkt := make([]byte, 0, maxPacketLen)
+	pkt = append(pkt, version, op, 0, 0)
+	pkt = appendUint32(pkt, uint32(lifetime/time.Second))
+	return append(pkt, ipBytes(local)...)
+}
+
+func appendUint16(bs []byte, v uint16) []byte {
+	return append(bs, byte(v>>8), byte(v))
+}
+
+func appendUint32(bs []byte, v uint32) []byte {
+	return append(bs, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// ipBytes returns the 16 byte form PCP uses for both address families, with
+// IPv4 addresses mapped into IPv6. A nil address is the unspecified IPv4
+// one.
+func ipBytes(ip net.IP) []byte {
+	if ip == nil {
+		return net.IPv4zero.To16()
+	}
+	return ip.To16()
+}
+
+// suggestedExternalIP returns the external address to suggest in a mapping
+// request. Without a preference that is the unspecified address of the
+// family we talk to the server in, as an IPv6 pinhole can't have an IPv4
+// address.
+func suggestedExternalIP(ip, local net.IP) net.IP {
+	if ip == nil && local.To4() == nil {
+		return net.IPv6unspecified
+	}
+	return ip
+}
+
+func parseIP(bs []byte) net.IP {
+	ip := net.IP(append([]byte(nil), bs...))
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip
+}
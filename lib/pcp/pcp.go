@@ -0,0 +1,146 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package pcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/jackpal/gateway"
+
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/sync"
+	"github.com/syncthing/syncthing/lib/util"
+)
+
+// The well known anycast address of PCP servers (RFC 7723). IPv6 routers
+// listen on it to hand out firewall pinholes, having no NAT gateway address
+// we could otherwise find.
+var anycastIPv6 = net.ParseIP("2001:1::1")
+
+func init() {
+	nat.Register(Discover)
+}
+
+func Discover(ctx context.Context, renewal, timeout time.Duration) []nat.Device {
+	var servers []net.IP
+	err := util.CallWithContext(ctx, func() error {
+		ip, err := gateway.DiscoverGateway()
+		if err == nil && ip != nil && !ip.IsUnspecified() {
+			servers = append(servers, ip)
+		}
+		return err
+	})
+	if err != nil {
+		l.Debugln("Failed to discover gateway", err)
+	}
+	servers = append(servers, anycastIPv6)
+
+	wg := sync.NewWaitGroup()
+	devices := make([]nat.Device, len(servers))
+	for i, ip := range servers {
+		wg.Add(1)
+		go func(i int, ip net.IP) {
+			defer wg.Done()
+			if dev := discoverServer(ctx, &net.UDPAddr{IP: ip, Port: ServerPort}, renewal, timeout); dev != nil {
+				devices[i] = dev
+			}
+		}(i, ip)
+	}
+	wg.Wait()
+
+	var found []nat.Device
+	for _, dev := range devices {
+		if dev != nil {
+			found = append(found, dev)
+		}
+	}
+	return found
+}
+
+// discoverServer returns a device for the PCP server at the address, or
+// nil if it doesn't answer.
+func discoverServer(ctx context.Context, server *net.UDPAddr, renewal, timeout time.Duration) nat.Device {
+	c := NewClient(server, timeout)
+	localIP, err := c.Announce(ctx)
+	if err != nil {
+		var rerr ResultError
+		if errors.As(err, &rerr) && rerr == UnsuppVersion {
+			l.Debugln("Gateway", server, "speaks only NAT-PMP")
+		} else {
+			l.Debugln("No PCP server at", server, err)
+		}
+		return nil
+	}
+
+	l.Debugln("Discovered PCP server at", server)
+	return &wrapper{
+		renewal: renewal,
+		localIP: localIP,
+		server:  server,
+		client:  c,
+		mut:     sync.NewMutex(),
+	}
+}
+
+type wrapper struct {
+	renewal time.Duration
+	localIP net.IP
+	server  *net.UDPAddr
+	client  *Client
+
+	// PCP has no way to ask for the external address other than mapping a
+	// port, so we remember what the last mapping told us.
+	externalIP net.IP
+	mut        sync.Mutex
+}
+
+func (w *wrapper) ID() string {
+	return fmt.Sprintf("PCP@%s", w.server.IP.String())
+}
+
+func (w *wrapper) GetLocalIPAddress() net.IP {
+	return w.localIP
+}
+
+func (w *wrapper) AddPortMapping(ctx context.Context, protocol nat.Protocol, internalPort, externalPort int, description string, duration time.Duration) (int, error) {
+	// As with NAT-PMP a zero lifetime deletes the mapping, so lease for the
+	// time between renewals instead.
+	if duration == 0 {
+		duration = w.renewal
+	}
+	req := MapRequest{
+		Protocol:     protocol,
+		InternalPort: internalPort,
+		ExternalPort: externalPort,
+		Lifetime:     duration,
+	}
+	if w.localIP.To4() == nil {
+		// A pinhole keeps our address, so ask for the same port as well.
+		req.ExternalPort = internalPort
+	}
+	res, err := w.client.Map(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	w.mut.Lock()
+	w.externalIP = res.ExternalIP
+	w.mut.Unlock()
+	return res.ExternalPort, nil
+}
+
+func (w *wrapper) GetExternalIPAddress(_ context.Context) (net.IP, error) {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	if w.externalIP == nil {
+		return nil, errNoExternalIP
+	}
+	return w.externalIP, nil
+}
@@ -0,0 +1,221 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package pcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/nat"
+)
+
+// fakeServer answers ANNOUNCE and MAP requests the way a PCP server on a
+// NAT gateway with the given external address would, with the given result
+// code, remembering the requests it got.
+type fakeServer struct {
+	conn       net.PacketConn
+	externalIP net.IP
+	result     byte
+	requests   chan []byte
+}
+
+func newFakeServer(t *testing.T, externalIP net.IP, result ResultError) *fakeServer {
+	return newFakeServerAt(t, "127.0.0.1:0", externalIP, result)
+}
+
+func newFakeServerAt(t *testing.T, addr string, externalIP net.IP, result ResultError) *fakeServer {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{
+		conn:       conn,
+		externalIP: externalIP,
+		result:     byte(result),
+		requests:   make(chan []byte, 16),
+	}
+	go s.serve()
+	t.Cleanup(func() { conn.Close() })
+	return s
+}
+
+func (s *fakeServer) addr() *net.UDPAddr {
+	return s.conn.LocalAddr().(*net.UDPAddr)
+}
+
+func (s *fakeServer) serve() {
+	buf := make([]byte, maxPacketLen)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		req := append([]byte(nil), buf[:n]...)
+		s.requests <- req
+
+		resp := make([]byte, headerLen)
+		resp[0] = version
+		resp[1] = opResponse | req[1]
+		resp[3] = s.result
+		copy(resp[4:8], req[4:8])
+		binary.BigEndian.PutUint32(resp[8:12], 1234)
+		if req[1] == opMap {
+			payload := append([]byte(nil), req[headerLen:headerLen+mapPayloadLen]...)
+			// Assign the suggested port, or the internal one.
+			if binary.BigEndian.Uint16(payload[18:20]) == 0 {
+				copy(payload[18:20], payload[16:18])
+			}
+			copy(payload[20:36], s.externalIP.To16())
+			resp = append(resp, payload...)
+		}
+		_, _ = s.conn.WriteTo(resp, addr)
+	}
+}
+
+func TestDiscoverAndMap(t *testing.T) {
+	srv := newFakeServer(t, net.ParseIP("192.0.2.1"), 0)
+	ctx := context.Background()
+
+	dev := discoverServer(ctx, srv.addr(), time.Minute, time.Second)
+	if dev == nil {
+		t.Fatal("server not discovered")
+	}
+	<-srv.requests
+
+	port, err := dev.AddPortMapping(ctx, nat.TCP, 22000, 34567, "syncthing", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port != 34567 {
+		t.Errorf("mapped port %d, expected 34567", port)
+	}
+	req := <-srv.requests
+	if lifetime := binary.BigEndian.Uint32(req[4:8]); lifetime != 60 {
+		t.Errorf("requested lifetime %d, expected the renewal interval", lifetime)
+	}
+	if !net.IP(req[8:24]).Equal(net.ParseIP("127.0.0.1")) {
+		t.Errorf("unexpected client address %v", net.IP(req[8:24]))
+	}
+	if req[headerLen+12] != protoTCP {
+		t.Errorf("unexpected protocol %d", req[headerLen+12])
+	}
+
+	ip, err := dev.GetExternalIPAddress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ip.Equal(net.ParseIP("192.0.2.1")) {
+		t.Errorf("external address %v, expected 192.0.2.1", ip)
+	}
+
+	if suggested := net.IP(req[headerLen+20 : headerLen+36]); !suggested.Equal(net.IPv4zero) {
+		t.Errorf("suggested external address %v, expected 0.0.0.0", suggested)
+	}
+
+	// Renewing reuses the nonce of the mapping.
+	if _, err := dev.AddPortMapping(ctx, nat.TCP, 22000, 34567, "syncthing", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	renew := <-srv.requests
+	if !bytes.Equal(req[headerLen:headerLen+12], renew[headerLen:headerLen+12]) {
+		t.Error("renewal should reuse the nonce")
+	}
+}
+
+func TestPinhole(t *testing.T) {
+	if conn, err := net.ListenPacket("udp", "[::1]:0"); err != nil {
+		t.Skip("IPv6 not available:", err)
+	} else {
+		conn.Close()
+	}
+	srv := newFakeServerAt(t, "[::1]:0", net.ParseIP("2001:db8::42"), 0)
+	ctx := context.Background()
+
+	dev := discoverServer(ctx, srv.addr(), time.Minute, time.Second)
+	if dev == nil {
+		t.Fatal("server not discovered")
+	}
+	<-srv.requests
+
+	port, err := dev.AddPortMapping(ctx, nat.TCP, 22000, 34567, "syncthing", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port != 22000 {
+		t.Errorf("mapped port %d, expected the internal port for a pinhole", port)
+	}
+	req := <-srv.requests
+	if !net.IP(req[8:24]).Equal(net.IPv6loopback) {
+		t.Errorf("unexpected client address %v", net.IP(req[8:24]))
+	}
+	if suggested := net.IP(req[headerLen+20 : headerLen+36]); !bytes.Equal(suggested, net.IPv6unspecified) {
+		t.Errorf("suggested external address %v, expected ::", suggested)
+	}
+
+	ip, err := dev.GetExternalIPAddress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ip.Equal(net.ParseIP("2001:db8::42")) {
+		t.Errorf("external address %v, expected 2001:db8::42", ip)
+	}
+}
+
+func TestThirdPartyMapping(t *testing.T) {
+	srv := newFakeServer(t, net.ParseIP("192.0.2.1"), 0)
+	c := NewClient(srv.addr(), time.Second)
+
+	res, err := c.Map(context.Background(), MapRequest{
+		Protocol:     nat.UDP,
+		InternalIP:   net.ParseIP("10.0.0.42"),
+		InternalPort: 22000,
+		Lifetime:     time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ExternalPort != 22000 || !res.ExternalIP.Equal(net.ParseIP("192.0.2.1")) {
+		t.Errorf("unexpected mapping %+v", res)
+	}
+
+	req := <-srv.requests
+	opt := req[headerLen+mapPayloadLen:]
+	if len(opt) != 4+net.IPv6len || opt[0] != optThirdParty || binary.BigEndian.Uint16(opt[2:4]) != net.IPv6len {
+		t.Fatalf("unexpected options %x", opt)
+	}
+	if !net.IP(opt[4:]).Equal(net.ParseIP("10.0.0.42")) {
+		t.Errorf("third party address %v, expected 10.0.0.42", net.IP(opt[4:]))
+	}
+}
+
+func TestResultError(t *testing.T) {
+	srv := newFakeServer(t, net.ParseIP("192.0.2.1"), NotAuthorized)
+	c := NewClient(srv.addr(), time.Second)
+
+	_, err := c.Map(context.Background(), MapRequest{Protocol: nat.TCP, InternalPort: 22000, Lifetime: time.Hour})
+	if err != NotAuthorized {
+		t.Errorf("got error %v, expected %v", err, NotAuthorized)
+	}
+}
+
+func TestNoServer(t *testing.T) {
+	// Nothing answers on a port we just released.
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := conn.LocalAddr().(*net.UDPAddr)
+	conn.Close()
+
+	if dev := discoverServer(context.Background(), addr, time.Minute, 500*time.Millisecond); dev != nil {
+		t.Error("should not discover a server that doesn't answer")
+	}
+}
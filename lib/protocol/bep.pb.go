@@ -72,16 +72,19 @@ type MessageCompression int32
 const (
 	MessageCompressionNone MessageCompression = 0
 	MessageCompressionLZ4  MessageCompression = 1
+	MessageCompressionZstd MessageCompression = 2
 )
 
 var MessageCompression_name = map[int32]string{
 	0: "MESSAGE_COMPRESSION_NONE",
 	1: "MESSAGE_COMPRESSION_LZ4",
+	2: "MESSAGE_COMPRESSION_ZSTD",
 }
 
 var MessageCompression_value = map[string]int32{
 	"MESSAGE_COMPRESSION_NONE": 0,
 	"MESSAGE_COMPRESSION_LZ4":  1,
+	"MESSAGE_COMPRESSION_ZSTD": 2,
 }
 
 func (x MessageCompression) String() string {
@@ -120,6 +123,31 @@ func (Compression) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_311ef540e10d9705, []int{2}
 }
 
+type CompressionAlgorithm int32
+
+const (
+	CompressionAlgorithmLZ4  CompressionAlgorithm = 0
+	CompressionAlgorithmZstd CompressionAlgorithm = 1
+)
+
+var CompressionAlgorithm_name = map[int32]string{
+	0: "COMPRESSION_ALGORITHM_LZ4",
+	1: "COMPRESSION_ALGORITHM_ZSTD",
+}
+
+var CompressionAlgorithm_value = map[string]int32{
+	"COMPRESSION_ALGORITHM_LZ4":  0,
+	"COMPRESSION_ALGORITHM_ZSTD": 1,
+}
+
+func (x CompressionAlgorithm) String() string {
+	return proto.EnumName(CompressionAlgorithm_name, int32(x))
+}
+
+func (CompressionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{3}
+}
+
 type FileInfoType int32
 
 const (
@@ -151,7 +179,7 @@ func (x FileInfoType) String() string {
 }
 
 func (FileInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{3}
+	return fileDescriptor_311ef540e10d9705, []int{4}
 }
 
 type ErrorCode int32
@@ -182,7 +210,7 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{4}
+	return fileDescriptor_311ef540e10d9705, []int{5}
 }
 
 type FileDownloadProgressUpdateType int32
@@ -207,13 +235,16 @@ func (x FileDownloadProgressUpdateType) String() string {
 }
 
 func (FileDownloadProgressUpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{5}
+	return fileDescriptor_311ef540e10d9705, []int{6}
 }
 
 type Hello struct {
-	DeviceName    string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"deviceName" xml:"deviceName"`
-	ClientName    string `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"clientName" xml:"clientName"`
-	ClientVersion string `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"clientVersion" xml:"clientVersion"`
+	DeviceName     string               `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"deviceName" xml:"deviceName"`
+	ClientName     string               `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"clientName" xml:"clientName"`
+	ClientVersion  string               `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"clientVersion" xml:"clientVersion"`
+	Compressions   []MessageCompression `protobuf:"varint,4,rep,packed,name=compressions,proto3,enum=protocol.MessageCompression" json:"compressions" xml:"compression"`
+	NumConnections int                  `protobuf:"varint,5,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections"`
+	Secondary      bool                 `protobuf:"varint,6,opt,name=secondary,proto3" json:"secondary" xml:"secondary"`
 }
 
 func (m *Hello) Reset()         { *m = Hello{} }
@@ -325,14 +356,16 @@ func (m *ClusterConfig) XXX_DiscardUnknown() {
 var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 
 type Folder struct {
-	ID                 string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id"`
-	Label              string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label"`
-	ReadOnly           bool     `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"readOnly" xml:"readOnly"`
-	IgnorePermissions  bool     `protobuf:"varint,4,opt,name=ignore_permissions,json=ignorePermissions,proto3" json:"ignorePermissions" xml:"ignorePermissions"`
-	IgnoreDelete       bool     `protobuf:"varint,5,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
-	DisableTempIndexes bool     `protobuf:"varint,6,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
-	Paused             bool     `protobuf:"varint,7,opt,name=paused,proto3" json:"paused" xml:"paused"`
-	Devices            []Device `protobuf:"bytes,16,rep,name=devices,proto3" json:"devices" xml:"device"`
+	ID                     string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id"`
+	Label                  string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label" xml:"label"`
+	ReadOnly               bool     `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"readOnly" xml:"readOnly"`
+	IgnorePermissions      bool     `protobuf:"varint,4,opt,name=ignore_permissions,json=ignorePermissions,proto3" json:"ignorePermissions" xml:"ignorePermissions"`
+	IgnoreDelete           bool     `protobuf:"varint,5,opt,name=ignore_delete,json=ignoreDelete,proto3" json:"ignoreDelete" xml:"ignoreDelete"`
+	DisableTempIndexes     bool     `protobuf:"varint,6,opt,name=disable_temp_indexes,json=disableTempIndexes,proto3" json:"disableTempIndexes" xml:"disableTempIndexes"`
+	Paused                 bool     `protobuf:"varint,7,opt,name=paused,proto3" json:"paused" xml:"paused"`
+	SelectedPaths          []string `protobuf:"bytes,8,rep,name=selected_paths,json=selectedPaths,proto3" json:"selectedPaths" xml:"selectedPath"`
+	ContentDefinedChunking bool     `protobuf:"varint,9,opt,name=content_defined_chunking,json=contentDefinedChunking,proto3" json:"contentDefinedChunking" xml:"contentDefinedChunking"`
+	Devices                []Device `protobuf:"bytes,16,rep,name=devices,proto3" json:"devices" xml:"device"`
 }
 
 func (m *Folder) Reset()         { *m = Folder{} }
@@ -379,6 +412,9 @@ type Device struct {
 	IndexID                  IndexID     `protobuf:"varint,8,opt,name=index_id,json=indexId,proto3,customtype=IndexID" json:"indexId" xml:"indexId"`
 	SkipIntroductionRemovals bool        `protobuf:"varint,9,opt,name=skip_introduction_removals,json=skipIntroductionRemovals,proto3" json:"skipIntroductionRemovals" xml:"skipIntroductionRemovals"`
 	EncryptionPasswordToken  []byte      `protobuf:"bytes,10,opt,name=encryption_password_token,json=encryptionPasswordToken,proto3" json:"encryptionPasswordToken" xml:"encryptionPasswordToken"`
+	// Addresses the sender currently has an outgoing connection to the
+	// device at, shared so that mutual peers can find each other.
+	ObservedAddresses []string `protobuf:"bytes,11,rep,name=observed_addresses,json=observedAddresses,proto3" json:"observedAddresses" xml:"observedAddress"`
 }
 
 func (m *Device) Reset()         { *m = Device{} }
@@ -501,6 +537,7 @@ type FileInfo struct {
 	SymlinkTarget string       `protobuf:"bytes,17,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlinkTarget" xml:"symlinkTarget"`
 	BlocksHash    []byte       `protobuf:"bytes,18,opt,name=blocks_hash,json=blocksHash,proto3" json:"blocksHash" xml:"blocksHash"`
 	Encrypted     []byte       `protobuf:"bytes,19,opt,name=encrypted,proto3" json:"encrypted" xml:"encrypted"`
+	Platform      PlatformData `protobuf:"bytes,14,opt,name=platform,proto3" json:"platform" xml:"platform"`
 	Type          FileInfoType `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.FileInfoType" json:"type" xml:"type"`
 	Permissions   uint32       `protobuf:"varint,4,opt,name=permissions,proto3" json:"permissions" xml:"permissions"`
 	ModifiedNs    int          `protobuf:"varint,11,opt,name=modified_ns,json=modifiedNs,proto3,casttype=int" json:"modifiedNs" xml:"modifiedNs"`
@@ -550,6 +587,167 @@ func (m *FileInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FileInfo proto.InternalMessageInfo
 
+// PlatformData holds information that is only relevant on certain
+// platforms, such as extended attributes.
+type PlatformData struct {
+	Unix    *UnixData  `protobuf:"bytes,1,opt,name=unix,proto3" json:"unix" xml:"unix"`
+	Linux   *XattrData `protobuf:"bytes,3,opt,name=linux,proto3" json:"linux" xml:"linux"`
+	Darwin  *XattrData `protobuf:"bytes,4,opt,name=darwin,proto3" json:"darwin" xml:"darwin"`
+	FreeBSD *XattrData `protobuf:"bytes,5,opt,name=freebsd,proto3" json:"freebsd" xml:"freebsd"`
+	NetBSD  *XattrData `protobuf:"bytes,6,opt,name=netbsd,proto3" json:"netbsd" xml:"netbsd"`
+}
+
+func (m *PlatformData) Reset()         { *m = PlatformData{} }
+func (m *PlatformData) String() string { return proto.CompactTextString(m) }
+func (*PlatformData) ProtoMessage()    {}
+func (*PlatformData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{8}
+}
+func (m *PlatformData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlatformData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlatformData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlatformData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlatformData.Merge(m, src)
+}
+func (m *PlatformData) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PlatformData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlatformData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlatformData proto.InternalMessageInfo
+
+type UnixData struct {
+	// The owner name and group name are set when known (i.e., could be
+	// resolved on the source device), while the UID and GID are always set
+	// as they come directly from the stat() call.
+	OwnerName string `protobuf:"bytes,1,opt,name=owner_name,json=ownerName,proto3" json:"ownerName" xml:"ownerName"`
+	GroupName string `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"groupName" xml:"groupName"`
+	UID       int    `protobuf:"varint,3,opt,name=uid,proto3,casttype=int" json:"uid" xml:"uid"`
+	GID       int    `protobuf:"varint,4,opt,name=gid,proto3,casttype=int" json:"gid" xml:"gid"`
+}
+
+func (m *UnixData) Reset()         { *m = UnixData{} }
+func (m *UnixData) String() string { return proto.CompactTextString(m) }
+func (*UnixData) ProtoMessage()    {}
+func (*UnixData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{9}
+}
+func (m *UnixData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnixData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnixData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnixData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnixData.Merge(m, src)
+}
+func (m *UnixData) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *UnixData) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnixData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnixData proto.InternalMessageInfo
+
+type XattrData struct {
+	Xattrs []Xattr `protobuf:"bytes,1,rep,name=xattrs,proto3" json:"xattrs" xml:"xattr"`
+}
+
+func (m *XattrData) Reset()         { *m = XattrData{} }
+func (m *XattrData) String() string { return proto.CompactTextString(m) }
+func (*XattrData) ProtoMessage()    {}
+func (*XattrData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{10}
+}
+func (m *XattrData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *XattrData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_XattrData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *XattrData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XattrData.Merge(m, src)
+}
+func (m *XattrData) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *XattrData) XXX_DiscardUnknown() {
+	xxx_messageInfo_XattrData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XattrData proto.InternalMessageInfo
+
+type Xattr struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value" xml:"value"`
+}
+
+func (m *Xattr) Reset()         { *m = Xattr{} }
+func (m *Xattr) String() string { return proto.CompactTextString(m) }
+func (*Xattr) ProtoMessage()    {}
+func (*Xattr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{11}
+}
+func (m *Xattr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Xattr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Xattr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Xattr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Xattr.Merge(m, src)
+}
+func (m *Xattr) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Xattr) XXX_DiscardUnknown() {
+	xxx_messageInfo_Xattr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Xattr proto.InternalMessageInfo
+
 type BlockInfo struct {
 	Hash     []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash" xml:"hash"`
 	Offset   int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset" xml:"offset"`
@@ -560,7 +758,7 @@ type BlockInfo struct {
 func (m *BlockInfo) Reset()      { *m = BlockInfo{} }
 func (*BlockInfo) ProtoMessage() {}
 func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{8}
+	return fileDescriptor_311ef540e10d9705, []int{12}
 }
 func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,7 +795,7 @@ func (m *Vector) Reset()         { *m = Vector{} }
 func (m *Vector) String() string { return proto.CompactTextString(m) }
 func (*Vector) ProtoMessage()    {}
 func (*Vector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{9}
+	return fileDescriptor_311ef540e10d9705, []int{13}
 }
 func (m *Vector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +833,7 @@ func (m *Counter) Reset()         { *m = Counter{} }
 func (m *Counter) String() string { return proto.CompactTextString(m) }
 func (*Counter) ProtoMessage()    {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{10}
+	return fileDescriptor_311ef540e10d9705, []int{14}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,7 +878,7 @@ func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{11}
+	return fileDescriptor_311ef540e10d9705, []int{15}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +917,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{12}
+	return fileDescriptor_311ef540e10d9705, []int{16}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +955,7 @@ func (m *DownloadProgress) Reset()         { *m = DownloadProgress{} }
 func (m *DownloadProgress) String() string { return proto.CompactTextString(m) }
 func (*DownloadProgress) ProtoMessage()    {}
 func (*DownloadProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{13}
+	return fileDescriptor_311ef540e10d9705, []int{17}
 }
 func (m *DownloadProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +996,7 @@ func (m *FileDownloadProgressUpdate) Reset()         { *m = FileDownloadProgress
 func (m *FileDownloadProgressUpdate) String() string { return proto.CompactTextString(m) }
 func (*FileDownloadProgressUpdate) ProtoMessage()    {}
 func (*FileDownloadProgressUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{14}
+	return fileDescriptor_311ef540e10d9705, []int{18}
 }
 func (m *FileDownloadProgressUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +1032,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{15}
+	return fileDescriptor_311ef540e10d9705, []int{19}
 }
 func (m *Ping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,7 +1069,7 @@ func (m *Close) Reset()         { *m = Close{} }
 func (m *Close) String() string { return proto.CompactTextString(m) }
 func (*Close) ProtoMessage()    {}
 func (*Close) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{16}
+	return fileDescriptor_311ef540e10d9705, []int{20}
 }
 func (m *Close) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,6 +1102,7 @@ func init() {
 	proto.RegisterEnum("protocol.MessageType", MessageType_name, MessageType_value)
 	proto.RegisterEnum("protocol.MessageCompression", MessageCompression_name, MessageCompression_value)
 	proto.RegisterEnum("protocol.Compression", Compression_name, Compression_value)
+	proto.RegisterEnum("protocol.CompressionAlgorithm", CompressionAlgorithm_name, CompressionAlgorithm_value)
 	proto.RegisterEnum("protocol.FileInfoType", FileInfoType_name, FileInfoType_value)
 	proto.RegisterEnum("protocol.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("protocol.FileDownloadProgressUpdateType", FileDownloadProgressUpdateType_name, FileDownloadProgressUpdateType_value)
@@ -915,6 +1114,10 @@ func init() {
 	proto.RegisterType((*Index)(nil), "protocol.Index")
 	proto.RegisterType((*IndexUpdate)(nil), "protocol.IndexUpdate")
 	proto.RegisterType((*FileInfo)(nil), "protocol.FileInfo")
+	proto.RegisterType((*PlatformData)(nil), "protocol.PlatformData")
+	proto.RegisterType((*UnixData)(nil), "protocol.UnixData")
+	proto.RegisterType((*XattrData)(nil), "protocol.XattrData")
+	proto.RegisterType((*Xattr)(nil), "protocol.Xattr")
 	proto.RegisterType((*BlockInfo)(nil), "protocol.BlockInfo")
 	proto.RegisterType((*Vector)(nil), "protocol.Vector")
 	proto.RegisterType((*Counter)(nil), "protocol.Counter")
@@ -929,175 +1132,213 @@ func init() {
 func init() { proto.RegisterFile("lib/protocol/bep.proto", fileDescriptor_311ef540e10d9705) }
 
 var fileDescriptor_311ef540e10d9705 = []byte{
-	// 2681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x92, 0x94, 0x44, 0x8d, 0x24, 0x87, 0x1a, 0x7f, 0x6d, 0x68, 0x9b, 0xcb, 0xff, 0xc4,
-	0xf9, 0x57, 0x51, 0x1a, 0x39, 0x51, 0x92, 0x36, 0x4d, 0x52, 0x07, 0xfc, 0x92, 0xc4, 0x44, 0x22,
-	0xd9, 0x21, 0xed, 0xd4, 0x46, 0x0b, 0x62, 0xc5, 0x1d, 0x51, 0x0b, 0x2f, 0x77, 0xd9, 0x5d, 0x4a,
-	0xb6, 0x82, 0x5e, 0xda, 0x5e, 0x02, 0x1e, 0x8a, 0x22, 0xa7, 0xa2, 0x28, 0xd1, 0xa0, 0x97, 0xde,
-	0x0a, 0xf4, 0xd0, 0x4b, 0x4e, 0x3d, 0xe6, 0x68, 0x04, 0x28, 0x50, 0xf4, 0xb0, 0x80, 0xed, 0x4b,
-	0xcb, 0x23, 0x8f, 0x3d, 0x15, 0xf3, 0xb1, 0xbb, 0xb3, 0xa2, 0x14, 0xc8, 0xc9, 0xa1, 0xb7, 0x7d,
-	0xbf, 0xf7, 0x7b, 0x6f, 0x86, 0x6f, 0xde, 0x7b, 0xf3, 0x76, 0x09, 0xae, 0x58, 0xe6, 0xde, 0xad,
-	0xbe, 0xeb, 0x0c, 0x9c, 0x8e, 0x63, 0xdd, 0xda, 0x23, 0xfd, 0x75, 0x26, 0xc0, 0x74, 0x80, 0x65,
-	0x17, 0xc8, 0xa3, 0x01, 0x07, 0xb3, 0x2f, 0xb9, 0xa4, 0xef, 0x78, 0x9c, 0xbe, 0x77, 0xb8, 0x7f,
-	0xab, 0xeb, 0x74, 0x1d, 0x26, 0xb0, 0x27, 0x4e, 0x42, 0x4f, 0x15, 0x30, 0xbb, 0x4d, 0x2c, 0xcb,
-	0x81, 0x25, 0xb0, 0x68, 0x90, 0x23, 0xb3, 0x43, 0xda, 0xb6, 0xde, 0x23, 0xaa, 0x92, 0x57, 0x56,
-	0x17, 0x8a, 0x68, 0xec, 0x6b, 0x80, 0xc3, 0x35, 0xbd, 0x47, 0x26, 0xbe, 0x96, 0x79, 0xd4, 0xb3,
-	0xde, 0x45, 0x11, 0x84, 0xb0, 0xa4, 0xa7, 0x4e, 0x3a, 0x96, 0x49, 0xec, 0x01, 0x77, 0x92, 0x88,
-	0x9c, 0x70, 0x38, 0xe6, 0x24, 0x82, 0x10, 0x96, 0xf4, 0xb0, 0x0e, 0x2e, 0x08, 0x27, 0x47, 0xc4,
-	0xf5, 0x4c, 0xc7, 0x56, 0x93, 0xcc, 0xcf, 0xea, 0xd8, 0xd7, 0x96, 0xb9, 0xe6, 0x2e, 0x57, 0x4c,
-	0x7c, 0xed, 0xa2, 0xe4, 0x4a, 0xa0, 0x08, 0xc7, 0x59, 0xe8, 0x2f, 0x0a, 0x98, 0xdb, 0x26, 0xba,
-	0x41, 0x5c, 0x58, 0x00, 0xa9, 0xc1, 0x71, 0x9f, 0xff, 0xbc, 0x0b, 0x1b, 0x97, 0xd7, 0x83, 0xc0,
-	0xad, 0xef, 0x12, 0xcf, 0xd3, 0xbb, 0xa4, 0x75, 0xdc, 0x27, 0xc5, 0x2b, 0x63, 0x5f, 0x63, 0xb4,
-	0x89, 0xaf, 0x01, 0xe6, 0x9f, 0x0a, 0x08, 0x33, 0x0c, 0x1a, 0x60, 0xb1, 0xe3, 0xf4, 0xfa, 0x2e,
-	0xf1, 0xd8, 0xde, 0x12, 0xcc, 0xd3, 0xf5, 0x29, 0x4f, 0xa5, 0x88, 0x53, 0xbc, 0x39, 0xf6, 0x35,
-	0xd9, 0x68, 0xe2, 0x6b, 0x2b, 0x7c, 0xdf, 0x11, 0x86, 0xb0, 0xcc, 0x40, 0x3f, 0x01, 0xcb, 0x25,
-	0xeb, 0xd0, 0x1b, 0x10, 0xb7, 0xe4, 0xd8, 0xfb, 0x66, 0x17, 0x7e, 0x04, 0xe6, 0xf7, 0x1d, 0xcb,
-	0x20, 0xae, 0xa7, 0x2a, 0xf9, 0xe4, 0xea, 0xe2, 0x46, 0x26, 0x5a, 0x72, 0x93, 0x29, 0x8a, 0xda,
-	0x97, 0xbe, 0x36, 0x33, 0xf6, 0xb5, 0x80, 0x38, 0xf1, 0xb5, 0x25, 0xb6, 0x0c, 0x97, 0x11, 0x0e,
-	0x14, 0xe8, 0x8b, 0x14, 0x98, 0xe3, 0x46, 0x70, 0x1d, 0x24, 0x4c, 0x43, 0x1c, 0x77, 0xee, 0xa9,
-	0xaf, 0x25, 0xaa, 0xe5, 0xb1, 0xaf, 0x25, 0x4c, 0x63, 0xe2, 0x6b, 0x69, 0x66, 0x6d, 0x1a, 0xe8,
-	0xb3, 0xc7, 0x37, 0x13, 0xd5, 0x32, 0x4e, 0x98, 0x06, 0x5c, 0x07, 0xb3, 0x96, 0xbe, 0x47, 0x2c,
-	0x71, 0xb8, 0xea, 0xd8, 0xd7, 0x38, 0x30, 0xf1, 0xb5, 0x45, 0xc6, 0x67, 0x12, 0xc2, 0x1c, 0x85,
-	0xef, 0x81, 0x05, 0x97, 0xe8, 0x46, 0xdb, 0xb1, 0xad, 0x63, 0x76, 0x90, 0xe9, 0x62, 0x6e, 0xec,
-	0x6b, 0x69, 0x0a, 0xd6, 0x6d, 0xeb, 0x78, 0xe2, 0x6b, 0x17, 0x98, 0x59, 0x00, 0x20, 0x1c, 0xea,
-	0x60, 0x1b, 0x40, 0xb3, 0x6b, 0x3b, 0x2e, 0x69, 0xf7, 0x89, 0xdb, 0x33, 0x59, 0x68, 0x3c, 0x35,
-	0xc5, 0xbc, 0xbc, 0x3e, 0xf6, 0xb5, 0x15, 0xae, 0x6d, 0x44, 0xca, 0x89, 0xaf, 0x5d, 0xe5, 0xbb,
-	0x3e, 0xa9, 0x41, 0x78, 0x9a, 0x0d, 0x3f, 0x02, 0xcb, 0x62, 0x01, 0x83, 0x58, 0x64, 0x40, 0xd4,
-	0x59, 0xe6, 0xfb, 0xff, 0xc7, 0xbe, 0xb6, 0xc4, 0x15, 0x65, 0x86, 0x4f, 0x7c, 0x0d, 0x4a, 0x6e,
-	0x39, 0x88, 0x70, 0x8c, 0x03, 0x0d, 0x70, 0xc9, 0x30, 0x3d, 0x7d, 0xcf, 0x22, 0xed, 0x01, 0xe9,
-	0xf5, 0xdb, 0xa6, 0x6d, 0x90, 0x47, 0xc4, 0x53, 0xe7, 0x98, 0xcf, 0x8d, 0xb1, 0xaf, 0x41, 0xa1,
-	0x6f, 0x91, 0x5e, 0xbf, 0xca, 0xb5, 0x13, 0x5f, 0x53, 0x79, 0x4d, 0x4d, 0xa9, 0x10, 0x3e, 0x85,
-	0x0f, 0x37, 0xc0, 0x5c, 0x5f, 0x3f, 0xf4, 0x88, 0xa1, 0xce, 0x33, 0xbf, 0xd9, 0xb1, 0xaf, 0x09,
-	0x24, 0x3c, 0x70, 0x2e, 0x22, 0x2c, 0x70, 0x9a, 0x3c, 0xbc, 0x4a, 0x3d, 0x35, 0x73, 0x32, 0x79,
-	0xca, 0x4c, 0x11, 0x25, 0x8f, 0x20, 0x86, 0xbe, 0xb8, 0x8c, 0x70, 0xa0, 0x40, 0x7f, 0x9b, 0x03,
-	0x73, 0xdc, 0x08, 0x16, 0xc3, 0xe4, 0x59, 0x2a, 0x6e, 0x50, 0x07, 0xff, 0xf4, 0xb5, 0x34, 0xd7,
-	0x55, 0xcb, 0x67, 0x25, 0xd3, 0xa7, 0x8f, 0x6f, 0x2a, 0x52, 0x42, 0xad, 0x81, 0x94, 0xd4, 0x2c,
-	0x58, 0xed, 0xd9, 0xbc, 0x4d, 0xf0, 0xda, 0xb3, 0x59, 0x83, 0x60, 0x18, 0x7c, 0x1f, 0x2c, 0xe8,
-	0x86, 0x41, 0x6b, 0x84, 0x78, 0x6a, 0x32, 0x9f, 0xa4, 0x39, 0x3b, 0xf6, 0xb5, 0x08, 0x9c, 0xf8,
-	0xda, 0x32, 0xb3, 0x12, 0x08, 0xc2, 0x91, 0x0e, 0xfe, 0x34, 0x5e, 0xb9, 0xa9, 0x93, 0x3d, 0xe0,
-	0xdb, 0x95, 0x2c, 0xcd, 0xf4, 0x0e, 0x71, 0x45, 0xeb, 0x9b, 0xe5, 0x05, 0x45, 0x33, 0x9d, 0x82,
-	0xa2, 0xf1, 0xf1, 0x4c, 0x0f, 0x00, 0x84, 0x43, 0x1d, 0xdc, 0x02, 0x4b, 0x3d, 0xfd, 0x51, 0xdb,
-	0x23, 0x3f, 0x3b, 0x24, 0x76, 0x87, 0xb0, 0x9c, 0x49, 0xf2, 0x5d, 0xf4, 0xf4, 0x47, 0x4d, 0x01,
-	0x87, 0xbb, 0x90, 0x30, 0x84, 0x65, 0x06, 0x2c, 0x02, 0x60, 0xda, 0x03, 0xd7, 0x31, 0x0e, 0x3b,
-	0xc4, 0x15, 0x29, 0xc2, 0x3a, 0x70, 0x84, 0x86, 0x1d, 0x38, 0x82, 0x10, 0x96, 0xf4, 0xb0, 0x0b,
-	0xd2, 0x2c, 0x77, 0xdb, 0xa6, 0xa1, 0xa6, 0xf3, 0xca, 0x6a, 0xaa, 0xb8, 0x23, 0x0e, 0x77, 0x9e,
-	0x65, 0x21, 0x3b, 0xdb, 0xe0, 0x91, 0xe6, 0x0c, 0x63, 0x57, 0x8d, 0x30, 0xfa, 0x42, 0xa6, 0x7d,
-	0x23, 0xa0, 0xfd, 0x2e, 0x7a, 0xc4, 0x01, 0x1f, 0xfe, 0x1c, 0x64, 0xbd, 0x07, 0x26, 0xad, 0x14,
-	0xbe, 0xf6, 0xc0, 0x74, 0xec, 0xb6, 0x4b, 0x7a, 0xce, 0x91, 0x6e, 0x79, 0xea, 0x02, 0xdb, 0xfc,
-	0xed, 0xb1, 0xaf, 0xa9, 0x94, 0x55, 0x95, 0x48, 0x58, 0x70, 0x26, 0xbe, 0x96, 0x63, 0x2b, 0x9e,
-	0x45, 0x40, 0xf8, 0x4c, 0x5b, 0xf8, 0x08, 0xbc, 0x48, 0xec, 0x8e, 0x7b, 0xdc, 0x67, 0xcb, 0xf6,
-	0x75, 0xcf, 0x7b, 0xe8, 0xb8, 0x46, 0x7b, 0xe0, 0x3c, 0x20, 0xb6, 0x0a, 0x58, 0x52, 0xbf, 0x3f,
-	0xf6, 0xb5, 0xab, 0x11, 0xa9, 0x21, 0x38, 0x2d, 0x4a, 0x99, 0xf8, 0xda, 0x0d, 0xb6, 0xf6, 0x19,
-	0x7a, 0x84, 0xcf, 0xb2, 0x44, 0xbf, 0x54, 0xc0, 0x2c, 0x0b, 0x06, 0xad, 0x66, 0xde, 0x94, 0x45,
-	0x0b, 0x66, 0xd5, 0xcc, 0x91, 0xa9, 0xf6, 0x2d, 0x70, 0x58, 0x01, 0xb3, 0xfb, 0xa6, 0x45, 0x3c,
-	0x35, 0xc1, 0x6a, 0x19, 0x4a, 0x17, 0x81, 0x69, 0x91, 0xaa, 0xbd, 0xef, 0x14, 0xaf, 0x89, 0x6a,
-	0xe6, 0xc4, 0xb0, 0x96, 0xa8, 0x84, 0x30, 0x07, 0xd1, 0xa7, 0x0a, 0x58, 0x64, 0x9b, 0xb8, 0xd3,
-	0x37, 0xf4, 0x01, 0xf9, 0x5f, 0x6e, 0xe5, 0x09, 0x00, 0xe9, 0xc0, 0x20, 0x6c, 0x08, 0xca, 0x39,
-	0x1a, 0xc2, 0x1a, 0x48, 0x79, 0xe6, 0x27, 0x84, 0x5d, 0x2c, 0x49, 0xce, 0xa5, 0x72, 0xc8, 0xa5,
-	0x02, 0xc2, 0x0c, 0x83, 0x1f, 0x00, 0xd0, 0x73, 0x0c, 0x73, 0xdf, 0x24, 0x46, 0xdb, 0x63, 0x05,
-	0x9a, 0x2c, 0xe6, 0x69, 0xf7, 0x08, 0xd0, 0xe6, 0xc4, 0xd7, 0x5e, 0xe0, 0xe5, 0x15, 0x20, 0x08,
-	0x47, 0x5a, 0xda, 0x3f, 0x42, 0x07, 0x7b, 0xc7, 0xea, 0x12, 0xab, 0x8c, 0xf7, 0x83, 0xca, 0x68,
-	0x1e, 0x38, 0xee, 0x80, 0x95, 0x43, 0xb8, 0x4c, 0xf1, 0x38, 0x2c, 0xb5, 0x08, 0x42, 0xb4, 0x12,
-	0x04, 0x19, 0x4b, 0x54, 0xb8, 0x03, 0xe6, 0x83, 0x81, 0x87, 0x66, 0x7e, 0xac, 0x49, 0xdf, 0x25,
-	0x9d, 0x81, 0xe3, 0x16, 0xf3, 0x41, 0x93, 0x3e, 0x0a, 0x07, 0x20, 0x5e, 0x70, 0x47, 0xc1, 0xe8,
-	0x13, 0x68, 0xe0, 0xbb, 0x20, 0x1d, 0x36, 0x13, 0xc0, 0x7e, 0x2b, 0x6b, 0x46, 0x5e, 0xd4, 0x49,
-	0x78, 0x33, 0xf2, 0xc2, 0x36, 0x12, 0xea, 0xe0, 0x87, 0x60, 0x6e, 0xcf, 0x72, 0x3a, 0x0f, 0x82,
-	0xdb, 0xe2, 0x62, 0xb4, 0x91, 0x22, 0xc5, 0xd9, 0xb9, 0xde, 0x10, 0x7b, 0x11, 0xd4, 0xf0, 0xfa,
-	0x67, 0x22, 0xc2, 0x02, 0xa6, 0xd3, 0x9c, 0x77, 0xdc, 0xb3, 0x4c, 0xfb, 0x41, 0x7b, 0xa0, 0xbb,
-	0x5d, 0x32, 0x50, 0x57, 0xa2, 0x69, 0x4e, 0x68, 0x5a, 0x4c, 0x11, 0x4e, 0x73, 0x31, 0x14, 0xe1,
-	0x38, 0x8b, 0xce, 0x98, 0xdc, 0x75, 0xfb, 0x40, 0xf7, 0x0e, 0x54, 0xc8, 0xea, 0x94, 0x75, 0x38,
-	0x0e, 0x6f, 0xeb, 0xde, 0x41, 0x18, 0xf6, 0x08, 0x42, 0x58, 0xd2, 0xc3, 0xdb, 0x60, 0x41, 0xd4,
-	0x26, 0x31, 0xd4, 0x8b, 0xcc, 0x05, 0x4b, 0x85, 0x10, 0x0c, 0x53, 0x21, 0x44, 0x10, 0x8e, 0xb4,
-	0xb0, 0x28, 0xe6, 0x48, 0x3e, 0xfd, 0x5d, 0x99, 0x4e, 0xfb, 0x73, 0x0c, 0x92, 0x9b, 0x60, 0xf1,
-	0xe4, 0x54, 0xb3, 0xcc, 0x3b, 0x7e, 0x3f, 0x36, 0xcf, 0xf0, 0x8e, 0xdf, 0x97, 0x27, 0x19, 0x99,
-	0x01, 0x3f, 0x94, 0xd2, 0xd2, 0xf6, 0xd4, 0xc5, 0xbc, 0xb2, 0x3a, 0x5b, 0x7c, 0x45, 0xce, 0xc3,
-	0x9a, 0x37, 0x95, 0x87, 0x35, 0x0f, 0xfd, 0xc7, 0xd7, 0x92, 0xa6, 0x3d, 0xc0, 0x12, 0x0d, 0xee,
-	0x03, 0x1e, 0xa5, 0x36, 0xab, 0xaa, 0x65, 0xe6, 0x6a, 0xeb, 0xa9, 0xaf, 0x2d, 0x61, 0xfd, 0x21,
-	0x3b, 0xfa, 0xa6, 0xf9, 0x09, 0xa1, 0x81, 0xda, 0x0b, 0x84, 0x30, 0x50, 0x21, 0x12, 0x38, 0xfe,
-	0xec, 0xf1, 0xcd, 0x98, 0x19, 0x8e, 0x8c, 0x60, 0x19, 0x2c, 0x5a, 0x4e, 0x47, 0xb7, 0xda, 0xfb,
-	0x96, 0xde, 0xf5, 0xd4, 0x7f, 0xcd, 0xb3, 0x1f, 0xcf, 0x4e, 0x91, 0xe1, 0x9b, 0x14, 0x0e, 0x37,
-	0x1d, 0x41, 0x08, 0x4b, 0x7a, 0xb8, 0x0d, 0x96, 0x44, 0xba, 0xf3, 0x5c, 0xf8, 0xf7, 0x3c, 0x3b,
-	0x49, 0x16, 0x43, 0xa1, 0x10, 0xd9, 0xb0, 0x22, 0x57, 0x09, 0x4f, 0x07, 0x99, 0x01, 0xbf, 0x47,
-	0x07, 0x24, 0x3a, 0xc4, 0x19, 0x62, 0x5a, 0xbb, 0xce, 0x47, 0x21, 0x06, 0x85, 0x55, 0x26, 0x64,
-	0x36, 0x0b, 0xb1, 0x27, 0x88, 0xc1, 0xbc, 0x69, 0x1f, 0xe9, 0x96, 0x19, 0x4c, 0x63, 0xef, 0x3c,
-	0xf5, 0x35, 0x80, 0xf5, 0x87, 0x55, 0x8e, 0xf2, 0xcb, 0x91, 0x3d, 0x4a, 0x97, 0x23, 0x93, 0xe9,
-	0xe5, 0x28, 0x31, 0x71, 0xc0, 0xa3, 0x15, 0x63, 0x3b, 0xb1, 0x81, 0x37, 0xcd, 0x5c, 0xb3, 0x8a,
-	0xb1, 0x9d, 0xf8, 0xb0, 0xcb, 0x2b, 0x26, 0x86, 0x22, 0x1c, 0x67, 0xbd, 0x9b, 0xfa, 0xed, 0xe7,
-	0xda, 0x0c, 0x7a, 0xa2, 0x80, 0x85, 0xb0, 0x7a, 0x69, 0xe3, 0x64, 0x21, 0x4b, 0xb2, 0x88, 0xb1,
-	0x44, 0x3d, 0xe0, 0xa1, 0xe2, 0x89, 0x7a, 0xc0, 0x62, 0xc4, 0x30, 0x7a, 0x31, 0x38, 0xfb, 0xfb,
-	0x1e, 0x19, 0xb0, 0x96, 0x9c, 0xe4, 0x17, 0x03, 0x47, 0xc2, 0x8b, 0x81, 0x8b, 0x08, 0x0b, 0x1c,
-	0xbe, 0x21, 0x1a, 0x73, 0x82, 0xa5, 0xd0, 0x8d, 0xd3, 0x1b, 0x73, 0x90, 0x81, 0xbc, 0x3f, 0xbf,
-	0x07, 0x16, 0x1e, 0x12, 0xfd, 0x01, 0x3f, 0x4a, 0x5e, 0x0d, 0xac, 0x65, 0x51, 0x50, 0x1c, 0x23,
-	0x6f, 0x59, 0x01, 0x80, 0x70, 0xa8, 0x13, 0xbf, 0xf1, 0x3e, 0x98, 0xe3, 0x9d, 0x12, 0x36, 0x40,
-	0xba, 0xe3, 0x1c, 0xda, 0x83, 0xe8, 0x7d, 0x69, 0x45, 0x1e, 0xf4, 0x98, 0xa6, 0xf8, 0x7f, 0xa2,
-	0x85, 0x85, 0xd4, 0xf0, 0x8c, 0x04, 0x40, 0x27, 0x34, 0xa1, 0x42, 0xbf, 0x52, 0xc0, 0xbc, 0x30,
-	0x84, 0xdb, 0xe1, 0xdc, 0x9b, 0x2a, 0xbe, 0x73, 0xe2, 0x02, 0xf8, 0xfa, 0x77, 0x28, 0xb9, 0xf9,
-	0x8b, 0xd7, 0xa9, 0x23, 0xdd, 0x3a, 0xe4, 0x81, 0x4a, 0xf1, 0xd7, 0x29, 0x06, 0x84, 0xfd, 0x94,
-	0x49, 0x08, 0x73, 0x14, 0xfd, 0x22, 0x05, 0xe6, 0x31, 0xed, 0xd3, 0xde, 0x00, 0xbe, 0x1d, 0xee,
-	0x62, 0xb6, 0xf8, 0xf2, 0x59, 0xcb, 0x46, 0xc5, 0x18, 0x0c, 0xdc, 0xd1, 0x3d, 0x9f, 0x38, 0xf7,
-	0x3d, 0x1f, 0xdc, 0xc9, 0xc9, 0x73, 0xdc, 0xc9, 0x51, 0xba, 0xa4, 0x9e, 0x3b, 0x5d, 0x66, 0xcf,
-	0x9f, 0x2e, 0x41, 0x06, 0xcf, 0x9d, 0x23, 0x83, 0xeb, 0xe0, 0xc2, 0xbe, 0xeb, 0xf4, 0xd8, 0x6b,
-	0x99, 0xe3, 0xea, 0xee, 0xb1, 0xa8, 0x56, 0x56, 0x52, 0x54, 0xd3, 0x0a, 0x14, 0x61, 0x49, 0xc5,
-	0x50, 0x84, 0xe3, 0xac, 0x78, 0xae, 0xa6, 0x9f, 0x2f, 0x57, 0xe1, 0x6d, 0x90, 0xe6, 0x4d, 0xd6,
-	0x76, 0xd8, 0x4d, 0x3f, 0x5b, 0x7c, 0x89, 0xf6, 0x09, 0x86, 0xd5, 0x9c, 0x30, 0x07, 0x85, 0x1c,
-	0xfe, 0xec, 0x80, 0x80, 0xfe, 0xac, 0x80, 0x34, 0x26, 0x5e, 0xdf, 0xb1, 0x3d, 0xf2, 0x4d, 0x93,
-	0x60, 0x0d, 0xa4, 0x0c, 0x7d, 0xa0, 0xb3, 0x14, 0x10, 0xd1, 0xa3, 0x72, 0x18, 0x3d, 0x2a, 0x20,
-	0xcc, 0x30, 0xf8, 0x01, 0x48, 0x75, 0x1c, 0x83, 0x1f, 0xfe, 0x05, 0x79, 0x18, 0xa8, 0xb8, 0xae,
-	0xe3, 0x96, 0x1c, 0x43, 0xdc, 0x74, 0x94, 0x14, 0x3a, 0xa0, 0x02, 0xc2, 0x0c, 0x43, 0x7f, 0x52,
-	0x40, 0xa6, 0xec, 0x3c, 0xb4, 0x2d, 0x47, 0x37, 0x1a, 0xae, 0xd3, 0xa5, 0x6f, 0x4c, 0xdf, 0x68,
-	0xdc, 0x6c, 0x83, 0xf9, 0x43, 0x36, 0xac, 0x06, 0x03, 0xe7, 0xcd, 0xf8, 0xcd, 0x7b, 0x72, 0x11,
-	0x3e, 0xd9, 0x46, 0xef, 0xb6, 0xc2, 0x38, 0xf4, 0xcf, 0x65, 0x84, 0x03, 0x05, 0xfa, 0x63, 0x12,
-	0x64, 0xcf, 0x76, 0x04, 0x7b, 0x60, 0x91, 0x33, 0xdb, 0xd2, 0x57, 0xa4, 0xd5, 0xf3, 0xec, 0x81,
-	0xcd, 0x03, 0xec, 0x7e, 0x3b, 0x0c, 0xe5, 0xf0, 0x7e, 0x8b, 0x20, 0x84, 0x25, 0xfd, 0x73, 0xbd,
-	0x1a, 0x4b, 0xd3, 0x63, 0xf2, 0xdb, 0x4f, 0x8f, 0x4d, 0xb0, 0xcc, 0x53, 0x34, 0xf8, 0x86, 0x91,
-	0xca, 0x27, 0x57, 0x67, 0x8b, 0xeb, 0x63, 0x5f, 0x5b, 0xda, 0xe3, 0x97, 0x48, 0xf0, 0xf5, 0x62,
-	0x25, 0x4a, 0x56, 0x0e, 0x06, 0xd9, 0x96, 0x99, 0xc1, 0x31, 0x2e, 0xdc, 0x8c, 0x0d, 0x17, 0xbc,
-	0xd4, 0xbf, 0x73, 0xce, 0x61, 0x42, 0x1a, 0x1e, 0xd0, 0x1c, 0x48, 0x35, 0x4c, 0xbb, 0x8b, 0xde,
-	0x03, 0xb3, 0x25, 0xcb, 0xf1, 0x58, 0xc7, 0x71, 0x89, 0xee, 0x39, 0xb6, 0x9c, 0x4a, 0x1c, 0x09,
-	0x8f, 0x9a, 0x8b, 0x08, 0x0b, 0x7c, 0xed, 0x8b, 0x24, 0x58, 0x94, 0x3e, 0xfa, 0xc1, 0x1f, 0x82,
-	0x6b, 0xbb, 0x95, 0x66, 0xb3, 0xb0, 0x55, 0x69, 0xb7, 0xee, 0x35, 0x2a, 0xed, 0xd2, 0xce, 0x9d,
-	0x66, 0xab, 0x82, 0xdb, 0xa5, 0x7a, 0x6d, 0xb3, 0xba, 0x95, 0x99, 0xc9, 0x5e, 0x1f, 0x8e, 0xf2,
-	0xaa, 0x64, 0x11, 0xff, 0x3c, 0xf7, 0x5d, 0x00, 0x63, 0xe6, 0xd5, 0x5a, 0xb9, 0xf2, 0xe3, 0x8c,
-	0x92, 0xbd, 0x34, 0x1c, 0xe5, 0x33, 0x92, 0x15, 0x7f, 0xeb, 0xfb, 0x01, 0x78, 0x71, 0x9a, 0xdd,
-	0xbe, 0xd3, 0x28, 0x17, 0x5a, 0x95, 0x4c, 0x22, 0x9b, 0x1d, 0x8e, 0xf2, 0x57, 0x4e, 0x1a, 0x89,
-	0x14, 0x7c, 0x1d, 0x5c, 0x8a, 0x99, 0xe2, 0xca, 0x8f, 0xee, 0x54, 0x9a, 0xad, 0x4c, 0x32, 0x7b,
-	0x65, 0x38, 0xca, 0x43, 0xc9, 0x2a, 0xb8, 0x26, 0x36, 0xc0, 0xe5, 0x13, 0x16, 0xcd, 0x46, 0xbd,
-	0xd6, 0xac, 0x64, 0x52, 0xd9, 0xab, 0xc3, 0x51, 0xfe, 0x62, 0xcc, 0x44, 0x74, 0x95, 0x12, 0xc8,
-	0xc5, 0x6c, 0xca, 0xf5, 0x8f, 0x6b, 0x3b, 0xf5, 0x42, 0xb9, 0xdd, 0xc0, 0xf5, 0x2d, 0x5c, 0x69,
-	0x36, 0x33, 0xb3, 0x59, 0x6d, 0x38, 0xca, 0x5f, 0x93, 0x8c, 0xa7, 0x2a, 0x7c, 0x0d, 0xac, 0xc4,
-	0x9c, 0x34, 0xaa, 0xb5, 0xad, 0xcc, 0x5c, 0xf6, 0xe2, 0x70, 0x94, 0x7f, 0x41, 0xb2, 0xa3, 0x67,
-	0x39, 0x15, 0xbf, 0xd2, 0x4e, 0xbd, 0x59, 0xc9, 0xcc, 0x4f, 0xc5, 0x8f, 0x1d, 0xf8, 0xda, 0x1f,
-	0x14, 0x00, 0xa7, 0xbf, 0xb3, 0xc2, 0x77, 0x80, 0x1a, 0x38, 0x29, 0xd5, 0x77, 0x1b, 0x74, 0x9f,
-	0xd5, 0x7a, 0xad, 0x5d, 0xab, 0xd7, 0x2a, 0x99, 0x99, 0x58, 0x54, 0x25, 0xab, 0x9a, 0x63, 0x13,
-	0x58, 0x07, 0x57, 0x4f, 0xb3, 0xdc, 0xb9, 0xff, 0x56, 0x46, 0xc9, 0x6e, 0x0c, 0x47, 0xf9, 0xcb,
-	0xd3, 0x86, 0x3b, 0xf7, 0xdf, 0xfa, 0xea, 0xd7, 0x2f, 0x9f, 0xae, 0x58, 0xfb, 0xbd, 0x02, 0x16,
-	0xe5, 0xad, 0xbd, 0x01, 0x2e, 0xc9, 0x8e, 0x77, 0x2b, 0xad, 0x42, 0xb9, 0xd0, 0x2a, 0x64, 0x66,
-	0xf8, 0x19, 0x48, 0xd4, 0x5d, 0x32, 0xd0, 0x59, 0xdb, 0x7d, 0x15, 0xac, 0xc4, 0x7e, 0x45, 0xe5,
-	0x6e, 0x05, 0x07, 0x19, 0x25, 0xef, 0x9f, 0x1c, 0x11, 0x17, 0xbe, 0x06, 0xa0, 0x4c, 0x2e, 0xec,
-	0x7c, 0x5c, 0xb8, 0xd7, 0xcc, 0x24, 0xb2, 0x97, 0x87, 0xa3, 0xfc, 0x8a, 0xc4, 0x2e, 0x58, 0x0f,
-	0xf5, 0x63, 0x6f, 0xed, 0xaf, 0x09, 0xb0, 0x24, 0xbf, 0xaa, 0xc0, 0xd7, 0xc0, 0xc5, 0xcd, 0xea,
-	0x0e, 0xcd, 0xc4, 0xcd, 0x3a, 0x3f, 0x01, 0x2a, 0x66, 0x66, 0xf8, 0x72, 0x32, 0x95, 0x3e, 0xc3,
-	0xef, 0x03, 0xf5, 0x04, 0xbd, 0x5c, 0xc5, 0x95, 0x52, 0xab, 0x8e, 0xef, 0x65, 0x94, 0xec, 0x8b,
-	0x34, 0x60, 0xb2, 0x4d, 0xd9, 0x74, 0x59, 0x0b, 0x3a, 0x86, 0xb7, 0xc1, 0xb5, 0x13, 0x86, 0xcd,
-	0x7b, 0xbb, 0x3b, 0xd5, 0xda, 0x47, 0x7c, 0xbd, 0x44, 0xf6, 0xc6, 0x70, 0x94, 0xbf, 0x2a, 0xdb,
-	0x36, 0xf9, 0xdb, 0x1f, 0x85, 0xd2, 0x0a, 0xdc, 0x06, 0xf9, 0x33, 0xec, 0xa3, 0x0d, 0x24, 0xb3,
-	0x68, 0x38, 0xca, 0x5f, 0x3f, 0xc5, 0x49, 0xb8, 0x8f, 0xb4, 0x02, 0xdf, 0x04, 0x57, 0x4e, 0xf7,
-	0x14, 0xd4, 0xc5, 0x29, 0xf6, 0x6b, 0x7f, 0x57, 0xc0, 0x42, 0x78, 0xeb, 0xd1, 0xa0, 0x55, 0x30,
-	0xae, 0xd3, 0x26, 0x51, 0xae, 0xb4, 0x6b, 0xf5, 0x36, 0x93, 0x82, 0xa0, 0x85, 0xbc, 0x9a, 0xc3,
-	0x1e, 0x69, 0x8e, 0x4b, 0xf4, 0xad, 0x4a, 0xad, 0x82, 0xab, 0xa5, 0xe0, 0x44, 0x43, 0xf6, 0x16,
-	0xb1, 0x89, 0x6b, 0x76, 0xe0, 0x5b, 0xe0, 0x6a, 0xdc, 0x79, 0xf3, 0x4e, 0x69, 0x3b, 0x88, 0x12,
-	0xdb, 0xa0, 0xb4, 0x40, 0xf3, 0xb0, 0x73, 0xc0, 0x0e, 0xe6, 0xed, 0x98, 0x55, 0xb5, 0x76, 0xb7,
-	0xb0, 0x53, 0x2d, 0x73, 0xab, 0x64, 0x56, 0x1d, 0x8e, 0xf2, 0x97, 0x42, 0x2b, 0xf1, 0xe2, 0x41,
-	0xcd, 0xd6, 0xbe, 0x52, 0x40, 0xee, 0xeb, 0x2f, 0x2f, 0xf8, 0x31, 0x78, 0x85, 0xc5, 0x6b, 0xaa,
-	0x15, 0x88, 0xbe, 0xc5, 0x63, 0x58, 0x68, 0x34, 0x2a, 0xb5, 0x72, 0x66, 0x26, 0xbb, 0x3a, 0x1c,
-	0xe5, 0x6f, 0x7e, 0xbd, 0xcb, 0x42, 0xbf, 0x4f, 0x6c, 0xe3, 0x9c, 0x8e, 0x37, 0xeb, 0x78, 0xab,
-	0xd2, 0xca, 0x28, 0xe7, 0x71, 0xbc, 0xe9, 0xb8, 0x5d, 0x32, 0x28, 0xee, 0x7e, 0xf9, 0x24, 0x37,
-	0xf3, 0xf8, 0x49, 0x6e, 0xe6, 0xcb, 0xa7, 0x39, 0xe5, 0xf1, 0xd3, 0x9c, 0xf2, 0x9b, 0x67, 0xb9,
-	0x99, 0xcf, 0x9f, 0xe5, 0x94, 0xc7, 0xcf, 0x72, 0x33, 0xff, 0x78, 0x96, 0x9b, 0xb9, 0xff, 0x6a,
-	0xd7, 0x1c, 0x1c, 0x1c, 0xee, 0xad, 0x77, 0x9c, 0xde, 0x2d, 0xef, 0xd8, 0xee, 0x0c, 0x0e, 0x4c,
-	0xbb, 0x2b, 0x3d, 0xc9, 0xff, 0xb7, 0xed, 0xcd, 0xb1, 0xa7, 0x37, 0xff, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0xf7, 0xe3, 0xa9, 0xd7, 0x86, 0x1b, 0x00, 0x00,
+	// 3281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcb, 0x6f, 0x1c, 0x47,
+	0x7a, 0xe7, 0x70, 0x1e, 0x1c, 0x16, 0x29, 0x6a, 0x58, 0x92, 0xa8, 0xd1, 0x48, 0x62, 0x4f, 0xca,
+	0x72, 0x4c, 0xd3, 0x31, 0x65, 0xd3, 0x8f, 0x38, 0xb2, 0x22, 0x83, 0xf3, 0x20, 0x39, 0x16, 0x39,
+	0xc3, 0xd4, 0x90, 0xb2, 0x25, 0xc4, 0x68, 0x34, 0xa7, 0x8b, 0xc3, 0x86, 0x66, 0xba, 0x27, 0xdd,
+	0x3d, 0x7c, 0x18, 0x39, 0xe4, 0x01, 0x04, 0x06, 0x0f, 0x41, 0xe0, 0x53, 0x10, 0x84, 0x88, 0x91,
+	0x4b, 0x0e, 0x01, 0x82, 0xe4, 0x90, 0xc3, 0xee, 0x5f, 0xa0, 0xa3, 0x60, 0x60, 0x81, 0xdd, 0x3d,
+	0x34, 0x60, 0xe9, 0xb0, 0xbb, 0x73, 0x9c, 0xe3, 0x9e, 0x16, 0xf5, 0xe8, 0xea, 0x6a, 0x3e, 0x6c,
+	0xca, 0x3e, 0xec, 0x49, 0x5d, 0xbf, 0xef, 0xf7, 0x7d, 0x55, 0x53, 0xf5, 0xbd, 0xaa, 0x28, 0x30,
+	0xd3, 0xb1, 0xb6, 0xef, 0xf6, 0x5c, 0xc7, 0x77, 0x5a, 0x4e, 0xe7, 0xee, 0x36, 0xe9, 0x2d, 0xb0,
+	0x01, 0xcc, 0x86, 0x58, 0x61, 0x9c, 0x1c, 0xf8, 0x1c, 0x2c, 0xbc, 0xe6, 0x92, 0x9e, 0xe3, 0x71,
+	0xfa, 0x76, 0x7f, 0xe7, 0x6e, 0xdb, 0x69, 0x3b, 0x6c, 0xc0, 0xbe, 0x38, 0x09, 0xfd, 0x26, 0x09,
+	0xd2, 0xab, 0xa4, 0xd3, 0x71, 0x60, 0x19, 0x4c, 0x98, 0x64, 0xcf, 0x6a, 0x11, 0xdd, 0x36, 0xba,
+	0x24, 0x9f, 0x28, 0x26, 0xe6, 0xc6, 0x4b, 0x68, 0x10, 0x68, 0x80, 0xc3, 0x75, 0xa3, 0x4b, 0x86,
+	0x81, 0x96, 0x3b, 0xe8, 0x76, 0xee, 0xa1, 0x08, 0x42, 0x58, 0x91, 0x53, 0x23, 0xad, 0x8e, 0x45,
+	0x6c, 0x9f, 0x1b, 0x19, 0x8d, 0x8c, 0x70, 0x38, 0x66, 0x24, 0x82, 0x10, 0x56, 0xe4, 0xb0, 0x01,
+	0xa6, 0x84, 0x91, 0x3d, 0xe2, 0x7a, 0x96, 0x63, 0xe7, 0x93, 0xcc, 0xce, 0xdc, 0x20, 0xd0, 0x2e,
+	0x71, 0xc9, 0x23, 0x2e, 0x18, 0x06, 0xda, 0x15, 0xc5, 0x94, 0x40, 0x11, 0x8e, 0xb3, 0x60, 0x1b,
+	0x4c, 0xb6, 0x9c, 0x6e, 0xcf, 0x25, 0x1e, 0x1d, 0x7a, 0xf9, 0x54, 0x31, 0x39, 0x37, 0xb5, 0x78,
+	0x6b, 0x21, 0xdc, 0xb5, 0x85, 0x75, 0xe2, 0x79, 0x46, 0x9b, 0x94, 0x23, 0x52, 0xe9, 0xf5, 0x41,
+	0xa0, 0xc5, 0xb4, 0x86, 0x81, 0x36, 0xcd, 0xe7, 0x8a, 0x40, 0x84, 0x63, 0x14, 0xf8, 0x04, 0x5c,
+	0xb6, 0xfb, 0x5d, 0xbd, 0xe5, 0xd8, 0x36, 0x69, 0xf9, 0x6c, 0xae, 0x74, 0x31, 0x31, 0x97, 0x2e,
+	0xbd, 0x3b, 0x08, 0xb4, 0x29, 0xbb, 0xdf, 0x2d, 0x47, 0x92, 0x61, 0xa0, 0x5d, 0x65, 0xf6, 0xe2,
+	0x30, 0xfa, 0x7d, 0xa0, 0x25, 0x2d, 0xdb, 0xc7, 0x27, 0xe8, 0xf0, 0x01, 0x18, 0xf7, 0x48, 0xcb,
+	0xb1, 0x4d, 0xc3, 0x3d, 0xcc, 0x67, 0x8a, 0x89, 0xb9, 0x6c, 0xa9, 0x38, 0x08, 0xb4, 0x08, 0x1c,
+	0x06, 0xda, 0x65, 0x66, 0x50, 0x22, 0x08, 0x47, 0x52, 0xf4, 0x7f, 0x09, 0x90, 0x59, 0x25, 0x86,
+	0x49, 0x5c, 0xb8, 0x04, 0x52, 0xfe, 0x61, 0x8f, 0x9f, 0xf1, 0xd4, 0xe2, 0xb5, 0x53, 0xfb, 0xb0,
+	0x79, 0xd8, 0x23, 0xa5, 0x99, 0x41, 0xa0, 0x31, 0xda, 0x30, 0xd0, 0x00, 0xb3, 0x4b, 0x07, 0x08,
+	0x33, 0x0c, 0x9a, 0x60, 0x42, 0xf9, 0xe5, 0xec, 0xa0, 0x7f, 0x68, 0x47, 0xef, 0x0c, 0x02, 0x4d,
+	0x55, 0x3a, 0x7b, 0x43, 0x55, 0x06, 0xfa, 0x6b, 0x70, 0xa9, 0xdc, 0xe9, 0x7b, 0x3e, 0x71, 0xcb,
+	0x8e, 0xbd, 0x63, 0xb5, 0xe1, 0x43, 0x30, 0xb6, 0xe3, 0x74, 0x4c, 0xe2, 0x7a, 0xf9, 0x44, 0x31,
+	0x39, 0x37, 0xb1, 0x98, 0x8b, 0xa6, 0x5c, 0x66, 0x82, 0x92, 0xf6, 0x2c, 0xd0, 0x46, 0x06, 0x81,
+	0x16, 0x12, 0x87, 0x81, 0x36, 0xc9, 0xa6, 0xe1, 0x63, 0x84, 0x43, 0x01, 0xfa, 0xbb, 0x0c, 0xc8,
+	0x70, 0x25, 0xb8, 0x00, 0x46, 0x2d, 0x53, 0xf8, 0xfc, 0xec, 0x8b, 0x40, 0x1b, 0xad, 0x55, 0x06,
+	0x81, 0x36, 0x6a, 0x99, 0xc3, 0x40, 0xcb, 0x32, 0x6d, 0xcb, 0x44, 0x5f, 0x3f, 0xbf, 0x33, 0x5a,
+	0xab, 0xe0, 0x51, 0xcb, 0x84, 0x0b, 0x20, 0xdd, 0x31, 0xb6, 0x49, 0x47, 0x78, 0x78, 0x7e, 0x10,
+	0x68, 0x1c, 0x18, 0x06, 0xda, 0x04, 0xe3, 0xb3, 0x11, 0xc2, 0x1c, 0x85, 0x1f, 0x83, 0x71, 0x97,
+	0x18, 0xa6, 0xee, 0xd8, 0x9d, 0x43, 0xe6, 0xcd, 0xd9, 0xd2, 0xec, 0x20, 0xd0, 0xb2, 0x14, 0x6c,
+	0xd8, 0x1d, 0x7a, 0x76, 0x53, 0x4c, 0x2d, 0x04, 0x10, 0x96, 0x32, 0xa8, 0x03, 0x68, 0xb5, 0x6d,
+	0xc7, 0x25, 0x7a, 0x8f, 0xb8, 0x5d, 0x4b, 0x3a, 0x31, 0xb5, 0xf2, 0xce, 0x20, 0xd0, 0xa6, 0xb9,
+	0x74, 0x23, 0x12, 0x0e, 0x03, 0xed, 0x3a, 0x5f, 0xf5, 0x49, 0x09, 0xc2, 0xa7, 0xd9, 0xf0, 0x21,
+	0xb8, 0x24, 0x26, 0x30, 0x49, 0x87, 0xf8, 0x84, 0x39, 0x6d, 0xb6, 0xf4, 0xa7, 0x34, 0x04, 0xb8,
+	0xa0, 0xc2, 0xf0, 0x61, 0xa0, 0x41, 0xc5, 0x2c, 0x07, 0x11, 0x8e, 0x71, 0xa0, 0x09, 0xae, 0x9a,
+	0x96, 0x67, 0x6c, 0x77, 0x88, 0xee, 0x93, 0x6e, 0x4f, 0xb7, 0x6c, 0x93, 0x1c, 0x10, 0x4f, 0xb8,
+	0xec, 0xe2, 0x20, 0xd0, 0xa0, 0x90, 0x6f, 0x92, 0x6e, 0xaf, 0xc6, 0xa5, 0xc3, 0x40, 0xcb, 0xf3,
+	0xc4, 0x72, 0x4a, 0x84, 0xf0, 0x19, 0x7c, 0xb8, 0x08, 0x32, 0x3d, 0xa3, 0xef, 0x11, 0x33, 0x3f,
+	0xc6, 0xec, 0x16, 0x06, 0x81, 0x26, 0x10, 0x79, 0xe0, 0x7c, 0x88, 0xb0, 0xc0, 0x61, 0x1d, 0x4c,
+	0x79, 0xa4, 0x43, 0x5a, 0x3e, 0x31, 0xf5, 0x9e, 0xe1, 0xef, 0x7a, 0xf9, 0x6c, 0x31, 0x39, 0x37,
+	0x5e, 0x7a, 0x83, 0xe6, 0x95, 0x50, 0xb2, 0x41, 0x05, 0xf2, 0x87, 0xaa, 0x28, 0xc2, 0x71, 0x12,
+	0xf4, 0x41, 0xbe, 0xe5, 0xd8, 0x3e, 0x4d, 0x54, 0x26, 0xd9, 0xb1, 0x6c, 0x62, 0xea, 0xad, 0xdd,
+	0xbe, 0xfd, 0xd4, 0xb2, 0xdb, 0xf9, 0x71, 0xb6, 0xaa, 0x7b, 0x83, 0x40, 0x9b, 0x11, 0x9c, 0x0a,
+	0xa7, 0x94, 0x05, 0x63, 0x18, 0x68, 0xb7, 0x84, 0xf7, 0x9f, 0x25, 0x46, 0xf8, 0x1c, 0x3d, 0x1a,
+	0x02, 0x3c, 0xe1, 0x7a, 0xf9, 0xdc, 0xc9, 0x10, 0xa8, 0x30, 0x41, 0x14, 0x02, 0x82, 0x28, 0x77,
+	0x84, 0x8f, 0x11, 0x0e, 0x05, 0xe8, 0x3f, 0xc6, 0x40, 0x86, 0x2b, 0xc1, 0x92, 0x0c, 0x81, 0xc9,
+	0xd2, 0x22, 0x35, 0xf0, 0xeb, 0x40, 0xcb, 0x72, 0x59, 0xad, 0x72, 0x5e, 0x48, 0x7c, 0xf5, 0xfc,
+	0x4e, 0x42, 0x09, 0x8b, 0x79, 0x90, 0x52, 0xf2, 0x3e, 0xcb, 0x20, 0xb6, 0xd1, 0x8d, 0x32, 0x88,
+	0xcd, 0x72, 0x3d, 0xc3, 0xe0, 0x7d, 0x30, 0x6e, 0x98, 0x26, 0x8d, 0x74, 0xe2, 0xe5, 0x93, 0xec,
+	0x20, 0x68, 0x48, 0x44, 0xe0, 0x30, 0xd0, 0x2e, 0x31, 0x2d, 0x81, 0x20, 0x1c, 0xc9, 0xe0, 0x17,
+	0xf1, 0xfc, 0x93, 0x3a, 0x99, 0xc9, 0x7e, 0x5a, 0xe2, 0xa1, 0xf1, 0xda, 0x22, 0xae, 0xa8, 0x62,
+	0x69, 0x9e, 0x16, 0x68, 0xbc, 0x52, 0x50, 0xd4, 0x30, 0x1e, 0xaf, 0x21, 0x80, 0xb0, 0x94, 0xc1,
+	0x15, 0x30, 0xd9, 0x35, 0x0e, 0x74, 0x8f, 0xfc, 0x4d, 0x9f, 0xd8, 0x2d, 0xc2, 0x3c, 0x3f, 0xc9,
+	0x57, 0xd1, 0x35, 0x0e, 0x9a, 0x02, 0x96, 0xab, 0x50, 0x30, 0x84, 0x55, 0x06, 0x2c, 0x01, 0x60,
+	0xd9, 0xbe, 0xeb, 0x98, 0xfd, 0x16, 0x71, 0x85, 0xa3, 0xb3, 0x62, 0x1a, 0xa1, 0xb2, 0x98, 0x46,
+	0x10, 0xc2, 0x8a, 0x1c, 0xb6, 0x41, 0x96, 0x45, 0xa0, 0x6e, 0x99, 0xf9, 0x6c, 0x31, 0x31, 0x97,
+	0x2a, 0xad, 0x89, 0xc3, 0x1d, 0x63, 0xb1, 0xc4, 0xce, 0x36, 0xfc, 0xa4, 0x3e, 0xc3, 0xd8, 0x35,
+	0x53, 0xee, 0xbe, 0x18, 0xd3, 0xec, 0x17, 0xd2, 0xfe, 0x2d, 0xfa, 0xc4, 0x21, 0x1f, 0xfe, 0x2d,
+	0x28, 0x78, 0x4f, 0xad, 0x9e, 0x1e, 0xce, 0x4d, 0xab, 0x96, 0xee, 0x92, 0xae, 0xb3, 0x67, 0x74,
+	0x3c, 0x11, 0x0f, 0x0f, 0x06, 0x81, 0x96, 0xa7, 0xac, 0x9a, 0x42, 0xc2, 0x82, 0x33, 0x0c, 0xb4,
+	0x59, 0x1e, 0x74, 0xe7, 0x10, 0x10, 0x3e, 0x57, 0x17, 0x1e, 0x80, 0x1b, 0xc4, 0x6e, 0xb9, 0x87,
+	0x3d, 0x36, 0x6d, 0xcf, 0xf0, 0xbc, 0x7d, 0xc7, 0x35, 0x75, 0xdf, 0x79, 0x4a, 0xec, 0x3c, 0x60,
+	0x4e, 0x7d, 0x7f, 0x10, 0x68, 0xd7, 0x23, 0xd2, 0x86, 0xe0, 0x6c, 0x52, 0xca, 0x30, 0xd0, 0x6e,
+	0xb3, 0xb9, 0xcf, 0x91, 0x23, 0x7c, 0x9e, 0x26, 0xfc, 0x02, 0x40, 0x67, 0xdb, 0x23, 0xee, 0x1e,
+	0x31, 0xf5, 0xc8, 0xa1, 0x27, 0x98, 0x43, 0x2f, 0xd0, 0xec, 0x1c, 0x4a, 0x97, 0x14, 0xc7, 0xbe,
+	0xc6, 0x26, 0x3b, 0x21, 0x41, 0xf8, 0x34, 0x17, 0xfd, 0x43, 0x02, 0xa4, 0xd9, 0x5e, 0xd3, 0x94,
+	0xc7, 0x2b, 0x97, 0xa8, 0x53, 0x2c, 0xe5, 0x71, 0xe4, 0x54, 0x8d, 0x13, 0x38, 0xac, 0x82, 0xf4,
+	0x8e, 0xd5, 0x21, 0x5e, 0x7e, 0x94, 0xa5, 0x0a, 0xa8, 0x54, 0x4b, 0xab, 0x43, 0x6a, 0xf6, 0x8e,
+	0x53, 0xba, 0x29, 0x92, 0x05, 0x27, 0xca, 0x50, 0xa5, 0x23, 0x84, 0x39, 0x88, 0xbe, 0x4a, 0x80,
+	0x09, 0xb6, 0x88, 0xad, 0x9e, 0x69, 0xf8, 0xe4, 0x8f, 0xb9, 0x94, 0x9f, 0x4d, 0x80, 0x6c, 0xa8,
+	0x20, 0xf3, 0x4d, 0xe2, 0x02, 0xf9, 0x66, 0x1e, 0xa4, 0x3c, 0xeb, 0x4b, 0xc2, 0xaa, 0x6f, 0x92,
+	0x73, 0xe9, 0x58, 0x72, 0xe9, 0x00, 0x61, 0x86, 0xc1, 0x4f, 0x00, 0xe8, 0x3a, 0xa6, 0xb5, 0x63,
+	0x11, 0x53, 0xe7, 0x2d, 0x5c, 0x92, 0x37, 0x5b, 0x21, 0xda, 0x94, 0xcd, 0x96, 0x44, 0x10, 0x8e,
+	0xa4, 0x34, 0x3d, 0x49, 0x03, 0xdb, 0x87, 0xf9, 0x49, 0x16, 0x78, 0xf7, 0xc3, 0xc0, 0x6b, 0xee,
+	0x3a, 0xae, 0xcf, 0xa2, 0x4d, 0x4e, 0x53, 0x3a, 0x94, 0x91, 0x1c, 0x41, 0x88, 0x06, 0x9a, 0x20,
+	0x63, 0x85, 0x0a, 0xd7, 0xc0, 0x58, 0xd8, 0x1a, 0xd3, 0xc0, 0x8a, 0xd5, 0x80, 0x47, 0xa4, 0xe5,
+	0x3b, 0x6e, 0xa9, 0x18, 0xd6, 0x80, 0x3d, 0xd9, 0x2a, 0xf3, 0x78, 0xde, 0x0b, 0x9b, 0xe4, 0x50,
+	0x02, 0xef, 0x81, 0xac, 0xcc, 0x55, 0x80, 0xfd, 0x56, 0x96, 0xeb, 0xbc, 0x28, 0x51, 0x4d, 0x89,
+	0x62, 0x18, 0x66, 0x29, 0x29, 0x83, 0x9f, 0x82, 0xcc, 0x76, 0xc7, 0x69, 0x3d, 0x0d, 0x8b, 0xd1,
+	0x95, 0x68, 0x21, 0x25, 0x8a, 0xb3, 0x73, 0xbd, 0x2d, 0xd6, 0x22, 0xa8, 0xb2, 0x47, 0x62, 0x43,
+	0x84, 0x05, 0x4c, 0xfb, 0x7e, 0xef, 0xb0, 0xdb, 0xb1, 0xec, 0xa7, 0xba, 0x6f, 0xb8, 0x6d, 0xe2,
+	0xe7, 0xa7, 0xa3, 0xbe, 0x5f, 0x48, 0x36, 0x99, 0x40, 0xf6, 0xfd, 0x31, 0x94, 0x16, 0x68, 0x75,
+	0x4c, 0x6f, 0x23, 0xdc, 0xb4, 0xbe, 0x6b, 0x78, 0xbb, 0x79, 0xc8, 0xd2, 0x00, 0x4b, 0xa0, 0x1c,
+	0x5e, 0x35, 0xbc, 0x5d, 0xb9, 0xed, 0x11, 0x84, 0xb0, 0x22, 0xa7, 0x7d, 0xb7, 0x08, 0x7d, 0x62,
+	0xe6, 0xaf, 0x30, 0x13, 0xcc, 0x15, 0x24, 0x28, 0x5d, 0x41, 0x22, 0x08, 0x47, 0x52, 0xf8, 0x08,
+	0x64, 0x7b, 0x1d, 0xc3, 0xdf, 0x71, 0xdc, 0x6e, 0x7e, 0x8a, 0x1d, 0xd6, 0x4c, 0xb4, 0x47, 0x1b,
+	0x42, 0x52, 0x31, 0x7c, 0xa3, 0x84, 0xc4, 0x36, 0x49, 0xbe, 0xdc, 0xf9, 0x10, 0x40, 0x58, 0xca,
+	0x60, 0x49, 0x34, 0xf1, 0xbc, 0xf5, 0x9e, 0x39, 0x1d, 0x4e, 0x17, 0xe8, 0xe2, 0x97, 0xc1, 0xc4,
+	0xc9, 0x96, 0xf2, 0x12, 0x2f, 0x54, 0xbd, 0x58, 0x33, 0xc9, 0x0b, 0x55, 0x4f, 0x6d, 0x23, 0x55,
+	0x06, 0xfc, 0x54, 0x71, 0x77, 0x9b, 0x26, 0x3f, 0x7a, 0xe7, 0x79, 0x53, 0xf5, 0xef, 0xba, 0x77,
+	0xca, 0xbf, 0xeb, 0xd1, 0x5d, 0x47, 0xa1, 0xc1, 0x1d, 0xc0, 0x77, 0x5f, 0x67, 0xd1, 0x7a, 0x89,
+	0x99, 0x5a, 0x79, 0x11, 0x68, 0x93, 0xd8, 0xd8, 0x67, 0x2e, 0xd5, 0xb4, 0xbe, 0x24, 0xf4, 0x00,
+	0xb6, 0xc3, 0x81, 0x3c, 0x00, 0x89, 0x84, 0x86, 0xbf, 0x7e, 0x7e, 0x27, 0xa6, 0x86, 0x23, 0x25,
+	0x58, 0x01, 0x13, 0x1d, 0xa7, 0x65, 0x74, 0xf4, 0x9d, 0x8e, 0xd1, 0xf6, 0xf2, 0xbf, 0x1d, 0x63,
+	0x3f, 0x9e, 0x79, 0x07, 0xc3, 0x97, 0x29, 0x2c, 0x17, 0x1d, 0x41, 0x08, 0x2b, 0x72, 0xb8, 0x0a,
+	0x26, 0x45, 0x18, 0x71, 0x1f, 0xfb, 0xdd, 0x18, 0xf3, 0x10, 0xb6, 0x87, 0x42, 0x20, 0xbc, 0x6c,
+	0x5a, 0x8d, 0x3e, 0xee, 0x66, 0x2a, 0x03, 0x7e, 0x48, 0xfb, 0x3a, 0xda, 0x41, 0x9b, 0xa2, 0x55,
+	0xbe, 0xc5, 0x3b, 0x38, 0x06, 0xc9, 0xe8, 0x15, 0x63, 0xd6, 0xc2, 0xb1, 0x2f, 0x88, 0xc1, 0x98,
+	0x65, 0xef, 0x19, 0x1d, 0x2b, 0x6c, 0x85, 0x3f, 0x7a, 0x11, 0x68, 0x00, 0x1b, 0xfb, 0x35, 0x8e,
+	0xf2, 0x9a, 0xce, 0x3e, 0x95, 0x9a, 0xce, 0xc6, 0xb4, 0xa6, 0x2b, 0x4c, 0x1c, 0xf2, 0x68, 0x24,
+	0xda, 0x4e, 0xec, 0xb6, 0x91, 0x65, 0xa6, 0x59, 0x24, 0xda, 0x4e, 0xfc, 0xa6, 0xc1, 0x23, 0x31,
+	0x86, 0x22, 0x1c, 0x67, 0xdd, 0x4b, 0xfd, 0xeb, 0x37, 0xda, 0x08, 0xfa, 0xdf, 0x24, 0x98, 0x54,
+	0x3d, 0x9e, 0xfa, 0x70, 0xdf, 0xb6, 0x0e, 0x58, 0xfe, 0x8e, 0x95, 0x84, 0x2d, 0xdb, 0x3a, 0x60,
+	0x31, 0x51, 0x78, 0x16, 0x68, 0x09, 0xea, 0xc3, 0x94, 0x27, 0x7d, 0x98, 0x0e, 0x10, 0x66, 0x18,
+	0x5c, 0x01, 0xe9, 0x8e, 0x65, 0xf7, 0x0f, 0x58, 0x62, 0x8f, 0x25, 0xa0, 0xcf, 0x0d, 0xdf, 0x77,
+	0x99, 0x95, 0x5b, 0xc2, 0x0a, 0x67, 0x46, 0x77, 0x34, 0x3a, 0xa2, 0x77, 0x34, 0xfa, 0x2f, 0x7c,
+	0x08, 0x32, 0xa6, 0xe1, 0xee, 0x5b, 0xbc, 0x9b, 0x3c, 0xc7, 0xd2, 0xac, 0xb0, 0x24, 0xa8, 0x51,
+	0x67, 0xcd, 0x86, 0x08, 0x0b, 0x1c, 0x12, 0x30, 0xb6, 0xe3, 0x12, 0xb2, 0xed, 0x99, 0xf9, 0xf4,
+	0xf9, 0xd6, 0x3e, 0xa4, 0xd6, 0x68, 0xff, 0xb5, 0xec, 0x12, 0x52, 0x6a, 0xb2, 0xfe, 0x4b, 0xa8,
+	0xc9, 0xb3, 0x12, 0x63, 0xd6, 0x7f, 0x09, 0x1a, 0x0e, 0x49, 0x50, 0x07, 0x19, 0x9b, 0xf8, 0xdb,
+	0x1e, 0xf7, 0x99, 0x73, 0x66, 0x59, 0x14, 0xb3, 0x64, 0xea, 0xc4, 0xe7, 0x93, 0x08, 0x25, 0xb9,
+	0x7a, 0x3e, 0xa4, 0x53, 0x08, 0x0e, 0x16, 0x0c, 0xf4, 0x4f, 0xa3, 0x20, 0x1b, 0x1e, 0x06, 0x2d,
+	0x8b, 0xce, 0xbe, 0x4d, 0x5c, 0xf5, 0x85, 0x88, 0xe5, 0x42, 0x86, 0x8a, 0xbe, 0x98, 0x87, 0xa2,
+	0x44, 0x10, 0x8e, 0xa4, 0xd4, 0x40, 0xdb, 0x75, 0xfa, 0x3d, 0xf5, 0x75, 0x88, 0x19, 0x60, 0x68,
+	0xcc, 0x80, 0x44, 0x10, 0x8e, 0xa4, 0xf0, 0x63, 0x90, 0xec, 0x5b, 0x26, 0x3b, 0xea, 0x74, 0xe9,
+	0xcd, 0x17, 0x81, 0x96, 0xdc, 0x62, 0x75, 0x94, 0xa2, 0xc3, 0x40, 0x1b, 0xe7, 0xde, 0x61, 0x99,
+	0x4a, 0x02, 0xa0, 0x0c, 0x4c, 0xe5, 0x54, 0xb9, 0x6d, 0x99, 0xf9, 0x54, 0xa4, 0xbc, 0xc2, 0x95,
+	0xdb, 0x8a, 0x72, 0x3b, 0xae, 0xbc, 0x42, 0x95, 0x29, 0xd6, 0x04, 0xe3, 0x72, 0x47, 0xe1, 0x32,
+	0xc8, 0x1c, 0xd0, 0x41, 0xf8, 0x0a, 0x71, 0xf9, 0xc4, 0xb6, 0x47, 0x15, 0x8f, 0xd3, 0xa4, 0xc7,
+	0xb1, 0x21, 0xc2, 0x02, 0x46, 0x2d, 0x90, 0x66, 0xfc, 0x57, 0x6a, 0x64, 0x16, 0x40, 0x7a, 0xcf,
+	0xe8, 0xf4, 0xf9, 0xfe, 0x4d, 0xf2, 0xb7, 0x07, 0x06, 0xc8, 0x59, 0xd8, 0x08, 0x61, 0x8e, 0xa2,
+	0xef, 0x12, 0x60, 0x5c, 0xd6, 0x62, 0x3a, 0x13, 0x4b, 0x54, 0x49, 0xa6, 0xcc, 0x66, 0xda, 0xe5,
+	0x09, 0x8a, 0xcf, 0xb4, 0xcb, 0x32, 0x13, 0xc3, 0x68, 0x9b, 0xe7, 0xec, 0xec, 0x78, 0xc4, 0x67,
+	0xeb, 0x4a, 0xf2, 0x36, 0x8f, 0x23, 0xd2, 0x75, 0xf8, 0x10, 0x61, 0x81, 0xc3, 0x77, 0x45, 0x9b,
+	0x35, 0xca, 0x76, 0xf9, 0xf6, 0xd9, 0x6d, 0x56, 0x98, 0xf7, 0x99, 0x88, 0x5e, 0xb6, 0xf6, 0x89,
+	0xf1, 0x94, 0x27, 0x50, 0x5e, 0x83, 0x58, 0x03, 0x42, 0x41, 0x91, 0x3c, 0x79, 0x19, 0x0c, 0x01,
+	0x84, 0xa5, 0x4c, 0x64, 0x96, 0x27, 0x20, 0xc3, 0xfb, 0x1e, 0xb8, 0x01, 0xb2, 0x2d, 0xa7, 0x6f,
+	0xfb, 0xd1, 0x13, 0xd1, 0xb4, 0x7a, 0x2b, 0x64, 0x92, 0xd2, 0x9f, 0x84, 0x95, 0x36, 0xa4, 0xca,
+	0x68, 0x13, 0x00, 0xbd, 0xce, 0x09, 0x11, 0xfa, 0xc7, 0x04, 0x18, 0x13, 0x8a, 0x70, 0x55, 0x5e,
+	0x92, 0x53, 0xa5, 0x8f, 0x4e, 0xb4, 0x73, 0xdf, 0xff, 0x6c, 0xa4, 0xb6, 0x72, 0xe2, 0x05, 0x29,
+	0x3a, 0xc5, 0xd4, 0x0f, 0x9f, 0xe2, 0xdf, 0xa7, 0xc0, 0x18, 0xa6, 0x5d, 0x97, 0xe7, 0xc3, 0x0f,
+	0xe4, 0x2a, 0xd2, 0xa5, 0xd7, 0xcf, 0x9b, 0x36, 0x72, 0xe2, 0xf0, 0x76, 0x1e, 0x75, 0xed, 0xa3,
+	0x17, 0xee, 0xda, 0x43, 0xc7, 0x4c, 0x5e, 0xc0, 0x31, 0x23, 0x77, 0x49, 0xbd, 0xb2, 0xbb, 0xa4,
+	0x2f, 0xee, 0x2e, 0xa1, 0x07, 0x67, 0x2e, 0xe0, 0xc1, 0x0d, 0x30, 0xb5, 0xe3, 0x3a, 0x5d, 0xf6,
+	0x12, 0xe5, 0xb8, 0xf4, 0xe5, 0x74, 0x2c, 0x2a, 0x64, 0x54, 0xb2, 0x19, 0x0a, 0x64, 0x21, 0x8b,
+	0xa1, 0x08, 0xc7, 0x59, 0x71, 0x5f, 0xcd, 0xbe, 0x9a, 0xaf, 0xc2, 0x07, 0x20, 0xcb, 0x5b, 0x1b,
+	0xdb, 0x61, 0x7d, 0x7b, 0xba, 0xf4, 0x1a, 0xcd, 0xf8, 0x0c, 0xab, 0x3b, 0xd2, 0x07, 0xc5, 0x58,
+	0xfe, 0xec, 0x90, 0x80, 0xfe, 0x27, 0x01, 0xb2, 0x98, 0x78, 0x3d, 0xc7, 0xf6, 0xc8, 0x8f, 0x75,
+	0x82, 0x79, 0x90, 0x32, 0x0d, 0xdf, 0xc8, 0x8f, 0x46, 0xbb, 0x47, 0xc7, 0x72, 0xf7, 0xe8, 0x00,
+	0x61, 0x86, 0xc1, 0x4f, 0x40, 0xaa, 0xe5, 0x98, 0xfc, 0xf0, 0xa7, 0xd4, 0xda, 0x52, 0x75, 0x5d,
+	0xc7, 0x2d, 0x3b, 0xa6, 0xe8, 0x2f, 0x29, 0x49, 0x1a, 0xa0, 0x03, 0x84, 0x19, 0x86, 0xfe, 0x2b,
+	0x01, 0x72, 0x15, 0x67, 0xdf, 0xee, 0x38, 0x86, 0xb9, 0xe1, 0x3a, 0x6d, 0x7a, 0xa7, 0xfd, 0x51,
+	0x97, 0x47, 0x1d, 0x8c, 0xf5, 0xd9, 0xd5, 0x33, 0xbc, 0x3e, 0xde, 0x89, 0xf7, 0xbb, 0x27, 0x27,
+	0xe1, 0xf7, 0xd4, 0xe8, 0x21, 0x4c, 0x28, 0x4b, 0xfb, 0x7c, 0x8c, 0x70, 0x28, 0x40, 0xff, 0x99,
+	0x04, 0x85, 0xf3, 0x0d, 0xc1, 0x2e, 0x98, 0xe0, 0x4c, 0x5d, 0x79, 0x38, 0x9f, 0xbb, 0xc8, 0x1a,
+	0x58, 0x17, 0xce, 0xba, 0xca, 0xbe, 0x1c, 0xcb, 0xae, 0x32, 0x82, 0x10, 0x56, 0xe4, 0xaf, 0xf4,
+	0x8e, 0xa6, 0xdc, 0x05, 0x93, 0x3f, 0xfd, 0x2e, 0xd8, 0x04, 0x97, 0xb8, 0x8b, 0x86, 0xcf, 0xb6,
+	0xf4, 0x6f, 0x25, 0x69, 0xf6, 0x90, 0x31, 0xb9, 0xcd, 0x8b, 0x48, 0xf8, 0x60, 0x3b, 0x1d, 0x39,
+	0x2b, 0x07, 0x43, 0x6f, 0xcb, 0x8d, 0xe0, 0x18, 0x17, 0x2e, 0xc7, 0x5a, 0x7a, 0x1e, 0xea, 0x6f,
+	0x5c, 0xb0, 0x85, 0x57, 0x5a, 0x76, 0x94, 0x01, 0xa9, 0x0d, 0xfa, 0x36, 0xfa, 0x31, 0x48, 0x97,
+	0x3b, 0x8e, 0xc7, 0x32, 0x8e, 0x4b, 0x0c, 0xcf, 0xb1, 0x55, 0x57, 0xe2, 0x88, 0x3c, 0x6a, 0x3e,
+	0x44, 0x58, 0xe0, 0xf3, 0x3f, 0x4f, 0x82, 0x09, 0xe5, 0xef, 0x1c, 0xf0, 0x2f, 0xc1, 0xcd, 0xf5,
+	0x6a, 0xb3, 0xb9, 0xb4, 0x52, 0xd5, 0x37, 0x1f, 0x6f, 0x54, 0xf5, 0xf2, 0xda, 0x56, 0x73, 0xb3,
+	0x8a, 0xf5, 0x72, 0xa3, 0xbe, 0x5c, 0x5b, 0xc9, 0x8d, 0x14, 0x6e, 0x1d, 0x1d, 0x17, 0xf3, 0x8a,
+	0x46, 0xfc, 0x2f, 0x12, 0x7f, 0x06, 0x60, 0x4c, 0xbd, 0x56, 0xaf, 0x54, 0x3f, 0xcf, 0x25, 0x0a,
+	0x57, 0x8f, 0x8e, 0x8b, 0x39, 0x45, 0x8b, 0xbf, 0xe1, 0xfc, 0x05, 0xb8, 0x71, 0x9a, 0xad, 0x6f,
+	0x6d, 0x54, 0x96, 0x36, 0xab, 0xb9, 0xd1, 0x42, 0xe1, 0xe8, 0xb8, 0x38, 0x73, 0x52, 0x49, 0xb8,
+	0xe0, 0x3b, 0xe0, 0x6a, 0x4c, 0x15, 0x57, 0xff, 0x6a, 0xab, 0xda, 0xdc, 0xcc, 0x25, 0x0b, 0x33,
+	0x47, 0xc7, 0x45, 0xa8, 0x68, 0x85, 0x65, 0x62, 0x11, 0x5c, 0x3b, 0xa1, 0xd1, 0xdc, 0x68, 0xd4,
+	0x9b, 0xd5, 0x5c, 0xaa, 0x70, 0xfd, 0xe8, 0xb8, 0x78, 0x25, 0xa6, 0x22, 0xb2, 0x4a, 0x19, 0xcc,
+	0xc6, 0x74, 0x2a, 0x8d, 0xcf, 0xea, 0x6b, 0x8d, 0xa5, 0x8a, 0xbe, 0x81, 0x1b, 0x2b, 0xb8, 0xda,
+	0x6c, 0xe6, 0xd2, 0x05, 0xed, 0xe8, 0xb8, 0x78, 0x53, 0x51, 0x3e, 0x15, 0xe1, 0xf3, 0x60, 0x3a,
+	0x66, 0x64, 0xa3, 0x56, 0x5f, 0xc9, 0x65, 0x0a, 0x57, 0x8e, 0x8e, 0x8b, 0x97, 0x15, 0x3d, 0x7a,
+	0x96, 0xa7, 0xf6, 0xaf, 0xbc, 0xd6, 0x68, 0x56, 0x73, 0x63, 0xa7, 0xf6, 0x8f, 0x1d, 0xf8, 0xfc,
+	0xaf, 0x12, 0x00, 0x9e, 0xfe, 0xd3, 0x12, 0xfc, 0x08, 0xe4, 0x43, 0x23, 0xe5, 0xc6, 0xfa, 0x06,
+	0x5d, 0x67, 0xad, 0x51, 0xd7, 0xeb, 0x8d, 0x7a, 0x35, 0x37, 0x12, 0xdb, 0x55, 0x45, 0xab, 0xee,
+	0xd8, 0xf4, 0x6f, 0x8d, 0xd7, 0xcf, 0xd2, 0x5c, 0x7b, 0xf2, 0x7e, 0x2e, 0x51, 0x58, 0x3c, 0x3a,
+	0x2e, 0x5e, 0x3b, 0xad, 0xb8, 0xf6, 0xe4, 0xfd, 0x6f, 0xff, 0xf9, 0xf5, 0xb3, 0x05, 0xe7, 0x2d,
+	0xe5, 0x49, 0x73, 0xb3, 0x72, 0xe2, 0x80, 0x15, 0xc5, 0x27, 0x9e, 0x6f, 0xce, 0xff, 0x7b, 0x02,
+	0x4c, 0xa8, 0x3f, 0xea, 0x5d, 0x70, 0x55, 0xb5, 0xb0, 0x5e, 0xdd, 0x5c, 0xaa, 0x2c, 0x6d, 0x2e,
+	0xe5, 0x46, 0xf8, 0xe9, 0x29, 0xd4, 0x75, 0xe2, 0x1b, 0x2c, 0x61, 0xbf, 0x05, 0xa6, 0x63, 0xbf,
+	0xbf, 0xfa, 0xa8, 0x8a, 0x43, 0x5f, 0x54, 0x7f, 0x39, 0xd9, 0x23, 0x2e, 0x7c, 0x1b, 0x40, 0x95,
+	0xbc, 0xb4, 0xf6, 0xd9, 0xd2, 0xe3, 0x66, 0x6e, 0xb4, 0x70, 0xed, 0xe8, 0xb8, 0x38, 0xad, 0xb0,
+	0x97, 0x3a, 0xfb, 0xc6, 0xa1, 0x37, 0xff, 0xdf, 0x09, 0x70, 0x35, 0x86, 0xb6, 0x1d, 0xd7, 0xf2,
+	0x77, 0xbb, 0x70, 0x0b, 0xdc, 0x88, 0xdb, 0x59, 0x69, 0xe0, 0xda, 0xe6, 0xea, 0x3a, 0xdb, 0xc4,
+	0x91, 0xc2, 0x87, 0x47, 0xc7, 0xc5, 0xeb, 0x67, 0x29, 0xf2, 0x6d, 0x3c, 0x4f, 0x04, 0xef, 0x83,
+	0xc2, 0xd9, 0x66, 0xd9, 0x56, 0x26, 0x78, 0x58, 0x9e, 0xa5, 0xcc, 0x36, 0xf3, 0xff, 0x47, 0xc1,
+	0xa4, 0xfa, 0x10, 0x02, 0xdf, 0x06, 0x57, 0x96, 0x6b, 0x6b, 0x34, 0xe2, 0x96, 0x1b, 0xdc, 0xd3,
+	0xe8, 0x30, 0x37, 0xc2, 0x37, 0x47, 0xa5, 0xd2, 0x6f, 0xf8, 0xe7, 0x20, 0x7f, 0x82, 0x5e, 0xa9,
+	0xe1, 0x6a, 0x79, 0xb3, 0x81, 0x1f, 0xe7, 0x12, 0x85, 0x1b, 0xd4, 0x31, 0x54, 0x9d, 0x8a, 0xe5,
+	0xb2, 0x54, 0x7b, 0x08, 0x1f, 0x80, 0x9b, 0x27, 0x14, 0x9b, 0x8f, 0xd7, 0xd7, 0x6a, 0xf5, 0x87,
+	0x7c, 0xbe, 0xd1, 0xc2, 0x6d, 0xba, 0x1f, 0xaa, 0x6e, 0x93, 0xbf, 0x59, 0x51, 0x28, 0x9b, 0x80,
+	0xab, 0xa0, 0x78, 0x8e, 0x7e, 0xb4, 0x80, 0x64, 0x01, 0x1d, 0x1d, 0x17, 0x6f, 0x9d, 0x61, 0x44,
+	0xae, 0x23, 0x9b, 0x80, 0xef, 0x81, 0x99, 0xb3, 0x2d, 0x85, 0xf1, 0x7f, 0x86, 0xfe, 0xfc, 0x2f,
+	0x12, 0x60, 0x5c, 0x56, 0x77, 0xba, 0x69, 0x55, 0x8c, 0x1b, 0x34, 0x19, 0x56, 0xaa, 0x7a, 0xbd,
+	0xa1, 0xb3, 0x51, 0xb8, 0x69, 0x92, 0x57, 0x77, 0xd8, 0x27, 0x8d, 0x65, 0x85, 0xbe, 0x52, 0xad,
+	0x57, 0x71, 0xad, 0x1c, 0xfa, 0x9f, 0x64, 0xaf, 0x10, 0x9b, 0xb8, 0x56, 0x0b, 0xbe, 0x0f, 0xae,
+	0xc7, 0x8d, 0x37, 0xb7, 0xca, 0xab, 0xe1, 0x2e, 0xb1, 0x05, 0x2a, 0x13, 0x34, 0xfb, 0xad, 0x5d,
+	0x76, 0x30, 0x1f, 0xc4, 0xb4, 0x6a, 0xf5, 0x47, 0x4b, 0x6b, 0xb5, 0x0a, 0xd7, 0x4a, 0x16, 0xf2,
+	0x47, 0xc7, 0xc5, 0xab, 0x52, 0x4b, 0x3c, 0x6b, 0x50, 0xb5, 0xf9, 0x6f, 0x13, 0x60, 0xf6, 0xfb,
+	0x8b, 0x34, 0xfc, 0x0c, 0xbc, 0xc9, 0xf6, 0xeb, 0x54, 0xca, 0x13, 0xf9, 0x99, 0xef, 0xe1, 0xd2,
+	0xc6, 0x46, 0xb5, 0x5e, 0xc9, 0x8d, 0x14, 0xe6, 0x8e, 0x8e, 0x8b, 0x77, 0xbe, 0xdf, 0xe4, 0x52,
+	0xaf, 0x47, 0x6c, 0xf3, 0x82, 0x86, 0x97, 0x1b, 0x78, 0xa5, 0xba, 0x99, 0x4b, 0x5c, 0xc4, 0xf0,
+	0xb2, 0x43, 0xdf, 0x37, 0x4b, 0xeb, 0xcf, 0xbe, 0x9b, 0x1d, 0x79, 0xfe, 0xdd, 0xec, 0xc8, 0xb3,
+	0x17, 0xb3, 0x89, 0xe7, 0x2f, 0x66, 0x13, 0xff, 0xf2, 0x72, 0x76, 0xe4, 0x9b, 0x97, 0xb3, 0x89,
+	0xe7, 0x2f, 0x67, 0x47, 0x7e, 0xf9, 0x72, 0x76, 0xe4, 0xc9, 0x5b, 0x6d, 0xcb, 0xdf, 0xed, 0x6f,
+	0x2f, 0xb4, 0x9c, 0xee, 0x5d, 0xef, 0xd0, 0x6e, 0xf9, 0xbb, 0x96, 0xdd, 0x56, 0xbe, 0xd4, 0xff,
+	0x4f, 0xb2, 0x9d, 0x61, 0x5f, 0xef, 0xfd, 0x61, 0x00, 0xda, 0xaf, 0xcc, 0xc3, 0x66, 0x22, 0x00,
+	0x00,
 }
 
 func (m *Hello) Marshal() (dAtA []byte, err error) {
@@ -1120,6 +1361,39 @@ func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Secondary {
+		i--
+		if m.Secondary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumConnections != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.NumConnections))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Compressions) > 0 {
+		dAtA2 := make([]byte, len(m.Compressions)*10)
+		var j1 int
+		for _, num := range m.Compressions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBep(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ClientVersion) > 0 {
 		i -= len(m.ClientVersion)
 		copy(dAtA[i:], m.ClientVersion)
@@ -1250,6 +1524,25 @@ func (m *Folder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x82
 		}
 	}
+	if m.ContentDefinedChunking {
+		i--
+		if m.ContentDefinedChunking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SelectedPaths) > 0 {
+		for iNdEx := len(m.SelectedPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SelectedPaths[iNdEx])
+			copy(dAtA[i:], m.SelectedPaths[iNdEx])
+			i = encodeVarintBep(dAtA, i, uint64(len(m.SelectedPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -1337,6 +1630,15 @@ func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ObservedAddresses) > 0 {
+		for iNdEx := len(m.ObservedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObservedAddresses[iNdEx])
+			copy(dAtA[i:], m.ObservedAddresses[iNdEx])
+			i = encodeVarintBep(dAtA, i, uint64(len(m.ObservedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.EncryptionPasswordToken) > 0 {
 		i -= len(m.EncryptionPasswordToken)
 		copy(dAtA[i:], m.EncryptionPasswordToken)
@@ -1582,6 +1884,16 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x82
 		}
 	}
+	{
+		size, err := m.Platform.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBep(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if m.RawBlockSize != 0 {
 		i = encodeVarintBep(dAtA, i, uint64(m.RawBlockSize))
 		i--
@@ -1672,7 +1984,7 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
+func (m *PlatformData) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1682,42 +1994,127 @@ func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *PlatformData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PlatformData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.WeakHash != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.WeakHash))
+	if m.NetBSD != nil {
+		{
+			size, err := m.NetBSD.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBep(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x32
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintBep(dAtA, i, uint64(len(m.Hash)))
+	if m.FreeBSD != nil {
+		{
+			size, err := m.FreeBSD.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBep(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Darwin != nil {
+		{
+			size, err := m.Darwin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBep(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Linux != nil {
+		{
+			size, err := m.Linux.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBep(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Size != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.Size))
+	if m.Unix != nil {
+		{
+			size, err := m.Unix.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBep(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
 	}
-	if m.Offset != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.Offset))
+	return len(dAtA) - i, nil
+}
+
+func (m *UnixData) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnixData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnixData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GID != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.GID))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x20
+	}
+	if m.UID != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.UID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerName) > 0 {
+		i -= len(m.OwnerName)
+		copy(dAtA[i:], m.OwnerName)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.OwnerName)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Vector) Marshal() (dAtA []byte, err error) {
+func (m *XattrData) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1727,20 +2124,20 @@ func (m *Vector) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vector) MarshalTo(dAtA []byte) (int, error) {
+func (m *XattrData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *XattrData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Counters) > 0 {
-		for iNdEx := len(m.Counters) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Xattrs) > 0 {
+		for iNdEx := len(m.Xattrs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Counters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Xattrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1754,7 +2151,7 @@ func (m *Vector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Counter) Marshal() (dAtA []byte, err error) {
+func (m *Xattr) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1764,30 +2161,34 @@ func (m *Counter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
+func (m *Xattr) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Xattr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.Value))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ID != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.ID))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
+func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1797,18 +2198,133 @@ func (m *Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockNo != 0 {
-		i = encodeVarintBep(dAtA, i, uint64(m.BlockNo))
+	if m.WeakHash != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.WeakHash))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Size != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vector) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counters) > 0 {
+		for iNdEx := len(m.Counters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBep(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Counter) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNo != 0 {
+		i = encodeVarintBep(dAtA, i, uint64(m.BlockNo))
 		i--
 		dAtA[i] = 0x48
 	}
@@ -2089,6 +2605,19 @@ func (m *Hello) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBep(uint64(l))
 	}
+	if len(m.Compressions) > 0 {
+		l = 0
+		for _, e := range m.Compressions {
+			l += sovBep(uint64(e))
+		}
+		n += 1 + sovBep(uint64(l)) + l
+	}
+	if m.NumConnections != 0 {
+		n += 1 + sovBep(uint64(m.NumConnections))
+	}
+	if m.Secondary {
+		n += 2
+	}
 	return n
 }
 
@@ -2151,6 +2680,15 @@ func (m *Folder) ProtoSize() (n int) {
 	if m.Paused {
 		n += 2
 	}
+	if len(m.SelectedPaths) > 0 {
+		for _, s := range m.SelectedPaths {
+			l = len(s)
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	if m.ContentDefinedChunking {
+		n += 2
+	}
 	if len(m.Devices) > 0 {
 		for _, e := range m.Devices {
 			l = e.ProtoSize()
@@ -2201,6 +2739,12 @@ func (m *Device) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBep(uint64(l))
 	}
+	if len(m.ObservedAddresses) > 0 {
+		for _, s := range m.ObservedAddresses {
+			l = len(s)
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2287,6 +2831,8 @@ func (m *FileInfo) ProtoSize() (n int) {
 	if m.RawBlockSize != 0 {
 		n += 1 + sovBep(uint64(m.RawBlockSize))
 	}
+	l = m.Platform.ProtoSize()
+	n += 1 + l + sovBep(uint64(l))
 	if len(m.Blocks) > 0 {
 		for _, e := range m.Blocks {
 			l = e.ProtoSize()
@@ -2315,6 +2861,90 @@ func (m *FileInfo) ProtoSize() (n int) {
 	return n
 }
 
+func (m *PlatformData) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unix != nil {
+		l = m.Unix.ProtoSize()
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.Linux != nil {
+		l = m.Linux.ProtoSize()
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.Darwin != nil {
+		l = m.Darwin.ProtoSize()
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.FreeBSD != nil {
+		l = m.FreeBSD.ProtoSize()
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.NetBSD != nil {
+		l = m.NetBSD.ProtoSize()
+		n += 1 + l + sovBep(uint64(l))
+	}
+	return n
+}
+
+func (m *UnixData) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerName)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	if m.UID != 0 {
+		n += 1 + sovBep(uint64(m.UID))
+	}
+	if m.GID != 0 {
+		n += 1 + sovBep(uint64(m.GID))
+	}
+	return n
+}
+
+func (m *XattrData) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Xattrs) > 0 {
+		for _, e := range m.Xattrs {
+			l = e.ProtoSize()
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Xattr) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	return n
+}
+
 func (m *BlockInfo) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2623,11 +3253,119 @@ func (m *Hello) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBep(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType == 0 {
+				var v MessageCompression
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBep
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= MessageCompression(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Compressions = append(m.Compressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBep
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBep
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBep
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Compressions) == 0 {
+					m.Compressions = make([]MessageCompression, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v MessageCompression
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBep
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= MessageCompression(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Compressions = append(m.Compressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressions", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumConnections", wireType)
+			}
+			m.NumConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumConnections |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secondary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Secondary = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBep
@@ -3009,6 +3747,58 @@ func (m *Folder) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectedPaths = append(m.SelectedPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentDefinedChunking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContentDefinedChunking = bool(v != 0)
 		case 16:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
@@ -3353,6 +4143,38 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				m.EncryptionPasswordToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedAddresses = append(m.ObservedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBep(dAtA[iNdEx:])
@@ -3912,6 +4734,39 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Platform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 16:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
@@ -4120,6 +4975,588 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PlatformData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlatformData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlatformData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unix == nil {
+				m.Unix = &UnixData{}
+			}
+			if err := m.Unix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Linux", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Linux == nil {
+				m.Linux = &XattrData{}
+			}
+			if err := m.Linux.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Darwin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Darwin == nil {
+				m.Darwin = &XattrData{}
+			}
+			if err := m.Darwin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBSD", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FreeBSD == nil {
+				m.FreeBSD = &XattrData{}
+			}
+			if err := m.FreeBSD.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetBSD", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetBSD == nil {
+				m.NetBSD = &XattrData{}
+			}
+			if err := m.NetBSD.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnixData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnixData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnixData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			m.UID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UID |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GID", wireType)
+			}
+			m.GID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GID |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XattrData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XattrData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XattrData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xattrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Xattrs = append(m.Xattrs, Xattr{})
+			if err := m.Xattrs[len(m.Xattrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Xattr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Xattr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Xattr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
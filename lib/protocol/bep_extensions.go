@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/rand"
@@ -128,6 +129,30 @@ func (f FileInfo) BlockSize() int {
 	return f.RawBlockSize
 }
 
+// HasVariableBlocks returns true if the blocks of the file differ in size,
+// that is they weren't cut at multiples of the block size but based on the
+// content.
+func (f FileInfo) HasVariableBlocks() bool {
+	blockSize := f.BlockSize()
+	for i := 0; i < len(f.Blocks)-1; i++ {
+		if f.Blocks[i].Size != blockSize {
+			return true
+		}
+	}
+	return false
+}
+
+// BlockIndex returns the index of the block at the given offset.
+func (f FileInfo) BlockIndex(offset int64) int {
+	i := int(offset / int64(f.BlockSize()))
+	if len(f.Blocks) == 0 || (i < len(f.Blocks) && f.Blocks[i].Offset == offset) {
+		return i
+	}
+	return sort.Search(len(f.Blocks), func(i int) bool {
+		return f.Blocks[i].Offset >= offset
+	})
+}
+
 func (f FileInfo) FileName() string {
 	return f.Name
 }
@@ -191,21 +216,35 @@ func WinsConflict(f, other FileIntf) bool {
 }
 
 func (f FileInfo) IsEquivalent(other FileInfo, modTimeWindow time.Duration) bool {
-	return f.isEquivalent(other, modTimeWindow, false, false, 0)
+	return f.isEquivalent(other, FileInfoComparison{ModTimeWindow: modTimeWindow})
+}
+
+func (f FileInfo) IsEquivalentOptional(other FileInfo, comp FileInfoComparison) bool {
+	return f.isEquivalent(other, comp)
 }
 
-func (f FileInfo) IsEquivalentOptional(other FileInfo, modTimeWindow time.Duration, ignorePerms bool, ignoreBlocks bool, ignoreFlags uint32) bool {
-	return f.isEquivalent(other, modTimeWindow, ignorePerms, ignoreBlocks, ignoreFlags)
+// FileInfoComparison selects which parts of two file infos are compared by
+// IsEquivalentOptional.
+type FileInfoComparison struct {
+	ModTimeWindow   time.Duration
+	IgnorePerms     bool
+	IgnoreBlocks    bool
+	IgnoreFlags     uint32
+	IgnoreOwnership bool
+	IgnoreXattrs    bool
 }
 
 // isEquivalent checks that the two file infos represent the same actual file content,
 // i.e. it does purposely not check only selected (see below) struct members.
-// Permissions (config) and blocks (scanning) can be excluded from the comparison.
+// Permissions, ownership and extended attributes (config) as well as
+// blocks (scanning) can be excluded from the comparison.
 // Any file info is not "equivalent", if it has different
 //  - type
 //  - deleted flag
 //  - invalid flag
 //  - permissions, unless they are ignored
+//  - ownership, unless it is ignored or unknown on either side
+//  - extended attributes, unless they are ignored
 // A file is not "equivalent", if it has different
 //  - modification time (difference bigger than modTimeWindow)
 //  - size
@@ -213,7 +252,7 @@ func (f FileInfo) IsEquivalentOptional(other FileInfo, modTimeWindow time.Durati
 // A symlink is not "equivalent", if it has different
 //  - target
 // A directory does not have anything specific to check.
-func (f FileInfo) isEquivalent(other FileInfo, modTimeWindow time.Duration, ignorePerms bool, ignoreBlocks bool, ignoreFlags uint32) bool {
+func (f FileInfo) isEquivalent(other FileInfo, comp FileInfoComparison) bool {
 	if f.MustRescan() || other.MustRescan() {
 		// These are per definition not equivalent because they don't
 		// represent a valid state, even if both happen to have the
@@ -222,20 +261,28 @@ func (f FileInfo) isEquivalent(other FileInfo, modTimeWindow time.Duration, igno
 	}
 
 	// Mask out the ignored local flags before checking IsInvalid() below
-	f.LocalFlags &^= ignoreFlags
-	other.LocalFlags &^= ignoreFlags
+	f.LocalFlags &^= comp.IgnoreFlags
+	other.LocalFlags &^= comp.IgnoreFlags
 
 	if f.Name != other.Name || f.Type != other.Type || f.Deleted != other.Deleted || f.IsInvalid() != other.IsInvalid() {
 		return false
 	}
 
-	if !ignorePerms && !f.NoPermissions && !other.NoPermissions && !PermsEqual(f.Permissions, other.Permissions) {
+	if !comp.IgnorePerms && !f.NoPermissions && !other.NoPermissions && !PermsEqual(f.Permissions, other.Permissions) {
+		return false
+	}
+
+	if !comp.IgnoreOwnership && !unixOwnershipEqual(f.Platform.Unix, other.Platform.Unix) {
+		return false
+	}
+
+	if !comp.IgnoreXattrs && !xattrsEqual(f.Platform, other.Platform) {
 		return false
 	}
 
 	switch f.Type {
 	case FileInfoTypeFile:
-		return f.Size == other.Size && ModTimeEqual(f.ModTime(), other.ModTime(), modTimeWindow) && (ignoreBlocks || f.BlocksEqual(other))
+		return f.Size == other.Size && ModTimeEqual(f.ModTime(), other.ModTime(), comp.ModTimeWindow) && (comp.IgnoreBlocks || f.BlocksEqual(other))
 	case FileInfoTypeSymlink:
 		return f.SymlinkTarget == other.SymlinkTarget
 	case FileInfoTypeDirectory:
@@ -297,6 +344,108 @@ func blocksEqual(a, b []BlockInfo) bool {
 	return true
 }
 
+// Xattrs returns the extended attributes for the current platform, if
+// any.
+func (p *PlatformData) Xattrs() []Xattr {
+	if xd := p.xattrData(runtime.GOOS); xd != nil {
+		return xd.Xattrs
+	}
+	return nil
+}
+
+// SetXattrs sets the extended attributes for the current platform. It's a
+// no-op on platforms where extended attributes are not supported.
+func (p *PlatformData) SetXattrs(xattrs []Xattr) {
+	xd := &XattrData{Xattrs: xattrs}
+	switch runtime.GOOS {
+	case "linux":
+		p.Linux = xd
+	case "darwin":
+		p.Darwin = xd
+	case "freebsd":
+		p.FreeBSD = xd
+	case "netbsd":
+		p.NetBSD = xd
+	}
+}
+
+// MergeWith copies over the platform data from other for all platforms
+// that are not already set on p.
+func (p *PlatformData) MergeWith(other *PlatformData) {
+	if p.Unix == nil {
+		p.Unix = other.Unix
+	}
+	if p.Linux == nil {
+		p.Linux = other.Linux
+	}
+	if p.Darwin == nil {
+		p.Darwin = other.Darwin
+	}
+	if p.FreeBSD == nil {
+		p.FreeBSD = other.FreeBSD
+	}
+	if p.NetBSD == nil {
+		p.NetBSD = other.NetBSD
+	}
+}
+
+func (p *PlatformData) xattrData(goos string) *XattrData {
+	switch goos {
+	case "linux":
+		return p.Linux
+	case "darwin":
+		return p.Darwin
+	case "freebsd":
+		return p.FreeBSD
+	case "netbsd":
+		return p.NetBSD
+	}
+	return nil
+}
+
+// unixOwnershipEqual returns whether the two sets of ownership data refer to
+// the same owner and group, either by ID or by name. Ownership missing on
+// either side is not a difference, as it merely means the other device
+// didn't tell us about it.
+func unixOwnershipEqual(a, b *UnixData) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	ownerEqual := a.UID == b.UID || (a.OwnerName != "" && a.OwnerName == b.OwnerName)
+	groupEqual := a.GID == b.GID || (a.GroupName != "" && a.GroupName == b.GroupName)
+	return ownerEqual && groupEqual
+}
+
+// xattrsEqual returns whether the two platform data sets contain the same
+// extended attributes, for every platform.
+func xattrsEqual(a, b PlatformData) bool {
+	for _, goos := range []string{"linux", "darwin", "freebsd", "netbsd"} {
+		if !a.xattrData(goos).equal(b.xattrData(goos)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *XattrData) equal(other *XattrData) bool {
+	var a, b []Xattr
+	if x != nil {
+		a = x.Xattrs
+	}
+	if other != nil {
+		b = other.Xattrs
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || !bytes.Equal(a[i].Value, b[i].Value) {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *FileInfo) SetMustRescan() {
 	f.setLocalFlags(FlagLocalMustRescan)
 }
@@ -37,3 +37,50 @@ func (c *Compression) UnmarshalText(bs []byte) error {
 	*c = compressionUnmarshal[string(bs)]
 	return nil
 }
+
+var compressionAlgorithmMarshal = map[CompressionAlgorithm]string{
+	CompressionAlgorithmLZ4:  "lz4",
+	CompressionAlgorithmZstd: "zstd",
+}
+
+var compressionAlgorithmUnmarshal = map[string]CompressionAlgorithm{
+	"lz4":  CompressionAlgorithmLZ4,
+	"zstd": CompressionAlgorithmZstd,
+}
+
+func (a CompressionAlgorithm) GoString() string {
+	return fmt.Sprintf("%q", a.String())
+}
+
+func (a CompressionAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(compressionAlgorithmMarshal[a]), nil
+}
+
+func (a *CompressionAlgorithm) UnmarshalText(bs []byte) error {
+	*a = compressionAlgorithmUnmarshal[string(bs)]
+	return nil
+}
+
+// supportedCompressions are the message compressions we can decompress, as
+// advertised in our Hello.
+var supportedCompressions = []MessageCompression{MessageCompressionLZ4, MessageCompressionZstd}
+
+// SupportedCompressions returns the message compressions to advertise in
+// the Hello message.
+func SupportedCompressions() []MessageCompression {
+	return append([]MessageCompression(nil), supportedCompressions...)
+}
+
+// NegotiateCompression returns the compression to use for messages sent to
+// a peer, given the algorithm configured for it and its Hello. Peers that
+// don't advertise their supported compressions only support LZ4.
+func NegotiateCompression(algo CompressionAlgorithm, remote Hello) MessageCompression {
+	if algo == CompressionAlgorithmZstd {
+		for _, c := range remote.Compressions {
+			if c == MessageCompressionZstd {
+				return MessageCompressionZstd
+			}
+		}
+	}
+	return MessageCompressionLZ4
+}
@@ -47,3 +47,40 @@ func TestCompressionMarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestCompressionAlgorithmMarshal(t *testing.T) {
+	for _, a := range []CompressionAlgorithm{CompressionAlgorithmLZ4, CompressionAlgorithmZstd} {
+		bs, err := a.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got CompressionAlgorithm
+		if err := got.UnmarshalText(bs); err != nil {
+			t.Fatal(err)
+		}
+		if got != a {
+			t.Errorf("%v round tripped through %q to %v", a, bs, got)
+		}
+	}
+}
+
+func TestNegotiateCompression(t *testing.T) {
+	zstdPeer := Hello{Compressions: SupportedCompressions()}
+	legacyPeer := Hello{}
+
+	cases := []struct {
+		algo   CompressionAlgorithm
+		remote Hello
+		exp    MessageCompression
+	}{
+		{CompressionAlgorithmLZ4, zstdPeer, MessageCompressionLZ4},
+		{CompressionAlgorithmLZ4, legacyPeer, MessageCompressionLZ4},
+		{CompressionAlgorithmZstd, zstdPeer, MessageCompressionZstd},
+		{CompressionAlgorithmZstd, legacyPeer, MessageCompressionLZ4},
+	}
+	for _, tc := range cases {
+		if got := NegotiateCompression(tc.algo, tc.remote); got != tc.exp {
+			t.Errorf("%v with %v: got %v, expected %v", tc.algo, tc.remote.Compressions, got, tc.exp)
+		}
+	}
+}
@@ -228,6 +228,10 @@ func (e encryptedConnection) Closed() <-chan struct{} {
 	return e.conn.Closed()
 }
 
+func (e encryptedConnection) AddStream(reader io.Reader, writer io.Writer, closer io.Closer, connInfo ConnectionInfo) error {
+	return e.conn.AddStream(reader, writer, closer, connInfo)
+}
+
 func (e encryptedConnection) Statistics() Statistics {
 	return e.conn.Statistics()
 }
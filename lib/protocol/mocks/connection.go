@@ -3,6 +3,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"net"
 	"sync"
 	"time"
@@ -11,6 +12,20 @@ import (
 )
 
 type Connection struct {
+	AddStreamStub        func(io.Reader, io.Writer, io.Closer, protocol.ConnectionInfo) error
+	addStreamMutex       sync.RWMutex
+	addStreamArgsForCall []struct {
+		arg1 io.Reader
+		arg2 io.Writer
+		arg3 io.Closer
+		arg4 protocol.ConnectionInfo
+	}
+	addStreamReturns struct {
+		result1 error
+	}
+	addStreamReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CloseStub        func(error)
 	closeMutex       sync.RWMutex
 	closeArgsForCall []struct {
@@ -188,6 +203,70 @@ type Connection struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *Connection) AddStream(arg1 io.Reader, arg2 io.Writer, arg3 io.Closer, arg4 protocol.ConnectionInfo) error {
+	fake.addStreamMutex.Lock()
+	ret, specificReturn := fake.addStreamReturnsOnCall[len(fake.addStreamArgsForCall)]
+	fake.addStreamArgsForCall = append(fake.addStreamArgsForCall, struct {
+		arg1 io.Reader
+		arg2 io.Writer
+		arg3 io.Closer
+		arg4 protocol.ConnectionInfo
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddStreamStub
+	fakeReturns := fake.addStreamReturns
+	fake.recordInvocation("AddStream", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Connection) AddStreamCallCount() int {
+	fake.addStreamMutex.RLock()
+	defer fake.addStreamMutex.RUnlock()
+	return len(fake.addStreamArgsForCall)
+}
+
+func (fake *Connection) AddStreamCalls(stub func(io.Reader, io.Writer, io.Closer, protocol.ConnectionInfo) error) {
+	fake.addStreamMutex.Lock()
+	defer fake.addStreamMutex.Unlock()
+	fake.AddStreamStub = stub
+}
+
+func (fake *Connection) AddStreamArgsForCall(i int) (io.Reader, io.Writer, io.Closer, protocol.ConnectionInfo) {
+	fake.addStreamMutex.RLock()
+	defer fake.addStreamMutex.RUnlock()
+	argsForCall := fake.addStreamArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *Connection) AddStreamReturns(result1 error) {
+	fake.addStreamMutex.Lock()
+	defer fake.addStreamMutex.Unlock()
+	fake.AddStreamStub = nil
+	fake.addStreamReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *Connection) AddStreamReturnsOnCall(i int, result1 error) {
+	fake.addStreamMutex.Lock()
+	defer fake.addStreamMutex.Unlock()
+	fake.AddStreamStub = nil
+	if fake.addStreamReturnsOnCall == nil {
+		fake.addStreamReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addStreamReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *Connection) Close(arg1 error) {
 	fake.closeMutex.Lock()
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
@@ -1093,6 +1172,8 @@ func (fake *Connection) TypeReturnsOnCall(i int, result1 string) {
 func (fake *Connection) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addStreamMutex.RLock()
+	defer fake.addStreamMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
 	fake.closedMutex.RLock()
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	lz4 "github.com/pierrec/lz4/v4"
 	"github.com/pkg/errors"
 )
@@ -121,6 +122,7 @@ var (
 	errDeletedHasBlocks   = errors.New("deleted file with non-empty block list")
 	errDirectoryHasBlocks = errors.New("directory with non-empty block list")
 	errFileHasNoBlocks    = errors.New("file with empty block list")
+	errSecondaryMessage   = errors.New("message not allowed on secondary connection")
 )
 
 type Model interface {
@@ -154,6 +156,7 @@ type Connection interface {
 	Request(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, weakHash uint32, fromTemporary bool) ([]byte, error)
 	ClusterConfig(config ClusterConfig)
 	DownloadProgress(ctx context.Context, folder string, updates []FileDownloadProgressUpdate)
+	AddStream(reader io.Reader, writer io.Writer, closer io.Closer, connInfo ConnectionInfo) error
 	Statistics() Statistics
 	Closed() <-chan struct{}
 	ConnectionInfo
@@ -195,6 +198,14 @@ type rawConnection struct {
 	closeOnce             sync.Once
 	sendCloseOnce         sync.Once
 	compression           Compression
+	compressionAlgo       MessageCompression
+
+	// A secondary connection carries only requests and responses, on
+	// behalf of the primary connection that it was added to.
+	secondary  bool
+	streamsMut sync.Mutex // Protects streams and nextStream.
+	streams    []*rawConnection
+	nextStream int
 
 	loopWG sync.WaitGroup // Need to ensure no leftover routines in testing
 }
@@ -230,7 +241,7 @@ const (
 // Should not be modified in production code, just for testing.
 var CloseTimeout = 10 * time.Second
 
-func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression, passwords map[string]string) Connection {
+func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression, algo MessageCompression, passwords map[string]string) Connection {
 	// Encryption / decryption is first (outermost) before conversion to
 	// native path formats.
 	nm := makeNative(receiver)
@@ -238,14 +249,14 @@ func NewConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer
 
 	// We do the wire format conversion first (outermost) so that the
 	// metadata is in wire format when it reaches the encryption step.
-	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress)
+	rc := newRawConnection(deviceID, reader, writer, closer, em, connInfo, compress, algo)
 	ec := encryptedConnection{ConnectionInfo: rc, conn: rc, folderKeys: em.folderKeys}
 	wc := wireFormatConnection{ec}
 
 	return wc
 }
 
-func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression) *rawConnection {
+func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, closer io.Closer, receiver Model, connInfo ConnectionInfo, compress Compression, algo MessageCompression) *rawConnection {
 	cr := &countingReader{Reader: reader}
 	cw := &countingWriter{Writer: writer}
 
@@ -264,6 +275,7 @@ func newRawConnection(deviceID DeviceID, reader io.Reader, writer io.Writer, clo
 		dispatcherLoopStopped: make(chan struct{}),
 		closed:                make(chan struct{}),
 		compression:           compress,
+		compressionAlgo:       algo,
 		loopWG:                sync.WaitGroup{},
 	}
 }
@@ -334,6 +346,32 @@ func (c *rawConnection) IndexUpdate(ctx context.Context, folder string, idx []Fi
 
 // Request returns the bytes for the specified block after fetching them from the connected peer.
 func (c *rawConnection) Request(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, weakHash uint32, fromTemporary bool) ([]byte, error) {
+	return c.nextRequestStream().request(ctx, folder, name, blockNo, offset, size, hash, weakHash, fromTemporary)
+}
+
+// nextRequestStream returns the connection to send the next request on,
+// going round robin over the primary and the open secondary connections.
+func (c *rawConnection) nextRequestStream() *rawConnection {
+	c.streamsMut.Lock()
+	defer c.streamsMut.Unlock()
+	for range c.streams {
+		c.nextStream = (c.nextStream + 1) % (len(c.streams) + 1)
+		if c.nextStream == 0 {
+			break
+		}
+		s := c.streams[c.nextStream-1]
+		select {
+		case <-s.closed:
+			continue
+		default:
+			return s
+		}
+	}
+	c.nextStream = 0
+	return c
+}
+
+func (c *rawConnection) request(ctx context.Context, folder string, name string, blockNo int, offset int64, size int, hash []byte, weakHash uint32, fromTemporary bool) ([]byte, error) {
 	rc := make(chan asyncResult, 1)
 
 	c.awaitingMut.Lock()
@@ -384,6 +422,42 @@ func (c *rawConnection) Closed() <-chan struct{} {
 	return c.closed
 }
 
+// AddStream adds a secondary connection to the same device. Requests are
+// spread over the primary and all secondary connections, while indexes and
+// cluster configs are only sent on the primary one. Secondary connections
+// are closed together with the primary.
+func (c *rawConnection) AddStream(reader io.Reader, writer io.Writer, closer io.Closer, connInfo ConnectionInfo) error {
+	select {
+	case <-c.closed:
+		return ErrClosed
+	default:
+	}
+
+	s := newRawConnection(c.id, reader, writer, closer, c.receiver, connInfo, c.compression, c.compressionAlgo)
+	s.secondary = true
+	s.Start()
+	c.streamsMut.Lock()
+	c.streams = append(c.streams, s)
+	c.streamsMut.Unlock()
+
+	go func() {
+		select {
+		case <-s.closed:
+		case <-c.closed:
+			s.internalClose(ErrClosed)
+		}
+		c.streamsMut.Lock()
+		for i := range c.streams {
+			if c.streams[i] == s {
+				c.streams = append(c.streams[:i], c.streams[i+1:]...)
+				break
+			}
+		}
+		c.streamsMut.Unlock()
+	}()
+	return nil
+}
+
 // DownloadProgress sends the progress updates for the files that are currently being downloaded.
 func (c *rawConnection) DownloadProgress(ctx context.Context, folder string, updates []FileDownloadProgressUpdate) {
 	c.send(ctx, &DownloadProgress{
@@ -421,6 +495,10 @@ func (c *rawConnection) dispatcherLoop() (err error) {
 	defer close(c.dispatcherLoopStopped)
 	var msg message
 	state := stateInitial
+	if c.secondary {
+		// There is no cluster config on secondary connections.
+		state = stateReady
+	}
 	for {
 		select {
 		case msg = <-c.inbox:
@@ -434,6 +512,13 @@ func (c *rawConnection) dispatcherLoop() (err error) {
 		}
 		l.Debugf("handle %v message", msgContext)
 
+		if c.secondary {
+			switch msg.(type) {
+			case *ClusterConfig, *Index, *IndexUpdate, *DownloadProgress:
+				return newProtocolError(errSecondaryMessage, msgContext)
+			}
+		}
+
 		switch msg := msg.(type) {
 		case *ClusterConfig:
 			if state == stateInitial {
@@ -530,6 +615,14 @@ func (c *rawConnection) readMessageAfterHeader(hdr Header, fourByteBuf []byte) (
 		}
 		buf = decomp
 
+	case MessageCompressionZstd:
+		decomp, err := zstdDecompress(buf)
+		BufferPool.Put(buf)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompressing message")
+		}
+		buf = decomp
+
 	default:
 		return nil, fmt.Errorf("unknown message compression %d", hdr.Compression)
 	}
@@ -694,19 +787,21 @@ func (c *rawConnection) send(ctx context.Context, msg message, done chan struct{
 }
 
 func (c *rawConnection) writerLoop() {
-	select {
-	case cc := <-c.clusterConfigBox:
-		err := c.writeMessage(cc)
-		if err != nil {
-			c.internalClose(err)
+	if !c.secondary {
+		select {
+		case cc := <-c.clusterConfigBox:
+			err := c.writeMessage(cc)
+			if err != nil {
+				c.internalClose(err)
+				return
+			}
+		case hm := <-c.closeBox:
+			_ = c.writeMessage(hm.msg)
+			close(hm.done)
+			return
+		case <-c.closed:
 			return
 		}
-	case hm := <-c.closeBox:
-		_ = c.writeMessage(hm.msg)
-		close(hm.done)
-		return
-	case <-c.closed:
-		return
 	}
 	for {
 		select {
@@ -792,7 +887,7 @@ func (c *rawConnection) writeMessage(msg message) error {
 func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (ok bool, err error) {
 	hdr := Header{
 		Type:        typeOf(msg),
-		Compression: MessageCompressionLZ4,
+		Compression: c.compressionAlgo,
 	}
 	hdrSize := hdr.ProtoSize()
 	if hdrSize > 1<<16-1 {
@@ -807,7 +902,12 @@ func (c *rawConnection) writeCompressedMessage(msg message, marshaled []byte) (o
 	buf := BufferPool.Get(maxCompressed)
 	defer BufferPool.Put(buf)
 
-	compressedSize, err := lz4Compress(marshaled, buf[cOverhead:])
+	var compressedSize int
+	if c.compressionAlgo == MessageCompressionZstd {
+		compressedSize, err = zstdCompress(marshaled, buf[cOverhead:])
+	} else {
+		compressedSize, err = lz4Compress(marshaled, buf[cOverhead:])
+	}
 	totSize := compressedSize + cOverhead
 	if err != nil {
 		return false, nil
@@ -941,7 +1041,9 @@ func (c *rawConnection) internalClose(err error) {
 
 		<-c.dispatcherLoopStopped
 
-		c.receiver.Closed(c.ID(), err)
+		if !c.secondary {
+			c.receiver.Closed(c.ID(), err)
+		}
 	})
 }
 
@@ -997,19 +1099,50 @@ func (c *rawConnection) pingReceiver() {
 }
 
 type Statistics struct {
-	At            time.Time `json:"at"`
+	At            time.Time          `json:"at"`
+	InBytesTotal  int64              `json:"inBytesTotal"`
+	OutBytesTotal int64              `json:"outBytesTotal"`
+	StartedAt     time.Time          `json:"startedAt"`
+	Streams       []StreamStatistics `json:"streams"`
+}
+
+// StreamStatistics are the statistics of one of the connections to a
+// device, the totals of which make up the device's Statistics.
+type StreamStatistics struct {
+	Address       string    `json:"address"`
+	Type          string    `json:"type"`
+	Primary       bool      `json:"primary"`
 	InBytesTotal  int64     `json:"inBytesTotal"`
 	OutBytesTotal int64     `json:"outBytesTotal"`
 	StartedAt     time.Time `json:"startedAt"`
 }
 
 func (c *rawConnection) Statistics() Statistics {
-	return Statistics{
-		At:            time.Now().Truncate(time.Second),
-		InBytesTotal:  c.cr.Tot(),
-		OutBytesTotal: c.cw.Tot(),
-		StartedAt:     c.startTime,
+	c.streamsMut.Lock()
+	streams := append([]*rawConnection{c}, c.streams...)
+	c.streamsMut.Unlock()
+
+	stats := Statistics{
+		At:        time.Now().Truncate(time.Second),
+		StartedAt: c.startTime,
+		Streams:   make([]StreamStatistics, len(streams)),
 	}
+	for i, s := range streams {
+		ss := StreamStatistics{
+			Type:          s.Type(),
+			Primary:       !s.secondary,
+			InBytesTotal:  s.cr.Tot(),
+			OutBytesTotal: s.cw.Tot(),
+			StartedAt:     s.startTime,
+		}
+		if addr := s.RemoteAddr(); addr != nil {
+			ss.Address = addr.String()
+		}
+		stats.InBytesTotal += ss.InBytesTotal
+		stats.OutBytesTotal += ss.OutBytesTotal
+		stats.Streams[i] = ss
+	}
+	return stats
 }
 
 func lz4Compress(src, buf []byte) (int, error) {
@@ -1039,6 +1172,48 @@ func lz4Decompress(src []byte) ([]byte, error) {
 	return buf[:n], nil
 }
 
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxMessageLen))
+)
+
+// zstdCompress uses the same framing as lz4Compress, so that the
+// decompressed buffer can be taken from the pool.
+func zstdCompress(src, buf []byte) (int, error) {
+	out := zstdEncoder.EncodeAll(src, buf[4:4])
+	if len(out) > len(buf)-4 {
+		return -1, errNotCompressible
+	}
+	// EncodeAll may have allocated a new slice despite the space.
+	copy(buf[4:], out)
+
+	binary.BigEndian.PutUint32(buf, uint32(len(src)))
+
+	return len(out) + 4, nil
+}
+
+func zstdDecompress(src []byte) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, errors.New("zstd message too short")
+	}
+	size := binary.BigEndian.Uint32(src)
+	if size > MaxMessageLen {
+		return nil, fmt.Errorf("decompressed message length %d exceeds maximum %d", size, MaxMessageLen)
+	}
+	buf := BufferPool.Get(int(size))
+
+	out, err := zstdDecoder.DecodeAll(src[4:], buf[:0])
+	if err == nil && len(out) != int(size) {
+		err = fmt.Errorf("decompressed message length %d, expected %d", len(out), size)
+	}
+	if err != nil {
+		BufferPool.Put(buf)
+		return nil, err
+	}
+
+	return buf[:size], nil
+}
+
 func newProtocolError(err error, msgContext string) error {
 	return fmt.Errorf("protocol error on %v: %w", msgContext, err)
 }
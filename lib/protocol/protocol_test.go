@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -32,10 +33,10 @@ func TestPing(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, nil))
+	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutils.NoopCloser{}, newTestModel(), new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -58,10 +59,10 @@ func TestClose(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, nil))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, nil)
+	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -103,7 +104,7 @@ func TestCloseOnBlockingSend(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -154,10 +155,10 @@ func TestCloseRace(t *testing.T) {
 	ar, aw := io.Pipe()
 	br, bw := io.Pipe()
 
-	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, nil))
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil))
 	c0.Start()
 	defer closeAndWait(c0, ar, bw)
-	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, nil)
+	c1 := NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionNever, MessageCompressionLZ4, nil)
 	c1.Start()
 	defer closeAndWait(c1, ar, bw)
 	c0.ClusterConfig(ClusterConfig{})
@@ -194,7 +195,7 @@ func TestClusterConfigFirst(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -246,7 +247,7 @@ func TestCloseTimeout(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -352,6 +353,9 @@ func TestMarshalClusterConfigMessage(t *testing.T) {
 			if len(m1.Folders[i].Devices) == 0 {
 				m1.Folders[i].Devices = nil
 			}
+			if len(m1.Folders[i].SelectedPaths) == 0 {
+				m1.Folders[i].SelectedPaths = nil
+			}
 			for j := range m1.Folders[i].Devices {
 				if len(m1.Folders[i].Devices[j].Addresses) == 0 {
 					m1.Folders[i].Devices[j].Addresses = nil
@@ -359,6 +363,9 @@ func TestMarshalClusterConfigMessage(t *testing.T) {
 				if len(m1.Folders[i].Devices[j].EncryptionPasswordToken) == 0 {
 					m1.Folders[i].Devices[j].EncryptionPasswordToken = nil
 				}
+				if len(m1.Folders[i].Devices[j].ObservedAddresses) == 0 {
+					m1.Folders[i].Devices[j].ObservedAddresses = nil
+				}
 			}
 		}
 
@@ -441,36 +448,50 @@ func testMarshal(t *testing.T, prefix string, m1, m2 message) bool {
 }
 
 func TestWriteCompressed(t *testing.T) {
-	for _, random := range []bool{false, true} {
-		buf := new(bytes.Buffer)
-		c := &rawConnection{
-			cr:          &countingReader{Reader: buf},
-			cw:          &countingWriter{Writer: buf},
-			compression: CompressionAlways,
-		}
+	for _, algo := range []MessageCompression{MessageCompressionLZ4, MessageCompressionZstd} {
+		for _, random := range []bool{false, true} {
+			buf := new(bytes.Buffer)
+			c := &rawConnection{
+				cr:              &countingReader{Reader: buf},
+				cw:              &countingWriter{Writer: buf},
+				compression:     CompressionAlways,
+				compressionAlgo: algo,
+			}
 
-		msg := &Response{Data: make([]byte, 10240)}
-		if random {
-			// This should make the message uncompressible.
-			rand.Read(msg.Data)
-		}
+			msg := &Response{Data: make([]byte, 10240)}
+			if random {
+				// This should make the message uncompressible.
+				rand.Read(msg.Data)
+			}
 
-		if err := c.writeMessage(msg); err != nil {
-			t.Fatal(err)
-		}
-		got, err := c.readMessage(make([]byte, 4))
-		if err != nil {
-			t.Fatal(err)
-		}
-		if !bytes.Equal(got.(*Response).Data, msg.Data) {
-			t.Error("received the wrong message")
-		}
+			if err := c.writeMessage(msg); err != nil {
+				t.Fatal(err)
+			}
+			if !random {
+				// The header follows the two byte header length.
+				var hdr Header
+				hdrLen := int(binary.BigEndian.Uint16(buf.Bytes()))
+				if err := hdr.Unmarshal(buf.Bytes()[2 : 2+hdrLen]); err != nil {
+					t.Fatal(err)
+				}
+				if hdr.Compression != algo {
+					t.Errorf("message compressed with %v, expected %v", hdr.Compression, algo)
+				}
+			}
+			got, err := c.readMessage(make([]byte, 4))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.(*Response).Data, msg.Data) {
+				t.Error("received the wrong message")
+			}
 
-		hdr := Header{Type: typeOf(msg)}
-		size := int64(2 + hdr.ProtoSize() + 4 + msg.ProtoSize())
-		if c.cr.tot > size {
-			t.Errorf("compression enlarged message from %d to %d",
-				size, c.cr.tot)
+			hdr := Header{Type: typeOf(msg)}
+			size := int64(2 + hdr.ProtoSize() + 4 + msg.ProtoSize())
+			if c.cr.tot > size {
+				t.Errorf("compression enlarged message from %d to %d",
+					size, c.cr.tot)
+			}
 		}
 	}
 }
@@ -695,6 +716,8 @@ func TestIsEquivalent(t *testing.T) {
 		b         FileInfo
 		ignPerms  *bool // nil means should not matter, we'll test both variants
 		ignBlocks *bool
+		ignXattrs *bool
+		ignOwner  *bool
 		ignFlags  uint32
 		eq        bool
 	}
@@ -846,6 +869,64 @@ func TestIsEquivalent(t *testing.T) {
 			b:  FileInfo{Type: FileInfoTypeFile, SymlinkTarget: "b"},
 			eq: true,
 		},
+
+		// Extended attributes matter, unless we ignore them
+		{
+			a:         FileInfo{Platform: PlatformData{Linux: &XattrData{Xattrs: []Xattr{{Name: "user.foo", Value: []byte("a")}}}}},
+			b:         FileInfo{Platform: PlatformData{Linux: &XattrData{Xattrs: []Xattr{{Name: "user.foo", Value: []byte("b")}}}}},
+			ignXattrs: b(false),
+			eq:        false,
+		},
+		{
+			a:         FileInfo{Platform: PlatformData{Darwin: &XattrData{Xattrs: []Xattr{{Name: "user.foo", Value: []byte("a")}}}}},
+			b:         FileInfo{},
+			ignXattrs: b(false),
+			eq:        false,
+		},
+		{
+			a:         FileInfo{Platform: PlatformData{Linux: &XattrData{Xattrs: []Xattr{{Name: "user.foo", Value: []byte("a")}}}}},
+			b:         FileInfo{Platform: PlatformData{Linux: &XattrData{Xattrs: []Xattr{{Name: "user.foo", Value: []byte("b")}}}}},
+			ignXattrs: b(true),
+			eq:        true,
+		},
+		// An empty set of attributes is the same as none at all
+		{
+			a:  FileInfo{Platform: PlatformData{Linux: &XattrData{}}},
+			b:  FileInfo{},
+			eq: true,
+		},
+
+		// Ownership matters, unless we ignore it. It's the same owner if
+		// either the IDs or the names match.
+		{
+			a:        FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 1000, GID: 1000}}},
+			b:        FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 1001, GID: 1000}}},
+			ignOwner: b(false),
+			eq:       false,
+		},
+		{
+			a:        FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 1000, GID: 1000}}},
+			b:        FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 1001, GID: 1000}}},
+			ignOwner: b(true),
+			eq:       true,
+		},
+		{
+			a:  FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 1000, GID: 1000, OwnerName: "jb", GroupName: "staff"}}},
+			b:  FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 501, GID: 20, OwnerName: "jb", GroupName: "staff"}}},
+			eq: true,
+		},
+		{
+			a:        FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 1000, GID: 1000, OwnerName: "jb", GroupName: "staff"}}},
+			b:        FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 501, GID: 20, OwnerName: "jb", GroupName: "wheel"}}},
+			ignOwner: b(false),
+			eq:       false,
+		},
+		// Unknown ownership on one side is not a difference
+		{
+			a:  FileInfo{Platform: PlatformData{Unix: &UnixData{UID: 1000, GID: 1000}}},
+			b:  FileInfo{},
+			eq: true,
+		},
 	}
 
 	if runtime.GOOS == "windows" {
@@ -871,12 +952,29 @@ func TestIsEquivalent(t *testing.T) {
 				if tc.ignBlocks != nil && *tc.ignBlocks != ignBlocks {
 					continue
 				}
-
-				if res := tc.a.isEquivalent(tc.b, 0, ignPerms, ignBlocks, tc.ignFlags); res != tc.eq {
-					t.Errorf("Case %d:\na: %v\nb: %v\na.IsEquivalent(b, %v, %v) => %v, expected %v", i, tc.a, tc.b, ignPerms, ignBlocks, res, tc.eq)
-				}
-				if res := tc.b.isEquivalent(tc.a, 0, ignPerms, ignBlocks, tc.ignFlags); res != tc.eq {
-					t.Errorf("Case %d:\na: %v\nb: %v\nb.IsEquivalent(a, %v, %v) => %v, expected %v", i, tc.a, tc.b, ignPerms, ignBlocks, res, tc.eq)
+				for _, ignXattrs := range []bool{true, false} {
+					if tc.ignXattrs != nil && *tc.ignXattrs != ignXattrs {
+						continue
+					}
+					for _, ignOwner := range []bool{true, false} {
+						if tc.ignOwner != nil && *tc.ignOwner != ignOwner {
+							continue
+						}
+
+						comp := FileInfoComparison{
+							IgnorePerms:     ignPerms,
+							IgnoreBlocks:    ignBlocks,
+							IgnoreXattrs:    ignXattrs,
+							IgnoreOwnership: ignOwner,
+							IgnoreFlags:     tc.ignFlags,
+						}
+						if res := tc.a.isEquivalent(tc.b, comp); res != tc.eq {
+							t.Errorf("Case %d:\na: %v\nb: %v\na.IsEquivalent(b, %+v) => %v, expected %v", i, tc.a, tc.b, comp, res, tc.eq)
+						}
+						if res := tc.b.isEquivalent(tc.a, comp); res != tc.eq {
+							t.Errorf("Case %d:\na: %v\nb: %v\nb.IsEquivalent(a, %+v) => %v, expected %v", i, tc.a, tc.b, comp, res, tc.eq)
+						}
+					}
 				}
 			}
 		}
@@ -899,7 +997,7 @@ func TestClusterConfigAfterClose(t *testing.T) {
 	m := newTestModel()
 
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, rw, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	c.Start()
 	defer closeAndWait(c, rw)
 
@@ -923,7 +1021,7 @@ func TestDispatcherToCloseDeadlock(t *testing.T) {
 	// the model callbacks (ClusterConfig).
 	m := newTestModel()
 	rw := testutils.NewBlockingRW()
-	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, nil))
+	c := getRawConnection(NewConnection(c0ID, rw, &testutils.NoopRW{}, testutils.NoopCloser{}, m, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
 	m.ccFn = func(devID DeviceID, cc ClusterConfig) {
 		c.Close(errManual)
 	}
@@ -939,6 +1037,82 @@ func TestDispatcherToCloseDeadlock(t *testing.T) {
 	}
 }
 
+func TestRequestStreams(t *testing.T) {
+	m0 := newTestModel()
+	m1 := newTestModel()
+	m1.data = []byte("response data")
+
+	ar, aw := io.Pipe()
+	br, bw := io.Pipe()
+	sar, saw := io.Pipe()
+	sbr, sbw := io.Pipe()
+
+	c0 := getRawConnection(NewConnection(c0ID, ar, bw, testutils.NoopCloser{}, m0, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
+	c0.Start()
+	defer closeAndWait(c0, ar, bw)
+	c1 := getRawConnection(NewConnection(c1ID, br, aw, testutils.NoopCloser{}, m1, new(mockedConnectionInfo), CompressionAlways, MessageCompressionLZ4, nil))
+	c1.Start()
+	defer closeAndWait(c1, br, aw)
+	c0.ClusterConfig(ClusterConfig{})
+	c1.ClusterConfig(ClusterConfig{})
+
+	if err := c0.AddStream(sar, sbw, sar, new(mockedConnectionInfo)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c1.AddStream(sbr, saw, sbr, new(mockedConnectionInfo)); err != nil {
+		t.Fatal(err)
+	}
+	s0 := c0.streams[0]
+	s1 := c1.streams[0]
+	defer closeAndWait(s1, sbr, saw)
+	defer closeAndWait(s0, sar, sbw)
+
+	for i := 0; i < 4; i++ {
+		data, err := c0.Request(context.Background(), "default", "foo", i, 0, 0, nil, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, m1.data) {
+			t.Fatalf("unexpected response data %q", data)
+		}
+	}
+
+	stats := c0.Statistics()
+	if len(stats.Streams) != 2 {
+		t.Fatalf("expected 2 streams, got %d", len(stats.Streams))
+	}
+	if !stats.Streams[0].Primary || stats.Streams[1].Primary {
+		t.Error("expected only the first stream to be primary")
+	}
+	for i, ss := range stats.Streams {
+		// At least the cluster config or two requests.
+		if ss.OutBytesTotal == 0 {
+			t.Errorf("stream %d sent nothing", i)
+		}
+	}
+	if stats.OutBytesTotal != stats.Streams[0].OutBytesTotal+stats.Streams[1].OutBytesTotal {
+		t.Error("total doesn't match the sum of the streams")
+	}
+
+	// Index data is not allowed on secondary connections.
+	s1.send(context.Background(), &Index{Folder: "default"}, nil)
+	select {
+	case <-s0.closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("secondary connection didn't close on index message")
+	}
+	select {
+	case <-m0.closedCh:
+		t.Fatal("closing a secondary connection closed the device connection")
+	default:
+	}
+
+	// Requests go to the primary connection again.
+	if _, err := c0.Request(context.Background(), "default", "foo", 0, 0, 0, nil, 0, false); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestBlocksEqual(t *testing.T) {
 	blocksOne := []BlockInfo{{Hash: []byte{1, 2, 3, 4}}}
 	blocksTwo := []BlockInfo{{Hash: []byte{5, 6, 7, 8}}}
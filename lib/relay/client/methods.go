@@ -9,11 +9,13 @@ import (
 	"net"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/dialer"
 	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/relay/protocol"
+	"github.com/syncthing/syncthing/lib/websocket"
 )
 
 type incorrectResponseCodeErr struct {
@@ -26,13 +28,9 @@ func (e *incorrectResponseCodeErr) Error() string {
 }
 
 func GetInvitationFromRelay(ctx context.Context, uri *url.URL, id syncthingprotocol.DeviceID, certs []tls.Certificate, timeout time.Duration) (protocol.SessionInvitation, error) {
-	if uri.Scheme != "relay" {
-		return protocol.SessionInvitation{}, fmt.Errorf("unsupported relay scheme: %v", uri.Scheme)
-	}
-
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	rconn, err := dialer.DialContext(ctx, "tcp", uri.Host)
+	rconn, err := dialRelay(ctx, uri)
 	if err != nil {
 		return protocol.SessionInvitation{}, err
 	}
@@ -74,12 +72,20 @@ func GetInvitationFromRelay(ctx context.Context, uri *url.URL, id syncthingproto
 	}
 }
 
-func JoinSession(ctx context.Context, invitation protocol.SessionInvitation) (net.Conn, error) {
-	addr := net.JoinHostPort(net.IP(invitation.Address).String(), strconv.Itoa(int(invitation.Port)))
-
+// JoinSession joins the session of an invitation from the relay at the
+// given URI. Relays reached over WebSocket take the session connection at
+// the same URL, others at the address in the invitation.
+func JoinSession(ctx context.Context, uri *url.URL, invitation protocol.SessionInvitation) (net.Conn, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	var conn net.Conn
+	var err error
+	if isWebsocketRelay(uri) {
+		conn, err = dialRelay(ctx, uri)
+	} else {
+		addr := net.JoinHostPort(net.IP(invitation.Address).String(), strconv.Itoa(int(invitation.Port)))
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +151,35 @@ func TestRelay(ctx context.Context, uri *url.URL, certs []tls.Certificate, sleep
 	return fmt.Errorf("getting invitation: %w", err) // last of the above errors
 }
 
+// dialRelay opens a connection to the relay at the URI, over WebSocket for
+// relay+ws:// and relay+wss:// URIs, for relays behind HTTP proxies.
+func dialRelay(ctx context.Context, uri *url.URL) (net.Conn, error) {
+	switch uri.Scheme {
+	case "relay":
+		return dialer.DialContext(ctx, "tcp", uri.Host)
+	case "relay+ws", "relay+wss":
+		wsURI := &url.URL{
+			Scheme: strings.TrimPrefix(uri.Scheme, "relay+"),
+			Host:   uri.Host,
+			Path:   uri.Path,
+		}
+		if wsURI.Port() == "" {
+			port := "80"
+			if wsURI.Scheme == "wss" {
+				port = "443"
+			}
+			wsURI.Host = net.JoinHostPort(wsURI.Hostname(), port)
+		}
+		return websocket.Dial(ctx, wsURI, dialer.DialContext)
+	default:
+		return nil, fmt.Errorf("unsupported relay scheme: %v", uri.Scheme)
+	}
+}
+
+func isWebsocketRelay(uri *url.URL) bool {
+	return uri != nil && (uri.Scheme == "relay+ws" || uri.Scheme == "relay+wss")
+}
+
 func configForCerts(certs []tls.Certificate) *tls.Config {
 	return &tls.Config{
 		Certificates:           certs,
@@ -12,7 +12,6 @@ import (
 
 	"github.com/pkg/errors"
 
-	"github.com/syncthing/syncthing/lib/dialer"
 	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/relay/protocol"
 )
@@ -130,13 +129,9 @@ func (c *staticClient) URI() *url.URL {
 }
 
 func (c *staticClient) connect(ctx context.Context) error {
-	if c.uri.Scheme != "relay" {
-		return fmt.Errorf("unsupported relay scheme: %v", c.uri.Scheme)
-	}
-
 	timeoutCtx, cancel := context.WithTimeout(ctx, c.connectTimeout)
 	defer cancel()
-	tcpConn, err := dialer.DialContext(timeoutCtx, "tcp", c.uri.Host)
+	tcpConn, err := dialRelay(timeoutCtx, c.uri)
 	if err != nil {
 		return err
 	}
@@ -146,7 +141,7 @@ func (c *staticClient) connect(ctx context.Context) error {
 	// other cases it will be a hostname, which will cause the TLS stack to
 	// send SNI.
 	cfg := c.config
-	if host, _, err := net.SplitHostPort(c.uri.Host); err == nil {
+	if host := c.uri.Hostname(); host != "" {
 		cfg = cfg.Clone()
 		cfg.ServerName = host
 	}
@@ -17,6 +17,9 @@ var (
 	ResponseSuccess           = Response{0, "success"}
 	ResponseNotFound          = Response{1, "not found"}
 	ResponseAlreadyConnected  = Response{2, "already connected"}
+	ResponseNotAllowed        = Response{3, "not allowed"}
+	ResponseQuotaExceeded     = Response{4, "quota exceeded"}
+	ResponseTooManySessions   = Response{5, "too many sessions"}
 	ResponseUnexpectedMessage = Response{100, "unexpected message"}
 )
 
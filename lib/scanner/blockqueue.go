@@ -9,6 +9,7 @@ package scanner
 import (
 	"context"
 	"errors"
+	"io"
 
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/protocol"
@@ -17,6 +18,19 @@ import (
 
 // HashFile hashes the files and returns a list of blocks representing the file.
 func HashFile(ctx context.Context, fs fs.Filesystem, path string, blockSize int, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
+	return hashFile(ctx, fs, path, func(r io.Reader, size int64) ([]protocol.BlockInfo, error) {
+		return Blocks(ctx, r, blockSize, size, counter, useWeakHashes)
+	})
+}
+
+// HashFileChunked is like HashFile, but uses content defined chunking.
+func HashFileChunked(ctx context.Context, fs fs.Filesystem, path string, maxBlockSize int, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
+	return hashFile(ctx, fs, path, func(r io.Reader, size int64) ([]protocol.BlockInfo, error) {
+		return ChunkedBlocks(ctx, r, maxBlockSize, size, counter, useWeakHashes)
+	})
+}
+
+func hashFile(ctx context.Context, fs fs.Filesystem, path string, blocksFn func(io.Reader, int64) ([]protocol.BlockInfo, error)) ([]protocol.BlockInfo, error) {
 	fd, err := fs.Open(path)
 	if err != nil {
 		l.Debugln("open:", err)
@@ -36,7 +50,7 @@ func HashFile(ctx context.Context, fs fs.Filesystem, path string, blockSize int,
 
 	// Hash the file. This may take a while for large files.
 
-	blocks, err := Blocks(ctx, fd, blockSize, size, counter, useWeakHashes)
+	blocks, err := blocksFn(fd, size)
 	if err != nil {
 		l.Debugln("blocks:", err)
 		return nil, err
@@ -66,16 +80,18 @@ type parallelHasher struct {
 	outbox  chan<- ScanResult
 	inbox   <-chan protocol.FileInfo
 	counter Counter
+	chunked bool
 	done    chan<- struct{}
 	wg      sync.WaitGroup
 }
 
-func newParallelHasher(ctx context.Context, fs fs.Filesystem, workers int, outbox chan<- ScanResult, inbox <-chan protocol.FileInfo, counter Counter, done chan<- struct{}) {
+func newParallelHasher(ctx context.Context, fs fs.Filesystem, workers int, outbox chan<- ScanResult, inbox <-chan protocol.FileInfo, counter Counter, chunked bool, done chan<- struct{}) {
 	ph := &parallelHasher{
 		fs:      fs,
 		outbox:  outbox,
 		inbox:   inbox,
 		counter: counter,
+		chunked: chunked,
 		done:    done,
 		wg:      sync.NewWaitGroup(),
 	}
@@ -102,7 +118,13 @@ func (ph *parallelHasher) hashFiles(ctx context.Context) {
 				panic("Bug. Asked to hash a directory or a deleted file.")
 			}
 
-			blocks, err := HashFile(ctx, ph.fs, f.Name, f.BlockSize(), ph.counter, true)
+			var blocks []protocol.BlockInfo
+			var err error
+			if ph.chunked {
+				blocks, err = HashFileChunked(ctx, ph.fs, f.Name, f.BlockSize(), ph.counter, true)
+			} else {
+				blocks, err = HashFile(ctx, ph.fs, f.Name, f.BlockSize(), ph.counter, true)
+			}
 			if err != nil {
 				handleError(ctx, "hashing", f.Name, err, ph.outbox)
 				continue
@@ -12,6 +12,7 @@ import (
 	"hash"
 	"hash/adler32"
 	"io"
+	"math/bits"
 
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/sha256"
@@ -112,6 +113,129 @@ func Blocks(ctx context.Context, r io.Reader, blocksize int, sizehint int64, cou
 	return blocks, nil
 }
 
+// ChunkedBlockSize returns the maximum block size to use for content
+// defined chunking of the given file size. The blocks average a quarter of
+// it, which is about the block size used otherwise.
+func ChunkedBlockSize(fileSize int64) int {
+	blockSize := 4 * protocol.BlockSize(fileSize)
+	if blockSize > protocol.MaxBlockSize {
+		return protocol.MaxBlockSize
+	}
+	return blockSize
+}
+
+// ChunkedBlocks returns the blockwise hash of the reader, like Blocks, but
+// with block boundaries determined by the content instead of the offset.
+// Data inserted into or removed from the middle of a file thus only changes
+// the blocks around the edit, instead of all the blocks after it. Blocks are
+// at least a sixteenth of maxBlockSize and average about a quarter of it.
+func ChunkedBlocks(ctx context.Context, r io.Reader, maxBlockSize int, sizehint int64, counter Counter, useWeakHashes bool) ([]protocol.BlockInfo, error) {
+	if counter == nil {
+		counter = &noopCounter{}
+	}
+	if sizehint >= 0 {
+		r = io.LimitReader(r, sizehint)
+	}
+
+	hf := sha256.New()
+	var weakHf hash.Hash32 = noopHash{}
+	if useWeakHashes {
+		weakHf = adler32.New()
+	}
+
+	minSize := maxBlockSize / 16
+	// Past the minimum size, the boundary condition is met once every
+	// maxBlockSize/4 bytes on average.
+	mask := ^uint64(0) << (64 - bits.Len(uint(maxBlockSize/4)) + 1)
+
+	var blocks []protocol.BlockInfo
+	var offset int64
+	buf := make([]byte, maxBlockSize)
+	n := 0
+	eof := false
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		if !eof {
+			m, err := io.ReadFull(r, buf[n:])
+			n += m
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				eof = true
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if n == 0 {
+			break
+		}
+
+		size := n
+		if n > minSize {
+			var h uint64
+			// The hash only depends on the last 64 bytes, no need to roll
+			// it over the part before the minimum size.
+			start := minSize - 64
+			if start < 0 {
+				start = 0
+			}
+			for i := start; i < n; i++ {
+				h = h<<1 + gearTable[buf[i]]
+				if i >= minSize && h&mask == 0 {
+					size = i + 1
+					break
+				}
+			}
+		}
+		block := buf[:size]
+		hf.Write(block)
+		weakHf.Write(block)
+		counter.Update(int64(size))
+
+		blocks = append(blocks, protocol.BlockInfo{
+			Size:     size,
+			Offset:   offset,
+			Hash:     hf.Sum(nil),
+			WeakHash: weakHf.Sum32(),
+		})
+		offset += int64(size)
+		hf.Reset()
+		weakHf.Reset()
+
+		n = copy(buf, buf[size:n])
+	}
+
+	if len(blocks) == 0 {
+		// Empty file
+		blocks = append(blocks, protocol.BlockInfo{
+			Offset: 0,
+			Size:   0,
+			Hash:   SHA256OfNothing,
+		})
+	}
+
+	return blocks, nil
+}
+
+// gearTable holds a random value per byte for the rolling hash used to find
+// block boundaries. It must be the same everywhere, so that devices cut the
+// same data into the same blocks.
+var gearTable = func() (t [256]uint64) {
+	// splitmix64 with a fixed seed
+	x := uint64(0x5f3759df)
+	for i := range t {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		t[i] = z ^ (z >> 31)
+	}
+	return t
+}()
+
 // Validate quickly validates buf against the 32-bit weakHash, if not zero,
 // else against the cryptohash hash, if len(hash)>0. It is satisfied if
 // either hash matches or neither hash is given.
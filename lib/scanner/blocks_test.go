@@ -106,6 +106,54 @@ func TestBlocks(t *testing.T) {
 	}
 }
 
+func TestChunkedBlocks(t *testing.T) {
+	const maxSize = 1 << 20
+	data := make([]byte, 8<<20)
+	rand.Reader.Read(data)
+
+	blocks, err := ChunkedBlocks(context.TODO(), bytes.NewReader(data), maxSize, int64(len(data)), nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var offset int64
+	for i, b := range blocks {
+		if b.Offset != offset {
+			t.Errorf("block %d at offset %d, expected %d", i, b.Offset, offset)
+		}
+		if b.Size > maxSize || (b.Size < maxSize/16 && i < len(blocks)-1) {
+			t.Errorf("block %d has invalid size %d", i, b.Size)
+		}
+		offset += int64(b.Size)
+	}
+	if offset != int64(len(data)) {
+		t.Fatalf("blocks cover %d bytes, expected %d", offset, len(data))
+	}
+	if len(blocks) <= len(data)/maxSize {
+		t.Fatalf("expected blocks smaller than the maximum, got %d blocks", len(blocks))
+	}
+
+	// Insert some data in the middle, only the blocks around it should
+	// change.
+	edited := append(append(append([]byte{}, data[:len(data)/2]...), make([]byte, 1000)...), data[len(data)/2:]...)
+	editedBlocks, err := ChunkedBlocks(context.TODO(), bytes.NewReader(edited), maxSize, -1, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashes := make(map[string]struct{}, len(blocks))
+	for _, b := range blocks {
+		hashes[string(b.Hash)] = struct{}{}
+	}
+	changed := 0
+	for _, b := range editedBlocks {
+		if _, ok := hashes[string(b.Hash)]; !ok {
+			changed++
+		}
+	}
+	if changed > 2 {
+		t.Errorf("%d of %d blocks changed after an insertion", changed, len(editedBlocks))
+	}
+}
+
 func TestAdler32Variants(t *testing.T) {
 	// Verify that the two adler32 functions give matching results for a few
 	// different blocks of data.
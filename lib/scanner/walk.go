@@ -59,6 +59,16 @@ type Config struct {
 	ModTimeWindow time.Duration
 	// Event logger to which the scan progress events are sent
 	EventLogger events.Logger
+	// If ScanOwnership is true, the owner and group of files are included
+	// in the scanned files.
+	ScanOwnership bool
+	// If ScanXattrs is true, extended attributes permitted by XattrFilter
+	// are read and included in the scanned files.
+	ScanXattrs  bool
+	XattrFilter fs.XattrFilter
+	// If ContentDefinedChunking is true, files are hashed in blocks with
+	// boundaries determined by the content.
+	ContentDefinedChunking bool
 }
 
 type CurrentFiler interface {
@@ -121,7 +131,7 @@ func (w *walker) walk(ctx context.Context) chan ScanResult {
 	// We're not required to emit scan progress events, just kick off hashers,
 	// and feed inputs directly from the walker.
 	if w.ProgressTickIntervalS < 0 {
-		newParallelHasher(ctx, w.Filesystem, w.Hashers, finishedChan, toHashChan, nil, nil)
+		newParallelHasher(ctx, w.Filesystem, w.Hashers, finishedChan, toHashChan, nil, w.ContentDefinedChunking, nil)
 		return finishedChan
 	}
 
@@ -152,7 +162,7 @@ func (w *walker) walk(ctx context.Context) chan ScanResult {
 		done := make(chan struct{})
 		progress := newByteCounter()
 
-		newParallelHasher(ctx, w.Filesystem, w.Hashers, finishedChan, realToHashChan, progress, done)
+		newParallelHasher(ctx, w.Filesystem, w.Hashers, finishedChan, realToHashChan, progress, w.ContentDefinedChunking, done)
 
 		// A routine which actually emits the FolderScanProgress events
 		// every w.ProgressTicker ticks, until the hasher routines terminate.
@@ -356,16 +366,19 @@ func (w *walker) handleItem(ctx context.Context, path string, info fs.FileInfo,
 		err = w.walkDir(ctx, path, info, finishedChan)
 
 	case info.IsRegular():
-		err = w.walkRegular(ctx, path, info, toHashChan)
+		err = w.walkRegular(ctx, path, info, toHashChan, finishedChan)
 	}
 
 	return err
 }
 
-func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo) error {
+func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileInfo, toHashChan chan<- protocol.FileInfo, finishedChan chan<- ScanResult) error {
 	curFile, hasCurFile := w.CurrentFiler.CurrentFile(relPath)
 
 	blockSize := protocol.BlockSize(info.Size())
+	if w.ContentDefinedChunking {
+		blockSize = ChunkedBlockSize(info.Size())
+	}
 
 	if hasCurFile {
 		// Check if we should retain current block size.
@@ -385,9 +398,13 @@ func (w *walker) walkRegular(ctx context.Context, relPath string, info fs.FileIn
 	f = w.updateFileInfo(f, curFile)
 	f.NoPermissions = w.IgnorePerms
 	f.RawBlockSize = blockSize
+	if err := w.updatePlatformData(&f, info, curFile); err != nil {
+		handleError(ctx, "reading platform data", relPath, err, finishedChan)
+		return nil
+	}
 
 	if hasCurFile {
-		if curFile.IsEquivalentOptional(f, w.ModTimeWindow, w.IgnorePerms, true, w.LocalFlags) {
+		if curFile.IsEquivalentOptional(f, w.comparison()) {
 			l.Debugln(w, "unchanged:", curFile, info.ModTime().Unix(), info.Mode()&fs.ModePerm)
 			return nil
 		}
@@ -419,9 +436,13 @@ func (w *walker) walkDir(ctx context.Context, relPath string, info fs.FileInfo,
 	f, _ := CreateFileInfo(info, relPath, nil)
 	f = w.updateFileInfo(f, curFile)
 	f.NoPermissions = w.IgnorePerms
+	if err := w.updatePlatformData(&f, info, curFile); err != nil {
+		handleError(ctx, "reading platform data", relPath, err, finishedChan)
+		return nil
+	}
 
 	if hasCurFile {
-		if curFile.IsEquivalentOptional(f, w.ModTimeWindow, w.IgnorePerms, true, w.LocalFlags) {
+		if curFile.IsEquivalentOptional(f, w.comparison()) {
 			l.Debugln(w, "unchanged:", curFile, info.ModTime().Unix(), info.Mode()&fs.ModePerm)
 			return nil
 		}
@@ -464,9 +485,13 @@ func (w *walker) walkSymlink(ctx context.Context, relPath string, info fs.FileIn
 	curFile, hasCurFile := w.CurrentFiler.CurrentFile(relPath)
 
 	f = w.updateFileInfo(f, curFile)
+	if err := w.updatePlatformData(&f, info, curFile); err != nil {
+		handleError(ctx, "reading platform data", relPath, err, finishedChan)
+		return nil
+	}
 
 	if hasCurFile {
-		if curFile.IsEquivalentOptional(f, w.ModTimeWindow, w.IgnorePerms, true, w.LocalFlags) {
+		if curFile.IsEquivalentOptional(f, w.comparison()) {
 			l.Debugln(w, "unchanged:", curFile, info.ModTime().Unix(), info.Mode()&fs.ModePerm)
 			return nil
 		}
@@ -563,6 +588,45 @@ func (w *walker) updateFileInfo(file, curFile protocol.FileInfo) protocol.FileIn
 	return file
 }
 
+// updatePlatformData sets the ownership and extended attributes of file,
+// if we are scanning them. Platform data we don't scan (other platforms, or
+// everything when scanning is disabled) is retained from curFile.
+func (w *walker) updatePlatformData(file *protocol.FileInfo, info fs.FileInfo, curFile protocol.FileInfo) error {
+	if w.ScanOwnership && runtime.GOOS != "windows" {
+		file.Platform.Unix = &protocol.UnixData{
+			UID:       info.Owner(),
+			GID:       info.Group(),
+			OwnerName: osutil.OwnerName(info.Owner()),
+			GroupName: osutil.GroupName(info.Group()),
+		}
+	}
+	if w.ScanXattrs {
+		xattrs, err := w.Filesystem.GetXattr(file.Name, w.XattrFilter)
+		if errors.Is(err, fs.ErrXattrsNotSupported) {
+			l.Debugf("%v: xattrs not supported for %s: %v", w, file.Name, err)
+		} else if err != nil {
+			return err
+		} else {
+			file.Platform.SetXattrs(xattrs)
+		}
+	}
+	file.Platform.MergeWith(&curFile.Platform)
+	return nil
+}
+
+// comparison returns the options used to decide whether a scanned file is
+// unchanged compared to the current file.
+func (w *walker) comparison() protocol.FileInfoComparison {
+	return protocol.FileInfoComparison{
+		ModTimeWindow:   w.ModTimeWindow,
+		IgnorePerms:     w.IgnorePerms,
+		IgnoreBlocks:    true,
+		IgnoreFlags:     w.LocalFlags,
+		IgnoreOwnership: !w.ScanOwnership,
+		IgnoreXattrs:    !w.ScanXattrs,
+	}
+}
+
 func handleError(ctx context.Context, context, path string, err error, finishedChan chan<- ScanResult) {
 	select {
 	case finishedChan <- ScanResult{
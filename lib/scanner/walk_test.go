@@ -962,3 +962,79 @@ func testConfig() (Config, context.CancelFunc) {
 		EventLogger: evLogger,
 	}, cancel
 }
+
+func TestWalkXattrs(t *testing.T) {
+	switch runtime.GOOS {
+	case "linux", "darwin", "freebsd", "netbsd":
+	default:
+		t.Skip("xattrs not supported on", runtime.GOOS)
+	}
+
+	ffs := fs.NewFilesystem(fs.FilesystemTypeFake, "TestWalkXattrs")
+	fd, err := ffs.Create("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+	attrs := []protocol.Xattr{{Name: "user.foo", Value: []byte("bar")}}
+	if err := ffs.SetXattr("file", attrs, noopXattrFilter{}); err != nil {
+		t.Fatal(err)
+	}
+
+	walk := func(current fakeCurrentFiler, scanXattrs bool) []protocol.FileInfo {
+		t.Helper()
+		cfg, cancel := testConfig()
+		defer cancel()
+		cfg.Filesystem = ffs
+		cfg.CurrentFiler = current
+		cfg.ScanXattrs = scanXattrs
+		cfg.XattrFilter = noopXattrFilter{}
+		var files []protocol.FileInfo
+		for res := range Walk(context.TODO(), cfg) {
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			files = append(files, res.File)
+		}
+		return files
+	}
+
+	// The initial scan picks up the attributes
+	files := walk(fakeCurrentFiler{}, true)
+	if len(files) != 1 {
+		t.Fatal("expected one file, got", len(files))
+	}
+	if diff, equal := messagediff.PrettyDiff(attrs, files[0].Platform.Xattrs()); !equal {
+		t.Fatal("unexpected xattrs:", diff)
+	}
+	current := fakeCurrentFiler{"file": files[0]}
+
+	// Nothing changed, nothing to report
+	if files := walk(current, true); len(files) != 0 {
+		t.Fatal("expected no changes, got", len(files))
+	}
+
+	// A changed attribute is a change to the file
+	attrs[0].Value = []byte("baz")
+	if err := ffs.SetXattr("file", attrs, noopXattrFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	files = walk(current, true)
+	if len(files) != 1 {
+		t.Fatal("expected one changed file, got", len(files))
+	}
+	if diff, equal := messagediff.PrettyDiff(attrs, files[0].Platform.Xattrs()); !equal {
+		t.Fatal("unexpected xattrs:", diff)
+	}
+
+	// ... unless we're not looking at attributes at all
+	if files := walk(current, false); len(files) != 0 {
+		t.Fatal("expected no changes when not scanning xattrs, got", len(files))
+	}
+}
+
+type noopXattrFilter struct{}
+
+func (noopXattrFilter) Permit(string) bool         { return true }
+func (noopXattrFilter) GetMaxSingleEntrySize() int { return 0 }
+func (noopXattrFilter) GetMaxTotalSize() int       { return 0 }
@@ -134,6 +134,8 @@ func (a *App) startup() error {
 		a.mainService.Add(newVerboseService(a.evLogger))
 	}
 
+	a.mainService.Add(newWebhookService(a.cfg, a.evLogger))
+
 	errors := logger.NewRecorder(l, logger.LevelWarn, maxSystemErrors, 0)
 	systemLog := logger.NewRecorder(l, logger.LevelDebug, maxSystemLog, initialSystemLog)
 
@@ -197,6 +199,7 @@ func (a *App) startup() error {
 
 	protectedFiles := []string{
 		locations.Get(locations.Database),
+		locations.Get(locations.DatabaseBolt),
 		locations.Get(locations.ConfigFile),
 		locations.Get(locations.CertFile),
 		locations.Get(locations.KeyFile),
@@ -243,7 +246,11 @@ func (a *App) startup() error {
 		miscDB.PutString("prevVersion", build.Version)
 	}
 
-	m := model.NewModel(a.cfg, a.myID, "syncthing", build.Version, a.ll, protectedFiles, a.evLogger)
+	// Addresses of devices learned from other connected devices, fed by the
+	// model and used by discovery.
+	peerExchange := discover.NewPeerExchange(a.myID)
+
+	m := model.NewModel(a.cfg, a.myID, "syncthing", build.Version, a.ll, protectedFiles, a.evLogger, peerExchange)
 
 	if a.opts.DeadlockTimeoutS > 0 {
 		m.StartDeadlockDetector(time.Duration(a.opts.DeadlockTimeoutS) * time.Second)
@@ -276,7 +283,7 @@ func (a *App) startup() error {
 	// Create a wrapper that is then wired after they are both setup.
 	addrLister := &lateAddressLister{}
 
-	discoveryManager := discover.NewManager(a.myID, a.cfg, a.cert, a.evLogger, addrLister)
+	discoveryManager := discover.NewManager(a.myID, a.cfg, a.cert, a.evLogger, addrLister, peerExchange)
 	connectionsService := connections.NewService(a.cfg, a.myID, m, tlsCfg, discoveryManager, bepProtocolName, tlsDefaultCommonName, a.evLogger)
 
 	addrLister.AddressLister = connectionsService
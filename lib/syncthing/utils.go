@@ -149,6 +149,14 @@ func copyFile(src, dst string) error {
 	return nil
 }
 
-func OpenDBBackend(path string, tuning config.Tuning) (backend.Backend, error) {
-	return backend.Open(path, backend.Tuning(tuning))
+// DBLocation returns the location of the database for the given backend.
+func DBLocation(typ config.DatabaseBackend) string {
+	if typ == config.DatabaseBackendBolt {
+		return locations.Get(locations.DatabaseBolt)
+	}
+	return locations.Get(locations.Database)
+}
+
+func OpenDBBackend(path string, typ config.DatabaseBackend, tuning config.Tuning) (backend.Backend, error) {
+	return backend.Open(path, backend.Type(typ), backend.Tuning(tuning))
 }
@@ -0,0 +1,311 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package syncthing
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+var (
+	// Failed deliveries are retried after webhookMinBackoff, doubling for
+	// every further attempt up to webhookMaxBackoff.
+	webhookMinBackoff = time.Second
+	webhookMaxBackoff = 5 * time.Minute
+	// Events for a webhook that can't keep up are dropped once this many
+	// are waiting to be delivered.
+	webhookQueueSize = 128
+)
+
+const (
+	webhookEventHeader     = "X-Syncthing-Event"
+	webhookDeliveryHeader  = "X-Syncthing-Delivery"
+	webhookSignatureHeader = "X-Syncthing-Signature"
+)
+
+// The webhookService subscribes to the events selected by the configured
+// webhooks and POSTs them, in JSON format, to the webhook URLs.
+type webhookService struct {
+	cfg       config.Wrapper
+	evLogger  events.Logger
+	hooksChan chan []config.WebhookConfiguration
+	client    *http.Client
+}
+
+func newWebhookService(cfg config.Wrapper, evLogger events.Logger) *webhookService {
+	return &webhookService{
+		cfg:       cfg,
+		evLogger:  evLogger,
+		hooksChan: make(chan []config.WebhookConfiguration),
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: dialer.DialContext,
+				Proxy:       http.ProxyFromEnvironment,
+			},
+		},
+	}
+}
+
+// serve runs the webhook service.
+func (s *webhookService) Serve(ctx context.Context) error {
+	cfg := s.cfg.Subscribe(s)
+	defer s.cfg.Unsubscribe(s)
+
+	var senders []*webhookSender
+	var sub events.Subscription
+	var evChan <-chan events.Event
+	apply := func(hooks []config.WebhookConfiguration) {
+		for _, sender := range senders {
+			sender.stop()
+		}
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+		senders, sub, evChan = nil, nil, nil
+
+		var mask events.EventType
+		for _, hook := range hooks {
+			if !hook.Enabled || hook.URL == "" {
+				continue
+			}
+			hookMask := webhookEventMask(hook)
+			if hookMask == 0 {
+				l.Warnf("Webhook %q has no valid events configured", hook.ID)
+				continue
+			}
+			senders = append(senders, newWebhookSender(ctx, hook, hookMask, s.client))
+			mask |= hookMask
+		}
+		if mask != 0 {
+			sub = s.evLogger.Subscribe(mask)
+			evChan = sub.C()
+		}
+	}
+	defer apply(nil)
+	apply(cfg.Webhooks)
+
+	for {
+		select {
+		case hooks := <-s.hooksChan:
+			apply(hooks)
+		case ev, ok := <-evChan:
+			if !ok {
+				// The event logger has stopped.
+				evChan = nil
+				continue
+			}
+			for _, sender := range senders {
+				sender.enqueue(ev)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *webhookService) CommitConfiguration(from, to config.Configuration) bool {
+	if !reflect.DeepEqual(from.Webhooks, to.Webhooks) {
+		s.hooksChan <- to.Webhooks
+	}
+	return true
+}
+
+func (s *webhookService) String() string {
+	return fmt.Sprintf("webhookService@%p", s)
+}
+
+// A webhookSender delivers events to one webhook, in order, retrying
+// failed deliveries.
+type webhookSender struct {
+	hook   config.WebhookConfiguration
+	mask   events.EventType
+	client *http.Client
+	queue  chan events.Event
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newWebhookSender(ctx context.Context, hook config.WebhookConfiguration, mask events.EventType, client *http.Client) *webhookSender {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &webhookSender{
+		hook:   hook,
+		mask:   mask,
+		client: client,
+		queue:  make(chan events.Event, webhookQueueSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go s.serve(ctx)
+	return s
+}
+
+func (s *webhookSender) serve(ctx context.Context) {
+	defer close(s.done)
+	for {
+		select {
+		case ev := <-s.queue:
+			s.deliver(ctx, ev)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *webhookSender) stop() {
+	s.cancel()
+	<-s.done
+}
+
+// enqueue queues the event for delivery, if it's one the webhook cares
+// about.
+func (s *webhookSender) enqueue(ev events.Event) {
+	if !s.permits(ev) {
+		return
+	}
+	select {
+	case s.queue <- ev:
+	default:
+		l.Infof("Webhook %q is not keeping up; dropping %v event", s.hook.ID, ev.Type)
+	}
+}
+
+func (s *webhookSender) permits(ev events.Event) bool {
+	if s.mask&ev.Type == 0 {
+		return false
+	}
+	folder, device := eventFolderAndDevice(ev)
+	if folder != "" && !s.hook.PermitsFolder(folder) {
+		return false
+	}
+	if device != "" && !s.hook.PermitsDevice(device) {
+		return false
+	}
+	return true
+}
+
+func (s *webhookSender) deliver(ctx context.Context, ev events.Event) {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		l.Debugf("Webhook %q: marshalling event: %v", s.hook.ID, err)
+		return
+	}
+
+	backoff := webhookMinBackoff
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(ctx, ev, body)
+		if err == nil {
+			l.Debugf("Webhook %q: delivered %v event %d", s.hook.ID, ev.Type, ev.GlobalID)
+			return
+		}
+		if !retry || attempt >= s.hook.MaxRetries {
+			l.Infof("Webhook %q: failed to deliver %v event: %v", s.hook.ID, ev.Type, err)
+			return
+		}
+		l.Debugf("Webhook %q: delivering %v event (attempt %d): %v", s.hook.ID, ev.Type, attempt+1, err)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		backoff *= 2
+		if backoff > webhookMaxBackoff {
+			backoff = webhookMaxBackoff
+		}
+	}
+}
+
+// post makes one delivery attempt and returns whether it makes sense to
+// retry if it failed.
+func (s *webhookSender) post(ctx context.Context, ev events.Event, body []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.hook.Timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "syncthing/"+build.Version)
+	req.Header.Set(webhookEventHeader, ev.Type.String())
+	req.Header.Set(webhookDeliveryHeader, strconv.Itoa(ev.GlobalID))
+	if s.hook.Secret != "" {
+		req.Header.Set(webhookSignatureHeader, "sha256="+webhookSignature(s.hook.Secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusRequestTimeout:
+		return true, fmt.Errorf("unexpected status %s", resp.Status)
+	default:
+		return false, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
+
+func webhookEventMask(hook config.WebhookConfiguration) events.EventType {
+	var mask events.EventType
+	for _, ev := range hook.Events {
+		mask |= events.UnmarshalEventType(strings.TrimSpace(ev))
+	}
+	return mask
+}
+
+// webhookSignature returns the hex encoded HMAC-SHA256 of the body, keyed
+// with the secret.
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// eventFolderAndDevice returns the folder ID and device ID the event is
+// about, if any.
+func eventFolderAndDevice(ev events.Event) (folder, device string) {
+	var get func(key string) string
+	switch data := ev.Data.(type) {
+	case map[string]string:
+		get = func(key string) string { return data[key] }
+	case map[string]interface{}:
+		get = func(key string) string {
+			str, _ := data[key].(string)
+			return str
+		}
+	default:
+		return "", ""
+	}
+
+	folder = get("folder")
+	device = get("device")
+	if device == "" && ev.Type&(events.DeviceConnected|events.DeviceDisconnected) != 0 {
+		device = get("id")
+	}
+	return folder, device
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package syncthing
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+type webhookRequest struct {
+	header http.Header
+	event  events.Event
+	body   []byte
+}
+
+func TestWebhookService(t *testing.T) {
+	oldBackoff := webhookMinBackoff
+	webhookMinBackoff = time.Millisecond
+	defer func() { webhookMinBackoff = oldBackoff }()
+
+	// The first request fails, to exercise the retry.
+	requests := make(chan webhookRequest, 10)
+	failed := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !failed {
+			failed = true
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var ev events.Event
+		if err := json.Unmarshal(body, &ev); err != nil {
+			t.Error(err)
+		}
+		requests <- webhookRequest{header: r.Header, event: ev, body: body}
+	}))
+	defer srv.Close()
+
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	hook := config.WebhookConfiguration{
+		ID:         "test",
+		URL:        srv.URL,
+		Enabled:    true,
+		Secret:     "s3cret",
+		Events:     []string{"FolderErrors", "DeviceDisconnected"},
+		Folders:    []string{"f1"},
+		MaxRetries: 2,
+		TimeoutS:   10,
+	}
+	cfgFile := tempCfgFilename(t)
+	defer os.Remove(cfgFile)
+	cfg := config.Wrap(cfgFile, config.Configuration{
+		Webhooks: []config.WebhookConfiguration{hook},
+	}, protocol.LocalDeviceID, evLogger)
+	go cfg.Serve(ctx)
+
+	service := newWebhookService(cfg, evLogger)
+	go service.Serve(ctx)
+
+	// Subscription needs to happen in service.Serve
+	time.Sleep(10 * time.Millisecond)
+
+	// Filtered by folder and by event type, respectively
+	evLogger.Log(events.FolderErrors, map[string]interface{}{"folder": "f2"})
+	evLogger.Log(events.StateChanged, map[string]interface{}{"folder": "f1"})
+	// Delivered
+	evLogger.Log(events.FolderErrors, map[string]interface{}{"folder": "f1"})
+	evLogger.Log(events.DeviceDisconnected, map[string]string{"id": protocol.LocalDeviceID.String()})
+
+	req := receiveWebhook(t, requests)
+	if req.event.Type != events.FolderErrors {
+		t.Errorf("unexpected event %v", req.event.Type)
+	}
+	if h := req.header.Get(webhookEventHeader); h != "FolderErrors" {
+		t.Errorf("unexpected event header %q", h)
+	}
+	if h := req.header.Get(webhookSignatureHeader); h != "sha256="+webhookSignature("s3cret", req.body) {
+		t.Errorf("unexpected signature %q", h)
+	}
+	if data, _ := req.event.Data.(map[string]interface{}); data["folder"] != "f1" {
+		t.Errorf("unexpected event data %v", req.event.Data)
+	}
+
+	req = receiveWebhook(t, requests)
+	if req.event.Type != events.DeviceDisconnected {
+		t.Errorf("unexpected event %v", req.event.Type)
+	}
+
+	// Disabling the webhook stops deliveries.
+	hook.Enabled = false
+	waiter, err := cfg.Modify(func(cfg *config.Configuration) {
+		cfg.Webhooks = []config.WebhookConfiguration{hook}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+
+	evLogger.Log(events.FolderErrors, map[string]interface{}{"folder": "f1"})
+	select {
+	case req := <-requests:
+		t.Errorf("unexpected delivery of %v event", req.event.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func receiveWebhook(t *testing.T, requests <-chan webhookRequest) webhookRequest {
+	t.Helper()
+	select {
+	case req := <-requests:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for webhook delivery")
+	}
+	return webhookRequest{}
+}
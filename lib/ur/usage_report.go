@@ -198,7 +198,7 @@ func (s *Service) reportData(ctx context.Context, urVersion int, preview bool) (
 		switch {
 		case addr == "dynamic+https://relays.syncthing.net/endpoint":
 			report.Relays.DefaultServers++
-		case strings.HasPrefix(addr, "relay://") || strings.HasPrefix(addr, "dynamic+http"):
+		case strings.HasPrefix(addr, "relay://") || strings.HasPrefix(addr, "relay+ws") || strings.HasPrefix(addr, "dynamic+http"):
 			report.Relays.OtherServers++
 
 		}
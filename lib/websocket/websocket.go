@@ -0,0 +1,286 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package websocket carries byte streams over WebSocket connections, for
+// networks that only let HTTP traffic through.
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	ws "golang.org/x/net/websocket"
+)
+
+// A DialFunc opens the underlying network connection.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Dial opens a WebSocket connection to the given ws:// or wss:// URL, which
+// must include the port. If the environment configures an HTTP proxy for the
+// URL the connection is tunneled through it using CONNECT. The returned
+// connection sends binary frames and reports the network addresses of the
+// underlying connection.
+//
+// The certificate of a wss:// server isn't verified; the transport security
+// is expected to come from the connection carried inside, and the server is
+// often a reverse proxy we know nothing about.
+func Dial(ctx context.Context, uri *url.URL, dial DialFunc) (net.Conn, error) {
+	httpURL := &url.URL{Scheme: "http", Host: uri.Host}
+	if uri.Scheme == "wss" {
+		httpURL.Scheme = "https"
+	}
+	proxyURL, err := http.ProxyFromEnvironment(&http.Request{URL: httpURL})
+	if err != nil {
+		return nil, err
+	}
+
+	var conn net.Conn
+	remote := net.Addr(nil)
+	if proxyURL != nil && (proxyURL.Scheme == "http" || proxyURL.Scheme == "https") {
+		conn, err = dialConnect(ctx, proxyURL, uri.Host, dial)
+		// The address of the proxy is of no interest.
+		if addr, rerr := net.ResolveTCPAddr("tcp", uri.Host); rerr == nil {
+			remote = addr
+		}
+	} else {
+		conn, err = dial(ctx, "tcp", uri.Host)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if remote == nil {
+		remote = conn.RemoteAddr()
+	}
+	local := conn.LocalAddr()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if uri.Scheme == "wss" {
+		tc := tls.Client(conn, &tls.Config{
+			ServerName:         uri.Hostname(),
+			InsecureSkipVerify: true,
+			NextProtos:         []string{"http/1.1"},
+		})
+		if err := tc.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tc
+	}
+
+	cfg, err := ws.NewConfig(uri.String(), httpURL.String())
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	wc, err := ws.NewClient(cfg, conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	return newConn(wc, local, remote), nil
+}
+
+// dialConnect opens a tunnel to addr through the HTTP proxy at proxyURL.
+func dialConnect(ctx context.Context, proxyURL *url.URL, addr string, dial DialFunc) (net.Conn, error) {
+	proxyAddr := proxyURL.Host
+	if proxyURL.Port() == "" {
+		port := "80"
+		if proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+
+	conn, err := dial(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s: %s", proxyURL.Host, resp.Status)
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+	if br.Buffered() > 0 {
+		// The other side already sent something through the tunnel.
+		return &bufferedConn{Conn: conn, br: br}, nil
+	}
+	return conn, nil
+}
+
+type bufferedConn struct {
+	net.Conn
+	br *bufio.Reader
+}
+
+func (c *bufferedConn) Read(bs []byte) (int, error) {
+	return c.br.Read(bs)
+}
+
+// A Listener accepts WebSocket connections to a path of an HTTP server
+// running on an underlying listener.
+type Listener struct {
+	inner     net.Listener
+	srv       *http.Server
+	conns     chan *conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewListener starts serving WebSocket connections to the given path on
+// the listener, which is closed together with the returned Listener.
+func NewListener(l net.Listener, path string) *Listener {
+	wl := &Listener{
+		inner:  l,
+		conns:  make(chan *conn),
+		closed: make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, ws.Server{
+		// Clients aren't browsers, so the origin doesn't matter.
+		Handshake: func(*ws.Config, *http.Request) error { return nil },
+		Handler:   wl.handle,
+	})
+	wl.srv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		_ = wl.srv.Serve(l)
+		wl.Close()
+	}()
+	return wl
+}
+
+func (l *Listener) handle(wc *ws.Conn) {
+	var remote net.Addr
+	if addr, err := net.ResolveTCPAddr("tcp", wc.Request().RemoteAddr); err == nil {
+		remote = addr
+	}
+	c := newConn(wc, l.inner.Addr(), remote)
+
+	select {
+	case l.conns <- c:
+	case <-l.closed:
+		return
+	}
+
+	// The connection is closed when we return, so stay until its owner
+	// is done with it, even if the listener is closed meanwhile.
+	<-c.closed
+}
+
+// Accept waits for and returns the next WebSocket connection.
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close stops the listener from accepting connections. Connections already
+// accepted from it stay open.
+func (l *Listener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		err = l.srv.Close()
+		if errors.Is(err, net.ErrClosed) {
+			err = nil
+		}
+	})
+	return err
+}
+
+func (l *Listener) Addr() net.Addr {
+	return l.inner.Addr()
+}
+
+// conn is a WebSocket connection sending binary frames, reporting the
+// addresses of the underlying network connection instead of URLs.
+type conn struct {
+	*ws.Conn
+	local     net.Addr
+	remote    net.Addr
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newConn(wc *ws.Conn, local, remote net.Addr) *conn {
+	wc.PayloadType = ws.BinaryFrame
+	return &conn{
+		Conn:   wc,
+		local:  local,
+		remote: remote,
+		closed: make(chan struct{}),
+	}
+}
+
+func (c *conn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return c.Conn.Close()
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	if c.local == nil {
+		return c.Conn.LocalAddr()
+	}
+	return c.local
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	if c.remote == nil {
+		return c.Conn.RemoteAddr()
+	}
+	return c.remote
+}
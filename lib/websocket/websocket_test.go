@@ -0,0 +1,142 @@
+// Copyright (C) 2022 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package websocket
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestDialListen(t *testing.T) {
+	tl, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wl := NewListener(tl, "/bep")
+	defer wl.Close()
+
+	data := bytes.Repeat([]byte("syncthing"), 10000)
+	go func() {
+		c, err := wl.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		_, _ = io.Copy(c, c)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	uri := &url.URL{Scheme: "ws", Host: tl.Addr().String(), Path: "/bep"}
+	c, err := Dial(ctx, uri, (&net.Dialer{}).DialContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if c.RemoteAddr().String() != tl.Addr().String() {
+		t.Errorf("remote address %v, expected %v", c.RemoteAddr(), tl.Addr())
+	}
+
+	go func() {
+		_, _ = c.Write(data)
+	}()
+	got := make([]byte, len(data))
+	if _, err := io.ReadFull(c, got); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("echoed data differs")
+	}
+
+	wl.Close()
+	if _, err := wl.Accept(); err == nil {
+		t.Error("accept on closed listener should fail")
+	}
+
+	// Closing the listener doesn't affect established connections.
+	go func() {
+		_, _ = c.Write(data)
+	}()
+	if _, err := io.ReadFull(c, got); err != nil {
+		t.Fatal("connection broken after closing the listener:", err)
+	}
+}
+
+func TestDialConnect(t *testing.T) {
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+	go func() {
+		c, err := target.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = c.Write([]byte("hello"))
+		c.Close()
+	}()
+
+	// A minimal proxy that handles a single CONNECT request.
+	proxy, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+	gotAuth := make(chan string, 1)
+	go func() {
+		c, err := proxy.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		req, err := http.ReadRequest(bufio.NewReader(c))
+		if err != nil {
+			return
+		}
+		gotAuth <- req.Header.Get("Proxy-Authorization")
+		if req.Method != http.MethodConnect || req.Host != target.Addr().String() {
+			_, _ = c.Write([]byte("HTTP/1.1 400 Bad Request\r\n\r\n"))
+			return
+		}
+		tc, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			return
+		}
+		defer tc.Close()
+		_, _ = c.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		_, _ = io.Copy(c, tc)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	proxyURL := &url.URL{Scheme: "http", Host: proxy.Addr().String(), User: url.UserPassword("user", "pass")}
+	c, err := dialConnect(ctx, proxyURL, target.Addr().String(), (&net.Dialer{}).DialContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if auth := <-gotAuth; auth != "Basic dXNlcjpwYXNz" {
+		t.Errorf("unexpected proxy authorization %q", auth)
+	}
+	bs, err := io.ReadAll(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != "hello" {
+		t.Errorf("unexpected data %q through tunnel", bs)
+	}
+}